@@ -6,10 +6,13 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"strings"
+	"time"
 
 	train "github.com/bijoyv/train/pkg/proto"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 // ClientCommands defines the available commands for the client
@@ -20,16 +23,82 @@ type ClientCommands struct {
 	Email   string
 	Section string
 	NewSeat string
+	Journey string
+	Train   string
+	Depart  string
+	All     bool
+	Ref     string
+	Pos     string
+	Hold    string
+	Join    bool
+	Wait    string
+	Other   string
+	Swap    string
+	Consent bool
+	NewMail string
+	Format  string
+	Out     string
+	Buyer   string
+	Name    string
+	Class   string
+	Seat    string
+	Reason  string
+	Start   string
+	Until   string
+	Block   string
+	Query   string
+	Date    string
+	Sort    string
+	Page    string
+	Return  string
+	Legs    string
+	Pass    string
+	Type    string
+	Days    int
+	ETA     string
 }
 
 func main() {
 	// Define command-line flags
-	cmd := flag.String("cmd", "", "Command to execute: purchase, getticket, getseats, removeuser, modifyseat")
+	cmd := flag.String("cmd", "", "Command to execute: purchase, getticket, getseats, removeuser, modifyseat, createjourney, listjourneys, retirejourney, quote, listtickets, purchasegroup, hold, confirmhold, releasehold, waitlist, leavewaitlist, swapseats, acceptswap, transfer, receipt, upgrade, blockseat, unblockseat, liststations, searchstations, search, purchasereturn, purchaseitinerary, purchasepass, getpass, delayjourney, canceljourney")
 	from := flag.String("from", "", "Origin station (required for purchase)")
 	to := flag.String("to", "", "Destination station (required for purchase)")
-	email := flag.String("email", "", "User email (required for purchase, getticket, removeuser, modifyseat)")
-	section := flag.String("section", "", "Seat section (required for getseats)")
-	newSeat := flag.String("newseat", "", "New seat (required for modifyseat)")
+	email := flag.String("email", "", "User email (required for purchase, listtickets; getticket, removeuser, modifyseat take --email or --ref; comma separated for purchasegroup)")
+	ref := flag.String("ref", "", "Booking reference (getticket, removeuser, modifyseat, receipt, upgrade)")
+	section := flag.String("section", "", "Seat section (required for getseats, preferred section for purchase)")
+	holdID := flag.String("hold", "", "Hold id (required for confirmhold, releasehold)")
+	join := flag.Bool("join", false, "Join the waitlist when the journey is full (purchase)")
+	waitlistID := flag.String("waitlist", "", "Waitlist id (required for waitlist, leavewaitlist)")
+	otherRef := flag.String("otherref", "", "Booking reference of the other ticket (required for swapseats)")
+	swapID := flag.String("swap", "", "Swap id (required for acceptswap)")
+	consent := flag.Bool("consent", false, "Ask the other passenger to accept the swap (swapseats)")
+	newEmail := flag.String("newemail", "", "Email of the passenger taking over the ticket (required for transfer)")
+	buyer := flag.String("purchaser", "", "Email of the purchaser booking for the passenger (purchase, purchasegroup, hold)")
+	name := flag.String("name", "", "Passenger name, needed instead of --email when booking with --purchaser (purchase, hold)")
+	class := flag.String("class", "", "Seat class: standard or first (purchase, purchasegroup, hold; required for upgrade)")
+	format := flag.String("format", "text", "Receipt format: text, html or pdf (receipt)")
+	out := flag.String("out", "", "File the receipt is written to (default the invoice number, receipt)")
+	position := flag.String("position", "", "Preferred seat position for purchase: window or aisle")
+	newSeat := flag.String("newseat", "", "New seat (required for modifyseat, optional for upgrade)")
+	journey := flag.String("journey", "", "Journey id (optional for purchase, getseats, modifyseat, blockseat; required for retirejourney, delayjourney, canceljourney)")
+	trainNumber := flag.String("train", "", "Train number (required for createjourney)")
+	depart := flag.String("depart", "", "Departure time in RFC3339 (required for createjourney)")
+	seat := flag.String("seat", "", "Seat to block (required for blockseat)")
+	reason := flag.String("reason", "", "Why the seat is out of service (required for blockseat), or why a journey is disrupted (delayjourney, canceljourney)")
+	start := flag.String("start", "", "Start of the block in RFC3339, default now (blockseat)")
+	until := flag.String("until", "", "End of the block in RFC3339, default until unblocked (blockseat)")
+	blockID := flag.String("block", "", "Seat block id (required for unblockseat)")
+	query := flag.String("query", "", "Part of a station code, name or alias (required for searchstations)")
+	date := flag.String("date", "", "Travel date YYYY-MM-DD (search), or the first day of a pass (purchasepass)")
+	sortBy := flag.String("sort", "departure", "Sort journeys by departure or price (search)")
+	pageToken := flag.String("page", "", "Page token from a previous search (search)")
+	returnJourney := flag.String("return", "", "Journey id of the way back (required for purchasereturn, with --journey for the way out)")
+	legs := flag.String("legs", "", "Comma separated journey:from:to legs in travel order (required for purchaseitinerary)")
+	passID := flag.String("pass", "", "Pass id (required for getpass, pays for the ride with purchase)")
+	passType := flag.String("type", "", "Pass type: season or carnet (required for purchasepass)")
+	days := flag.Int("days", 0, "Days a season ticket runs, default 30 (purchasepass)")
+	eta := flag.String("eta", "", "Estimated arrival at the last stop in RFC3339 (required for delayjourney)")
+	all := flag.Bool("all", false, "Include retired journeys (listjourneys)")
 
 	flag.Parse()
 
@@ -40,6 +109,39 @@ func main() {
 		Email:   *email,
 		Section: *section,
 		NewSeat: *newSeat,
+		Journey: *journey,
+		Train:   *trainNumber,
+		Depart:  *depart,
+		All:     *all,
+		Ref:     *ref,
+		Pos:     *position,
+		Hold:    *holdID,
+		Join:    *join,
+		Wait:    *waitlistID,
+		Other:   *otherRef,
+		Swap:    *swapID,
+		Consent: *consent,
+		NewMail: *newEmail,
+		Format:  *format,
+		Out:     *out,
+		Buyer:   *buyer,
+		Name:    *name,
+		Class:   *class,
+		Seat:    *seat,
+		Reason:  *reason,
+		Start:   *start,
+		Until:   *until,
+		Block:   *blockID,
+		Query:   *query,
+		Date:    *date,
+		Sort:    *sortBy,
+		Page:    *pageToken,
+		Return:  *returnJourney,
+		Legs:    *legs,
+		Pass:    *passID,
+		Type:    *passType,
+		Days:    *days,
+		ETA:     *eta,
 	}
 
 	// Validate input
@@ -62,15 +164,69 @@ func main() {
 	// Execute the command
 	switch clientCommands.Command {
 	case "purchase":
-		executePurchase(client, clientCommands.From, clientCommands.To, clientCommands.Email)
+		executePurchase(client, clientCommands.Journey, clientCommands.From, clientCommands.To, passenger(clientCommands), purchaser(clientCommands), seatClasses[clientCommands.Class], seatPreferences(clientCommands), clientCommands.Join, clientCommands.Pass)
 	case "getticket":
-		executeGetTicket(client, clientCommands.Email)
+		executeGetTicket(client, clientCommands.Ref, clientCommands.Email)
 	case "getseats":
-		executeGetSeats(client, clientCommands.Section)
+		executeGetSeats(client, clientCommands.Journey, clientCommands.Section, clientCommands.From, clientCommands.To)
 	case "removeuser":
-		executeRemoveUser(client, clientCommands.Email)
+		executeRemoveUser(client, clientCommands.Ref, clientCommands.Email)
 	case "modifyseat":
-		executeModifySeat(client, clientCommands.Email, clientCommands.NewSeat)
+		executeModifySeat(client, clientCommands.Journey, clientCommands.Ref, clientCommands.Email, clientCommands.NewSeat)
+	case "listtickets":
+		executeListTickets(client, clientCommands.Email)
+	case "hold":
+		executeHoldSeat(client, clientCommands.Journey, clientCommands.From, clientCommands.To, passenger(clientCommands), purchaser(clientCommands), seatClasses[clientCommands.Class], seatPreferences(clientCommands))
+	case "receipt":
+		executeGetReceipt(client, clientCommands.Ref, clientCommands.Email, clientCommands.Format, clientCommands.Out)
+	case "upgrade":
+		executeUpgradeSeat(client, clientCommands.Ref, clientCommands.Email, seatClasses[clientCommands.Class], clientCommands.NewSeat)
+	case "transfer":
+		executeTransferTicket(client, clientCommands.Ref, clientCommands.Email, clientCommands.NewMail)
+	case "swapseats":
+		executeSwapSeats(client, clientCommands.Ref, clientCommands.Other, clientCommands.Email, clientCommands.Consent)
+	case "acceptswap":
+		executeAcceptSwap(client, clientCommands.Swap, clientCommands.Ref, clientCommands.Email)
+	case "waitlist":
+		executeGetWaitlistPosition(client, clientCommands.Wait)
+	case "leavewaitlist":
+		executeLeaveWaitlist(client, clientCommands.Wait)
+	case "confirmhold":
+		executeConfirmHold(client, clientCommands.Hold)
+	case "releasehold":
+		executeReleaseHold(client, clientCommands.Hold)
+	case "purchasegroup":
+		executePurchaseGroup(client, clientCommands.Journey, clientCommands.From, clientCommands.To, strings.Split(clientCommands.Email, ","), purchaser(clientCommands), seatClasses[clientCommands.Class])
+	case "createjourney":
+		executeCreateJourney(client, clientCommands.Train, clientCommands.Depart, clientCommands.From, clientCommands.To)
+	case "listjourneys":
+		executeListJourneys(client, clientCommands.All)
+	case "retirejourney":
+		executeRetireJourney(client, clientCommands.Journey)
+	case "delayjourney":
+		executeDelayJourney(client, clientCommands.Journey, clientCommands.ETA, clientCommands.Reason)
+	case "canceljourney":
+		executeCancelJourney(client, clientCommands.Journey, clientCommands.Reason)
+	case "blockseat":
+		executeBlockSeat(client, clientCommands.Journey, clientCommands.Seat, clientCommands.Reason, clientCommands.Start, clientCommands.Until)
+	case "unblockseat":
+		executeUnblockSeat(client, clientCommands.Block)
+	case "liststations":
+		executeListStations(client)
+	case "searchstations":
+		executeSearchStations(client, clientCommands.Query)
+	case "purchasereturn":
+		executePurchaseReturn(client, clientCommands.Journey, clientCommands.Return, clientCommands.From, clientCommands.To, passenger(clientCommands), purchaser(clientCommands), seatClasses[clientCommands.Class], seatPreferences(clientCommands))
+	case "purchasepass":
+		executePurchasePass(client, passTypes[clientCommands.Type], clientCommands.From, clientCommands.To, passenger(clientCommands), purchaser(clientCommands), seatClasses[clientCommands.Class], clientCommands.Date, clientCommands.Days)
+	case "getpass":
+		executeGetPass(client, clientCommands.Pass)
+	case "purchaseitinerary":
+		executePurchaseItinerary(client, itineraryLegs(clientCommands.Legs), passenger(clientCommands), purchaser(clientCommands), seatClasses[clientCommands.Class], seatPreferences(clientCommands))
+	case "search":
+		executeSearchJourneys(client, clientCommands.From, clientCommands.To, clientCommands.Date, journeySorts[clientCommands.Sort], clientCommands.Page)
+	case "quote":
+		executeQuoteFare(client, clientCommands.Journey, clientCommands.From, clientCommands.To)
 	default:
 		flag.Usage()
 		os.Exit(1)
@@ -81,57 +237,326 @@ func main() {
 // validateInput validates the command-line input
 func validateInput(cmd ClientCommands) error {
 	switch cmd.Command {
-	case "purchase":
-		if cmd.From == "" || cmd.To == "" || cmd.Email == "" {
-			return fmt.Errorf("purchase requires --from, --to, and --email")
+	case "purchase", "purchasegroup", "hold", "purchasereturn":
+		if cmd.From == "" || cmd.To == "" {
+			return fmt.Errorf("%s requires --from, --to, and --email", cmd.Command)
+		}
+		if cmd.Email == "" && (cmd.Command == "purchasegroup" || cmd.Buyer == "" || cmd.Name == "") {
+			return fmt.Errorf("%s requires --email, or --purchaser and --name", cmd.Command)
+		}
+		if cmd.Pos != "" && cmd.Pos != "window" && cmd.Pos != "aisle" {
+			return fmt.Errorf("--position must be window or aisle")
+		}
+		if _, ok := seatClasses[cmd.Class]; !ok {
+			return fmt.Errorf("--class must be standard or first")
+		}
+		if cmd.Command == "purchasereturn" && (cmd.Journey == "" || cmd.Return == "") {
+			return fmt.Errorf("purchasereturn requires --journey and --return")
+		}
+	case "purchaseitinerary":
+		if len(itineraryLegs(cmd.Legs)) < 2 {
+			return fmt.Errorf("purchaseitinerary requires --legs with two or more journey:from:to legs")
 		}
-	case "getticket", "removeuser", "modifyseat":
+		if cmd.Email == "" && (cmd.Buyer == "" || cmd.Name == "") {
+			return fmt.Errorf("purchaseitinerary requires --email, or --purchaser and --name")
+		}
+		if _, ok := seatClasses[cmd.Class]; !ok {
+			return fmt.Errorf("--class must be standard or first")
+		}
+	case "getticket", "modifyseat":
+		if cmd.Email == "" && cmd.Ref == "" {
+			return fmt.Errorf("%s requires --email or --ref", cmd.Command)
+		}
+	case "removeuser":
 		if cmd.Email == "" {
-			return fmt.Errorf("%s requires --email", cmd.Command)
+			return fmt.Errorf("removeuser requires the purchaser's --email")
+		}
+	case "receipt":
+		if cmd.Email == "" {
+			return fmt.Errorf("receipt requires the purchaser's --email")
+		}
+		if _, ok := receiptFormats[cmd.Format]; !ok {
+			return fmt.Errorf("--format must be text, html or pdf")
+		}
+	case "upgrade":
+		if cmd.Email == "" {
+			return fmt.Errorf("upgrade requires the purchaser's --email")
+		}
+		if class, ok := seatClasses[cmd.Class]; !ok || class == train.SeatClass_SEAT_CLASS_UNSPECIFIED {
+			return fmt.Errorf("upgrade requires --class standard or first")
+		}
+	case "transfer":
+		if cmd.Ref == "" || cmd.NewMail == "" || cmd.Email == "" {
+			return fmt.Errorf("transfer requires --ref, the purchaser's --email and --newemail")
+		}
+	case "swapseats":
+		if cmd.Ref == "" || cmd.Other == "" || cmd.Email == "" {
+			return fmt.Errorf("swapseats requires --ref, --otherref and --email")
+		}
+	case "acceptswap":
+		if cmd.Swap == "" || cmd.Ref == "" || cmd.Email == "" {
+			return fmt.Errorf("acceptswap requires --swap, --ref and --email")
+		}
+	case "waitlist", "leavewaitlist":
+		if cmd.Wait == "" {
+			return fmt.Errorf("%s requires --waitlist", cmd.Command)
+		}
+	case "confirmhold", "releasehold":
+		if cmd.Hold == "" {
+			return fmt.Errorf("%s requires --hold", cmd.Command)
+		}
+	case "listtickets":
+		if cmd.Email == "" {
+			return fmt.Errorf("listtickets requires --email")
 		}
 	case "getseats":
 		if cmd.Section == "" {
 			return fmt.Errorf("getseats requires --section")
 		}
+	case "createjourney":
+		if cmd.Train == "" || cmd.Depart == "" || cmd.From == "" || cmd.To == "" {
+			return fmt.Errorf("createjourney requires --train, --depart, --from, and --to")
+		}
+		if _, err := time.Parse(time.RFC3339, cmd.Depart); err != nil {
+			return fmt.Errorf("createjourney --depart must be RFC3339: %v", err)
+		}
+	case "listjourneys", "quote", "liststations":
+	case "search":
+		if cmd.From == "" || cmd.To == "" {
+			return fmt.Errorf("search requires --from and --to")
+		}
+		if _, ok := journeySorts[cmd.Sort]; !ok {
+			return fmt.Errorf("--sort must be departure or price")
+		}
+	case "searchstations":
+		if cmd.Query == "" {
+			return fmt.Errorf("searchstations requires --query")
+		}
+	case "retirejourney":
+		if cmd.Journey == "" {
+			return fmt.Errorf("retirejourney requires --journey")
+		}
+	case "delayjourney":
+		if cmd.Journey == "" || cmd.ETA == "" {
+			return fmt.Errorf("delayjourney requires --journey and --eta")
+		}
+		if _, err := optionalTime(cmd.ETA); err != nil {
+			return fmt.Errorf("delayjourney --eta must be RFC3339: %v", err)
+		}
+	case "canceljourney":
+		if cmd.Journey == "" {
+			return fmt.Errorf("canceljourney requires --journey")
+		}
+	case "blockseat":
+		if cmd.Seat == "" || cmd.Reason == "" {
+			return fmt.Errorf("blockseat requires --seat and --reason")
+		}
+		for _, t := range []string{cmd.Start, cmd.Until} {
+			if _, err := optionalTime(t); err != nil {
+				return fmt.Errorf("blockseat --start and --until must be RFC3339: %v", err)
+			}
+		}
+	case "unblockseat":
+		if cmd.Block == "" {
+			return fmt.Errorf("unblockseat requires --block")
+		}
+	case "purchasepass":
+		if _, ok := passTypes[cmd.Type]; !ok {
+			return fmt.Errorf("--type must be season or carnet")
+		}
+		if cmd.From == "" || cmd.To == "" || cmd.Email == "" {
+			return fmt.Errorf("purchasepass requires --from, --to, and --email")
+		}
+		if _, ok := seatClasses[cmd.Class]; !ok {
+			return fmt.Errorf("--class must be standard or first")
+		}
+	case "getpass":
+		if cmd.Pass == "" {
+			return fmt.Errorf("getpass requires --pass")
+		}
 	default:
 		return fmt.Errorf("unknown command: %s", cmd.Command)
 	}
 	return nil
 }
 
-// executePurchase handles the purchase command
-func executePurchase(client train.TrainServiceClient, from, to, email string) {
-	user := &train.User{
-		Email: email,
+// seatPreferences builds the purchase preferences out of the command-line flags
+func seatPreferences(cmd ClientCommands) *train.SeatPreferences {
+	prefs := &train.SeatPreferences{Section: cmd.Section}
+	switch cmd.Pos {
+	case "window":
+		prefs.Position = train.SeatPosition_SEAT_POSITION_WINDOW
+	case "aisle":
+		prefs.Position = train.SeatPosition_SEAT_POSITION_AISLE
 	}
+	return prefs
+}
+
+// seatClasses maps the --class values to seat classes, no value leaves the
+// class to the server
+var seatClasses = map[string]train.SeatClass{
+	"":         train.SeatClass_SEAT_CLASS_UNSPECIFIED,
+	"standard": train.SeatClass_SEAT_CLASS_STANDARD,
+	"first":    train.SeatClass_SEAT_CLASS_FIRST,
+}
+
+// passenger builds the travelling passenger out of --email and --name
+func passenger(cmd ClientCommands) *train.User {
+	user := &train.User{Email: cmd.Email}
+	user.FirstName, user.LastName, _ = strings.Cut(strings.TrimSpace(cmd.Name), " ")
+	return user
+}
+
+// purchaser builds the purchaser out of --purchaser, nil when the passenger
+// books for themselves
+func purchaser(cmd ClientCommands) *train.Purchaser {
+	if cmd.Buyer == "" {
+		return nil
+	}
+	return &train.Purchaser{Email: cmd.Buyer}
+}
+
+// executePurchase handles the purchase command
+func executePurchase(client train.TrainServiceClient, journey, from, to string, user *train.User, buyer *train.Purchaser, class train.SeatClass, prefs *train.SeatPreferences, join bool, pass string) {
 	purchaseRequest := &train.PurchaseTicketRequest{
-		From: from,
-		To:   to,
-		User: user,
+		From:         from,
+		To:           to,
+		User:         user,
+		Purchaser:    buyer,
+		SeatClass:    class,
+		JourneyId:    journey,
+		Preferences:  prefs,
+		JoinWaitlist: join,
+		PassId:       pass,
 	}
 	purchaseResponse, err := client.PurchaseTicket(context.Background(), purchaseRequest)
 	if err != nil {
 		log.Fatalf("could not purchase ticket: %v", err)
 	}
+	if purchaseResponse.Waitlist != nil {
+		fmt.Println("Journey full, joined waitlist:", purchaseResponse.Waitlist)
+		return
+	}
 	fmt.Println("Ticket purchased:", purchaseResponse.Ticket)
+	for _, note := range purchaseResponse.AllocationNotes {
+		fmt.Println("Note:", note)
+	}
+}
+
+// passTypes maps the --type flag to pass types
+var passTypes = map[string]train.PassType{
+	"season": train.PassType_PASS_TYPE_SEASON,
+	"carnet": train.PassType_PASS_TYPE_CARNET,
+}
+
+// executePurchasePass handles the purchasepass command
+func executePurchasePass(client train.TrainServiceClient, kind train.PassType, from, to string, user *train.User, buyer *train.Purchaser, class train.SeatClass, startDate string, days int) {
+	purchasePassRequest := &train.PurchasePassRequest{
+		Type:      kind,
+		From:      from,
+		To:        to,
+		User:      user,
+		Purchaser: buyer,
+		SeatClass: class,
+		StartDate: startDate,
+		Days:      int32(days),
+	}
+	purchasePassResponse, err := client.PurchasePass(context.Background(), purchasePassRequest)
+	if err != nil {
+		log.Fatalf("could not purchase pass: %v", err)
+	}
+	fmt.Println("Pass purchased:", purchasePassResponse.Pass)
+}
+
+// executeGetPass handles the getpass command
+func executeGetPass(client train.TrainServiceClient, id string) {
+	getPassResponse, err := client.GetPass(context.Background(), &train.GetPassRequest{Id: id})
+	if err != nil {
+		log.Fatalf("could not get pass: %v", err)
+	}
+	fmt.Println("Pass:", getPassResponse.Pass)
+	for _, t := range getPassResponse.Tickets {
+		fmt.Println("Ride:", t)
+	}
+}
+
+// executePurchaseReturn handles the purchasereturn command
+func executePurchaseReturn(client train.TrainServiceClient, outbound, back, from, to string, user *train.User, buyer *train.Purchaser, class train.SeatClass, prefs *train.SeatPreferences) {
+	purchaseReturnRequest := &train.PurchaseReturnRequest{
+		From:              from,
+		To:                to,
+		OutboundJourneyId: outbound,
+		ReturnJourneyId:   back,
+		User:              user,
+		Purchaser:         buyer,
+		SeatClass:         class,
+		Preferences:       prefs,
+	}
+	purchaseReturnResponse, err := client.PurchaseReturn(context.Background(), purchaseReturnRequest)
+	if err != nil {
+		log.Fatalf("could not purchase round trip: %v", err)
+	}
+	fmt.Println("Round trip booked:", purchaseReturnResponse.TripReference)
+	for _, t := range purchaseReturnResponse.Tickets {
+		fmt.Println(t)
+	}
+}
+
+// itineraryLegs parses journey:from:to legs, nil when any leg is malformed.
+func itineraryLegs(legs string) []*train.ItineraryLeg {
+	var parsed []*train.ItineraryLeg
+	for _, leg := range strings.Split(legs, ",") {
+		parts := strings.Split(strings.TrimSpace(leg), ":")
+		if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+			return nil
+		}
+		parsed = append(parsed, &train.ItineraryLeg{JourneyId: parts[0], From: parts[1], To: parts[2]})
+	}
+	return parsed
+}
+
+// executePurchaseItinerary handles the purchaseitinerary command
+func executePurchaseItinerary(client train.TrainServiceClient, legs []*train.ItineraryLeg, user *train.User, buyer *train.Purchaser, class train.SeatClass, prefs *train.SeatPreferences) {
+	purchaseItineraryRequest := &train.PurchaseItineraryRequest{
+		Legs:        legs,
+		User:        user,
+		Purchaser:   buyer,
+		SeatClass:   class,
+		Preferences: prefs,
+	}
+	purchaseItineraryResponse, err := client.PurchaseItinerary(context.Background(), purchaseItineraryRequest)
+	if err != nil {
+		log.Fatalf("could not purchase itinerary: %v", err)
+	}
+	fmt.Println("Itinerary booked:", purchaseItineraryResponse.ItineraryReference)
+	for _, t := range purchaseItineraryResponse.Tickets {
+		fmt.Println(t)
+	}
 }
 
 // executeGetTicket handles the getticket command
-func executeGetTicket(client train.TrainServiceClient, email string) {
+func executeGetTicket(client train.TrainServiceClient, ref, email string) {
 	getTicketRequest := &train.GetTicketRequest{
-		Email: email,
+		Email:     email,
+		Reference: ref,
 	}
 	getTicketResponse, err := client.GetTicket(context.Background(), getTicketRequest)
 	if err != nil {
 		log.Fatalf("could not get ticket: %v", err)
 	}
 	fmt.Println("Ticket retrieved:", getTicketResponse.Ticket)
+	for _, leg := range getTicketResponse.Trip {
+		fmt.Println("Trip leg:", leg)
+	}
 }
 
 // executeGetSeats handles the getseats command
-func executeGetSeats(client train.TrainServiceClient, section string) {
+func executeGetSeats(client train.TrainServiceClient, journey, section, from, to string) {
 	getSeatsBySectionRequest := &train.GetSeatsBySectionRequest{
-		Section: section,
+		Section:   section,
+		JourneyId: journey,
+		From:      from,
+		To:        to,
 	}
 	getSeatsBySectionResponse, err := client.GetSeatsBySection(context.Background(), getSeatsBySectionRequest)
 	if err != nil {
@@ -141,22 +566,26 @@ func executeGetSeats(client train.TrainServiceClient, section string) {
 }
 
 // executeRemoveUser handles the removeuser command
-func executeRemoveUser(client train.TrainServiceClient, email string) {
+func executeRemoveUser(client train.TrainServiceClient, ref, email string) {
 	removeUserRequest := &train.RemoveUserRequest{
-		Email: email,
+		Email:     email,
+		Reference: ref,
 	}
 	removeUserResponse, err := client.RemoveUser(context.Background(), removeUserRequest)
 	if err != nil {
 		log.Fatalf("could not remove user: %v", err)
 	}
 	fmt.Println("User removed successfully:", removeUserResponse.Success)
+	fmt.Println("Cancellation:", removeUserResponse.Cancellation)
 }
 
 // executeModifySeat handles the modifyseat command
-func executeModifySeat(client train.TrainServiceClient, email, newSeat string) {
+func executeModifySeat(client train.TrainServiceClient, journey, ref, email, newSeat string) {
 	modifySeatRequest := &train.ModifySeatRequest{
-		Email:   email,
-		NewSeat: newSeat,
+		Email:     email,
+		NewSeat:   newSeat,
+		JourneyId: journey,
+		Reference: ref,
 	}
 	modifySeatResponse, err := client.ModifySeat(context.Background(), modifySeatRequest)
 	if err != nil {
@@ -164,3 +593,362 @@ func executeModifySeat(client train.TrainServiceClient, email, newSeat string) {
 	}
 	fmt.Println("Seat modified successfully:", modifySeatResponse.Success)
 }
+
+// executeCreateJourney handles the createjourney command
+func executeCreateJourney(client train.TrainServiceClient, trainNumber, depart, from, to string) {
+	departure, _ := time.Parse(time.RFC3339, depart) // checked by validateInput
+	createJourneyRequest := &train.CreateJourneyRequest{
+		TrainNumber: trainNumber,
+		Departure:   timestamppb.New(departure),
+		Origin:      from,
+		Destination: to,
+	}
+	createJourneyResponse, err := client.CreateJourney(context.Background(), createJourneyRequest)
+	if err != nil {
+		log.Fatalf("could not create journey: %v", err)
+	}
+	fmt.Println("Journey created:", createJourneyResponse.Journey)
+}
+
+// executeListJourneys handles the listjourneys command
+func executeListJourneys(client train.TrainServiceClient, all bool) {
+	listJourneysResponse, err := client.ListJourneys(context.Background(), &train.ListJourneysRequest{IncludeRetired: all})
+	if err != nil {
+		log.Fatalf("could not list journeys: %v", err)
+	}
+	for _, j := range listJourneysResponse.Journeys {
+		fmt.Println(j)
+	}
+}
+
+// executeRetireJourney handles the retirejourney command
+func executeRetireJourney(client train.TrainServiceClient, journey string) {
+	retireJourneyResponse, err := client.RetireJourney(context.Background(), &train.RetireJourneyRequest{JourneyId: journey})
+	if err != nil {
+		log.Fatalf("could not retire journey: %v", err)
+	}
+	fmt.Println("Journey retired successfully:", retireJourneyResponse.Success)
+}
+
+// printDisruptionReport prints the notice of every passenger on a disrupted journey
+func printDisruptionReport(report []*train.DisruptionNotice) {
+	for _, n := range report {
+		fmt.Printf("%s %s: %s\n", n.Reference, n.User.GetEmail(), n.Message)
+	}
+}
+
+// executeDelayJourney handles the delayjourney command
+func executeDelayJourney(client train.TrainServiceClient, journey, eta, reason string) {
+	delayJourneyRequest := &train.DelayJourneyRequest{
+		JourneyId: journey,
+		Reason:    reason,
+	}
+	delayJourneyRequest.EstimatedArrival, _ = optionalTime(eta) // checked by validateInput
+	delayJourneyResponse, err := client.DelayJourney(context.Background(), delayJourneyRequest)
+	if err != nil {
+		log.Fatalf("could not delay journey: %v", err)
+	}
+	fmt.Println("Journey delayed:", delayJourneyResponse.Journey)
+	printDisruptionReport(delayJourneyResponse.Report)
+}
+
+// executeCancelJourney handles the canceljourney command
+func executeCancelJourney(client train.TrainServiceClient, journey, reason string) {
+	cancelJourneyResponse, err := client.CancelJourney(context.Background(), &train.CancelJourneyRequest{JourneyId: journey, Reason: reason})
+	if err != nil {
+		log.Fatalf("could not cancel journey: %v", err)
+	}
+	fmt.Println("Journey cancelled:", cancelJourneyResponse.Journey)
+	printDisruptionReport(cancelJourneyResponse.Report)
+}
+
+// optionalTime parses an RFC3339 flag, an empty flag is no time
+func optionalTime(value string) (*timestamppb.Timestamp, error) {
+	if value == "" {
+		return nil, nil
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, err
+	}
+	return timestamppb.New(t), nil
+}
+
+// executeBlockSeat handles the blockseat command
+func executeBlockSeat(client train.TrainServiceClient, journey, seat, reason, start, until string) {
+	blockSeatRequest := &train.BlockSeatRequest{
+		JourneyId: journey,
+		Seat:      seat,
+		Reason:    reason,
+	}
+	blockSeatRequest.Start, _ = optionalTime(start) // checked by validateInput
+	blockSeatRequest.Until, _ = optionalTime(until)
+	blockSeatResponse, err := client.BlockSeat(context.Background(), blockSeatRequest)
+	if err != nil {
+		log.Fatalf("could not block seat: %v", err)
+	}
+	fmt.Println("Seat blocked:", blockSeatResponse.Block)
+	for _, t := range blockSeatResponse.Reseated {
+		fmt.Println("Reseated:", t)
+	}
+	for _, ref := range blockSeatResponse.Unplaced {
+		fmt.Println("Could not reseat:", ref)
+	}
+}
+
+// executeUnblockSeat handles the unblockseat command
+func executeUnblockSeat(client train.TrainServiceClient, blockID string) {
+	unblockSeatResponse, err := client.UnblockSeat(context.Background(), &train.UnblockSeatRequest{BlockId: blockID})
+	if err != nil {
+		log.Fatalf("could not unblock seat: %v", err)
+	}
+	fmt.Println("Seat unblocked successfully:", unblockSeatResponse.Success)
+}
+
+// executeListStations handles the liststations command
+func executeListStations(client train.TrainServiceClient) {
+	listStationsResponse, err := client.ListStations(context.Background(), &train.ListStationsRequest{})
+	if err != nil {
+		log.Fatalf("could not list stations: %v", err)
+	}
+	for _, st := range listStationsResponse.Stations {
+		fmt.Println(st)
+	}
+}
+
+// executeSearchStations handles the searchstations command
+func executeSearchStations(client train.TrainServiceClient, query string) {
+	searchStationsResponse, err := client.SearchStations(context.Background(), &train.SearchStationsRequest{Query: query})
+	if err != nil {
+		log.Fatalf("could not search stations: %v", err)
+	}
+	for _, st := range searchStationsResponse.Stations {
+		fmt.Println(st)
+	}
+}
+
+// journeySorts maps the --sort values to journey sort orders
+var journeySorts = map[string]train.JourneySort{
+	"departure": train.JourneySort_JOURNEY_SORT_DEPARTURE,
+	"price":     train.JourneySort_JOURNEY_SORT_PRICE,
+}
+
+// executeSearchJourneys handles the search command
+func executeSearchJourneys(client train.TrainServiceClient, from, to, date string, sortBy train.JourneySort, page string) {
+	searchJourneysRequest := &train.SearchJourneysRequest{
+		From:      from,
+		To:        to,
+		Date:      date,
+		SortBy:    sortBy,
+		PageToken: page,
+	}
+	searchJourneysResponse, err := client.SearchJourneys(context.Background(), searchJourneysRequest)
+	if err != nil {
+		log.Fatalf("could not search journeys: %v", err)
+	}
+	fmt.Printf("%d journeys found\n", searchJourneysResponse.TotalResults)
+	for _, r := range searchJourneysResponse.Results {
+		fmt.Println(r)
+	}
+	if searchJourneysResponse.NextPageToken != "" {
+		fmt.Println("More with --page=" + searchJourneysResponse.NextPageToken)
+	}
+}
+
+// executeQuoteFare handles the quote command
+func executeQuoteFare(client train.TrainServiceClient, journey, from, to string) {
+	quoteFareRequest := &train.QuoteFareRequest{
+		JourneyId: journey,
+		From:      from,
+		To:        to,
+	}
+	quoteFareResponse, err := client.QuoteFare(context.Background(), quoteFareRequest)
+	if err != nil {
+		log.Fatalf("could not quote fare: %v", err)
+	}
+	for _, c := range quoteFareResponse.Breakdown {
+		fmt.Printf("%-40s %s %d\n", c.Description, c.Amount.Currency, c.Amount.MinorUnits)
+	}
+	fmt.Printf("%-40s %s %d\n", "Total", quoteFareResponse.Total.Currency, quoteFareResponse.Total.MinorUnits)
+}
+
+// executeListTickets handles the listtickets command
+func executeListTickets(client train.TrainServiceClient, email string) {
+	listTicketsResponse, err := client.ListTicketsByUser(context.Background(), &train.ListTicketsByUserRequest{Email: email})
+	if err != nil {
+		log.Fatalf("could not list tickets: %v", err)
+	}
+	for _, t := range listTicketsResponse.Tickets {
+		fmt.Println(t)
+	}
+}
+
+// executePurchaseGroup handles the purchasegroup command
+func executePurchaseGroup(client train.TrainServiceClient, journey, from, to string, emails []string, buyer *train.Purchaser, class train.SeatClass) {
+	purchaseGroupRequest := &train.PurchaseGroupRequest{
+		JourneyId: journey,
+		From:      from,
+		To:        to,
+		Purchaser: buyer,
+		SeatClass: class,
+	}
+	for _, email := range emails {
+		purchaseGroupRequest.Passengers = append(purchaseGroupRequest.Passengers, &train.User{Email: strings.TrimSpace(email)})
+	}
+	purchaseGroupResponse, err := client.PurchaseGroup(context.Background(), purchaseGroupRequest)
+	if err != nil {
+		log.Fatalf("could not purchase group: %v", err)
+	}
+	fmt.Println("Group booked:", purchaseGroupResponse.GroupReference)
+	for _, t := range purchaseGroupResponse.Tickets {
+		fmt.Println(t)
+	}
+}
+
+// executeHoldSeat handles the hold command
+func executeHoldSeat(client train.TrainServiceClient, journey, from, to string, user *train.User, buyer *train.Purchaser, class train.SeatClass, prefs *train.SeatPreferences) {
+	holdSeatRequest := &train.HoldSeatRequest{
+		JourneyId:   journey,
+		From:        from,
+		To:          to,
+		User:        user,
+		Purchaser:   buyer,
+		SeatClass:   class,
+		Preferences: prefs,
+	}
+	holdSeatResponse, err := client.HoldSeat(context.Background(), holdSeatRequest)
+	if err != nil {
+		log.Fatalf("could not hold seat: %v", err)
+	}
+	fmt.Println("Seat held:", holdSeatResponse.Hold)
+	for _, note := range holdSeatResponse.AllocationNotes {
+		fmt.Println("Note:", note)
+	}
+}
+
+// executeConfirmHold handles the confirmhold command
+func executeConfirmHold(client train.TrainServiceClient, holdID string) {
+	confirmHoldResponse, err := client.ConfirmHold(context.Background(), &train.ConfirmHoldRequest{HoldId: holdID})
+	if err != nil {
+		log.Fatalf("could not confirm hold: %v", err)
+	}
+	fmt.Println("Ticket purchased:", confirmHoldResponse.Ticket)
+}
+
+// executeReleaseHold handles the releasehold command
+func executeReleaseHold(client train.TrainServiceClient, holdID string) {
+	releaseHoldResponse, err := client.ReleaseHold(context.Background(), &train.ReleaseHoldRequest{HoldId: holdID})
+	if err != nil {
+		log.Fatalf("could not release hold: %v", err)
+	}
+	fmt.Println("Hold released successfully:", releaseHoldResponse.Success)
+}
+
+// executeGetWaitlistPosition handles the waitlist command
+func executeGetWaitlistPosition(client train.TrainServiceClient, waitlistID string) {
+	positionResponse, err := client.GetWaitlistPosition(context.Background(), &train.GetWaitlistPositionRequest{WaitlistId: waitlistID})
+	if err != nil {
+		log.Fatalf("could not get waitlist position: %v", err)
+	}
+	fmt.Println("Waitlist position:", positionResponse.Entry.Position)
+}
+
+// executeLeaveWaitlist handles the leavewaitlist command
+func executeLeaveWaitlist(client train.TrainServiceClient, waitlistID string) {
+	leaveWaitlistResponse, err := client.LeaveWaitlist(context.Background(), &train.LeaveWaitlistRequest{WaitlistId: waitlistID})
+	if err != nil {
+		log.Fatalf("could not leave waitlist: %v", err)
+	}
+	fmt.Println("Left waitlist successfully:", leaveWaitlistResponse.Success)
+}
+
+// executeSwapSeats handles the swapseats command
+func executeSwapSeats(client train.TrainServiceClient, ref, otherRef, email string, consent bool) {
+	swapSeatsRequest := &train.SwapSeatsRequest{
+		Reference:      ref,
+		OtherReference: otherRef,
+		RequireConsent: consent,
+		Email:          email,
+	}
+	swapSeatsResponse, err := client.SwapSeats(context.Background(), swapSeatsRequest)
+	if err != nil {
+		log.Fatalf("could not swap seats: %v", err)
+	}
+	if !swapSeatsResponse.Swapped {
+		fmt.Println("Swap waiting for acceptance:", swapSeatsResponse.SwapId)
+		return
+	}
+	for _, t := range swapSeatsResponse.Tickets {
+		fmt.Println("Seat swapped:", t)
+	}
+}
+
+// executeAcceptSwap handles the acceptswap command
+func executeAcceptSwap(client train.TrainServiceClient, swapID, ref, email string) {
+	acceptSwapResponse, err := client.AcceptSwap(context.Background(), &train.AcceptSwapRequest{SwapId: swapID, Reference: ref, Email: email})
+	if err != nil {
+		log.Fatalf("could not accept swap: %v", err)
+	}
+	for _, t := range acceptSwapResponse.Tickets {
+		fmt.Println("Seat swapped:", t)
+	}
+}
+
+// executeTransferTicket handles the transfer command
+func executeTransferTicket(client train.TrainServiceClient, ref, email, newEmail string) {
+	transferTicketRequest := &train.TransferTicketRequest{
+		Reference: ref,
+		Email:     email,
+		NewUser:   &train.User{Email: newEmail},
+	}
+	transferTicketResponse, err := client.TransferTicket(context.Background(), transferTicketRequest)
+	if err != nil {
+		log.Fatalf("could not transfer ticket: %v", err)
+	}
+	fmt.Println("Ticket transferred:", transferTicketResponse.Ticket)
+	for _, leg := range transferTicketResponse.Trip {
+		fmt.Println("Trip leg:", leg)
+	}
+}
+
+// receiptFormats maps the --format values to receipt formats
+var receiptFormats = map[string]train.ReceiptFormat{
+	"text": train.ReceiptFormat_RECEIPT_FORMAT_TEXT,
+	"html": train.ReceiptFormat_RECEIPT_FORMAT_HTML,
+	"pdf":  train.ReceiptFormat_RECEIPT_FORMAT_PDF,
+}
+
+// executeGetReceipt handles the receipt command
+func executeGetReceipt(client train.TrainServiceClient, ref, email, format, out string) {
+	getReceiptRequest := &train.GetReceiptRequest{
+		Reference: ref,
+		Email:     email,
+		Format:    receiptFormats[format],
+	}
+	getReceiptResponse, err := client.GetReceipt(context.Background(), getReceiptRequest)
+	if err != nil {
+		log.Fatalf("could not get receipt: %v", err)
+	}
+	if out == "" {
+		out = getReceiptResponse.FileName
+	}
+	if err := os.WriteFile(out, getReceiptResponse.Content, 0o644); err != nil {
+		log.Fatalf("could not write receipt: %v", err)
+	}
+	fmt.Printf("Receipt %s written to %s\n", getReceiptResponse.InvoiceNumber, out)
+}
+
+// executeUpgradeSeat handles the upgrade command
+func executeUpgradeSeat(client train.TrainServiceClient, ref, email string, class train.SeatClass, newSeat string) {
+	upgradeSeatRequest := &train.UpgradeSeatRequest{
+		Reference: ref,
+		Email:     email,
+		SeatClass: class,
+		NewSeat:   newSeat,
+	}
+	upgradeSeatResponse, err := client.UpgradeSeat(context.Background(), upgradeSeatRequest)
+	if err != nil {
+		log.Fatalf("could not upgrade seat: %v", err)
+	}
+	fmt.Printf("Upgraded for %d %s: %v\n", upgradeSeatResponse.Charged.MinorUnits, upgradeSeatResponse.Charged.Currency, upgradeSeatResponse.Ticket)
+}
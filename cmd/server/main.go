@@ -1,8 +1,10 @@
 package main
 
 import (
+	"flag"
 	"log"
 	"net"
+	"time"
 
 	train "github.com/bijoyv/train/pkg/proto"
 	reservation "github.com/bijoyv/train/pkg/train"
@@ -10,8 +12,74 @@ import (
 )
 
 func main() {
+	layoutFile := flag.String("layout", "", "JSON seat layout used for new journeys (default two coaches of 20 seats)")
+	faresFile := flag.String("fares", "", "JSON fare table (default 20.00 GBP for every trip)")
+	allocator := flag.String("allocator", "preference", "Seat allocator: sequential, spread or preference")
+	holdTTL := flag.Duration("holdttl", 10*time.Minute, "How long a seat hold lasts before it is released")
+	stationsFile := flag.String("stations", "", "JSON station registry (default the London, Paris and Brussels lines)")
+	refundsFile := flag.String("refunds", "", "JSON refund policy (default full refund up to 48h before departure, half after, less 1.00)")
+	transferCutoff := flag.Duration("transfercutoff", 2*time.Hour, "No ticket transfers within this long of departure")
+	maxTransfers := flag.Int("maxtransfers", 1, "How often a ticket may be transferred, 0 for no limit")
+	outboxDir := flag.String("outbox", "", "Maildir passenger notifications are written to")
+	smtpAddr := flag.String("smtp", "", "SMTP server host:port passenger notifications are sent through")
+	mailFrom := flag.String("mailfrom", "tickets@train.example", "Sender of passenger notifications")
+	flag.Parse()
+
+	seatAllocator, err := reservation.AllocatorByName(*allocator)
+	if err != nil {
+		log.Fatalf("failed to configure seat allocator: %v", err)
+	}
+	opts := []reservation.Option{
+		reservation.WithSeatAllocator(seatAllocator),
+		reservation.WithHoldTTL(*holdTTL),
+		reservation.WithTransferPolicy(reservation.CutoffTransferPolicy{Cutoff: *transferCutoff, MaxTransfers: *maxTransfers}),
+	}
+	if *layoutFile != "" {
+		layout, err := reservation.LoadSeatLayout(*layoutFile)
+		if err != nil {
+			log.Fatalf("failed to load seat layout: %v", err)
+		}
+		opts = append(opts, reservation.WithSeatLayout(layout))
+	}
+	if *faresFile != "" {
+		fares, err := reservation.LoadFarePolicy(*faresFile)
+		if err != nil {
+			log.Fatalf("failed to load fare table: %v", err)
+		}
+		opts = append(opts, reservation.WithFarePolicy(fares))
+	}
+
+	if *stationsFile != "" {
+		stations, err := reservation.LoadStationRegistry(*stationsFile)
+		if err != nil {
+			log.Fatalf("failed to load station registry: %v", err)
+		}
+		opts = append(opts, reservation.WithStationRegistry(stations))
+	}
+
+	if *refundsFile != "" {
+		refunds, err := reservation.LoadRefundPolicy(*refundsFile)
+		if err != nil {
+			log.Fatalf("failed to load refund policy: %v", err)
+		}
+		opts = append(opts, reservation.WithRefundPolicy(refunds))
+	}
+
+	switch {
+	case *outboxDir != "" && *smtpAddr != "":
+		log.Fatalf("notifications go to either --outbox or --smtp, not both")
+	case *outboxDir != "":
+		outbox, err := reservation.NewMaildirOutbox(*outboxDir, *mailFrom)
+		if err != nil {
+			log.Fatalf("failed to open outbox: %v", err)
+		}
+		opts = append(opts, reservation.WithNotifier(outbox))
+	case *smtpAddr != "":
+		opts = append(opts, reservation.WithNotifier(&reservation.SMTPNotifier{Addr: *smtpAddr, From: *mailFrom}))
+	}
+
 	// Create a TrainService instance
-	trainService := reservation.NewTrainReservationService()
+	trainService := reservation.NewTrainReservationService(opts...)
 
 	// Initialize gRPC server
 	lis, err := net.Listen("tcp", ":50051") // Choose your port
@@ -9,6 +9,8 @@ package train
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 )
@@ -20,377 +22,620 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
-type Ticket struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+// TripLeg tells the two tickets of a round trip apart.
+type TripLeg int32
 
-	From  string `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
-	To    string `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
-	User  *User  `protobuf:"bytes,3,opt,name=user,proto3" json:"user,omitempty"`
-	Price int32  `protobuf:"varint,4,opt,name=price,proto3" json:"price,omitempty"`
-	Seat  string `protobuf:"bytes,5,opt,name=seat,proto3" json:"seat,omitempty"`
-}
+const (
+	TripLeg_TRIP_LEG_UNSPECIFIED TripLeg = 0
+	TripLeg_TRIP_LEG_OUTBOUND    TripLeg = 1
+	TripLeg_TRIP_LEG_RETURN      TripLeg = 2
+)
 
-func (x *Ticket) Reset() {
-	*x = Ticket{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_train_proto_msgTypes[0]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+// Enum value maps for TripLeg.
+var (
+	TripLeg_name = map[int32]string{
+		0: "TRIP_LEG_UNSPECIFIED",
+		1: "TRIP_LEG_OUTBOUND",
+		2: "TRIP_LEG_RETURN",
 	}
-}
+	TripLeg_value = map[string]int32{
+		"TRIP_LEG_UNSPECIFIED": 0,
+		"TRIP_LEG_OUTBOUND":    1,
+		"TRIP_LEG_RETURN":      2,
+	}
+)
 
-func (x *Ticket) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x TripLeg) Enum() *TripLeg {
+	p := new(TripLeg)
+	*p = x
+	return p
 }
 
-func (*Ticket) ProtoMessage() {}
-
-func (x *Ticket) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_train_proto_msgTypes[0]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (x TripLeg) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-// Deprecated: Use Ticket.ProtoReflect.Descriptor instead.
-func (*Ticket) Descriptor() ([]byte, []int) {
-	return file_proto_train_proto_rawDescGZIP(), []int{0}
+func (TripLeg) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_train_proto_enumTypes[0].Descriptor()
 }
 
-func (x *Ticket) GetFrom() string {
-	if x != nil {
-		return x.From
-	}
-	return ""
+func (TripLeg) Type() protoreflect.EnumType {
+	return &file_proto_train_proto_enumTypes[0]
 }
 
-func (x *Ticket) GetTo() string {
-	if x != nil {
-		return x.To
-	}
-	return ""
+func (x TripLeg) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *Ticket) GetUser() *User {
-	if x != nil {
-		return x.User
-	}
-	return nil
+// Deprecated: Use TripLeg.Descriptor instead.
+func (TripLeg) EnumDescriptor() ([]byte, []int) {
+	return file_proto_train_proto_rawDescGZIP(), []int{0}
 }
 
-func (x *Ticket) GetPrice() int32 {
-	if x != nil {
-		return x.Price
+type PaymentStatus int32
+
+const (
+	PaymentStatus_PAYMENT_STATUS_UNSPECIFIED PaymentStatus = 0
+	PaymentStatus_PAYMENT_STATUS_AUTHORIZED  PaymentStatus = 1
+	PaymentStatus_PAYMENT_STATUS_CAPTURED    PaymentStatus = 2
+	PaymentStatus_PAYMENT_STATUS_VOIDED      PaymentStatus = 3
+	PaymentStatus_PAYMENT_STATUS_REFUNDED    PaymentStatus = 4
+)
+
+// Enum value maps for PaymentStatus.
+var (
+	PaymentStatus_name = map[int32]string{
+		0: "PAYMENT_STATUS_UNSPECIFIED",
+		1: "PAYMENT_STATUS_AUTHORIZED",
+		2: "PAYMENT_STATUS_CAPTURED",
+		3: "PAYMENT_STATUS_VOIDED",
+		4: "PAYMENT_STATUS_REFUNDED",
 	}
-	return 0
+	PaymentStatus_value = map[string]int32{
+		"PAYMENT_STATUS_UNSPECIFIED": 0,
+		"PAYMENT_STATUS_AUTHORIZED":  1,
+		"PAYMENT_STATUS_CAPTURED":    2,
+		"PAYMENT_STATUS_VOIDED":      3,
+		"PAYMENT_STATUS_REFUNDED":    4,
+	}
+)
+
+func (x PaymentStatus) Enum() *PaymentStatus {
+	p := new(PaymentStatus)
+	*p = x
+	return p
 }
 
-func (x *Ticket) GetSeat() string {
-	if x != nil {
-		return x.Seat
-	}
-	return ""
+func (x PaymentStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-type User struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (PaymentStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_train_proto_enumTypes[1].Descriptor()
+}
 
-	FirstName string `protobuf:"bytes,1,opt,name=firstName,proto3" json:"firstName,omitempty"`
-	LastName  string `protobuf:"bytes,2,opt,name=lastName,proto3" json:"lastName,omitempty"`
-	Email     string `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+func (PaymentStatus) Type() protoreflect.EnumType {
+	return &file_proto_train_proto_enumTypes[1]
 }
 
-func (x *User) Reset() {
-	*x = User{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_train_proto_msgTypes[1]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+func (x PaymentStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *User) String() string {
-	return protoimpl.X.MessageStringOf(x)
+// Deprecated: Use PaymentStatus.Descriptor instead.
+func (PaymentStatus) EnumDescriptor() ([]byte, []int) {
+	return file_proto_train_proto_rawDescGZIP(), []int{1}
 }
 
-func (*User) ProtoMessage() {}
+type SeatClass int32
 
-func (x *User) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_train_proto_msgTypes[1]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+const (
+	SeatClass_SEAT_CLASS_UNSPECIFIED SeatClass = 0
+	SeatClass_SEAT_CLASS_STANDARD    SeatClass = 1
+	SeatClass_SEAT_CLASS_FIRST       SeatClass = 2
+)
+
+// Enum value maps for SeatClass.
+var (
+	SeatClass_name = map[int32]string{
+		0: "SEAT_CLASS_UNSPECIFIED",
+		1: "SEAT_CLASS_STANDARD",
+		2: "SEAT_CLASS_FIRST",
 	}
-	return mi.MessageOf(x)
-}
+	SeatClass_value = map[string]int32{
+		"SEAT_CLASS_UNSPECIFIED": 0,
+		"SEAT_CLASS_STANDARD":    1,
+		"SEAT_CLASS_FIRST":       2,
+	}
+)
 
-// Deprecated: Use User.ProtoReflect.Descriptor instead.
-func (*User) Descriptor() ([]byte, []int) {
-	return file_proto_train_proto_rawDescGZIP(), []int{1}
+func (x SeatClass) Enum() *SeatClass {
+	p := new(SeatClass)
+	*p = x
+	return p
 }
 
-func (x *User) GetFirstName() string {
-	if x != nil {
-		return x.FirstName
-	}
-	return ""
+func (x SeatClass) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (x *User) GetLastName() string {
-	if x != nil {
-		return x.LastName
-	}
-	return ""
+func (SeatClass) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_train_proto_enumTypes[2].Descriptor()
 }
 
-func (x *User) GetEmail() string {
-	if x != nil {
-		return x.Email
-	}
-	return ""
+func (SeatClass) Type() protoreflect.EnumType {
+	return &file_proto_train_proto_enumTypes[2]
 }
 
-type PurchaseTicketRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x SeatClass) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
 
-	From string `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
-	To   string `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
-	User *User  `protobuf:"bytes,3,opt,name=user,proto3" json:"user,omitempty"`
+// Deprecated: Use SeatClass.Descriptor instead.
+func (SeatClass) EnumDescriptor() ([]byte, []int) {
+	return file_proto_train_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *PurchaseTicketRequest) Reset() {
-	*x = PurchaseTicketRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_train_proto_msgTypes[2]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+type PassengerType int32
+
+const (
+	PassengerType_PASSENGER_TYPE_UNSPECIFIED PassengerType = 0
+	PassengerType_PASSENGER_TYPE_ADULT       PassengerType = 1
+	PassengerType_PASSENGER_TYPE_CHILD       PassengerType = 2
+	PassengerType_PASSENGER_TYPE_SENIOR      PassengerType = 3
+)
+
+// Enum value maps for PassengerType.
+var (
+	PassengerType_name = map[int32]string{
+		0: "PASSENGER_TYPE_UNSPECIFIED",
+		1: "PASSENGER_TYPE_ADULT",
+		2: "PASSENGER_TYPE_CHILD",
+		3: "PASSENGER_TYPE_SENIOR",
 	}
+	PassengerType_value = map[string]int32{
+		"PASSENGER_TYPE_UNSPECIFIED": 0,
+		"PASSENGER_TYPE_ADULT":       1,
+		"PASSENGER_TYPE_CHILD":       2,
+		"PASSENGER_TYPE_SENIOR":      3,
+	}
+)
+
+func (x PassengerType) Enum() *PassengerType {
+	p := new(PassengerType)
+	*p = x
+	return p
 }
 
-func (x *PurchaseTicketRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x PassengerType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (*PurchaseTicketRequest) ProtoMessage() {}
+func (PassengerType) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_train_proto_enumTypes[3].Descriptor()
+}
 
-func (x *PurchaseTicketRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_train_proto_msgTypes[2]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (PassengerType) Type() protoreflect.EnumType {
+	return &file_proto_train_proto_enumTypes[3]
 }
 
-// Deprecated: Use PurchaseTicketRequest.ProtoReflect.Descriptor instead.
-func (*PurchaseTicketRequest) Descriptor() ([]byte, []int) {
-	return file_proto_train_proto_rawDescGZIP(), []int{2}
+func (x PassengerType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *PurchaseTicketRequest) GetFrom() string {
-	if x != nil {
-		return x.From
-	}
-	return ""
+// Deprecated: Use PassengerType.Descriptor instead.
+func (PassengerType) EnumDescriptor() ([]byte, []int) {
+	return file_proto_train_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *PurchaseTicketRequest) GetTo() string {
-	if x != nil {
-		return x.To
+// JourneyStatus is set by operations, a journey runs to its timetable until
+// it is delayed or cancelled. A cancelled journey is retired too.
+type JourneyStatus int32
+
+const (
+	JourneyStatus_JOURNEY_STATUS_UNSPECIFIED JourneyStatus = 0
+	JourneyStatus_JOURNEY_STATUS_DELAYED     JourneyStatus = 1
+	JourneyStatus_JOURNEY_STATUS_CANCELLED   JourneyStatus = 2
+)
+
+// Enum value maps for JourneyStatus.
+var (
+	JourneyStatus_name = map[int32]string{
+		0: "JOURNEY_STATUS_UNSPECIFIED",
+		1: "JOURNEY_STATUS_DELAYED",
+		2: "JOURNEY_STATUS_CANCELLED",
 	}
-	return ""
+	JourneyStatus_value = map[string]int32{
+		"JOURNEY_STATUS_UNSPECIFIED": 0,
+		"JOURNEY_STATUS_DELAYED":     1,
+		"JOURNEY_STATUS_CANCELLED":   2,
+	}
+)
+
+func (x JourneyStatus) Enum() *JourneyStatus {
+	p := new(JourneyStatus)
+	*p = x
+	return p
 }
 
-func (x *PurchaseTicketRequest) GetUser() *User {
-	if x != nil {
-		return x.User
-	}
-	return nil
+func (x JourneyStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-type PurchaseTicketResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (JourneyStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_train_proto_enumTypes[4].Descriptor()
+}
 
-	Ticket *Ticket `protobuf:"bytes,1,opt,name=ticket,proto3" json:"ticket,omitempty"`
+func (JourneyStatus) Type() protoreflect.EnumType {
+	return &file_proto_train_proto_enumTypes[4]
 }
 
-func (x *PurchaseTicketResponse) Reset() {
-	*x = PurchaseTicketResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_train_proto_msgTypes[3]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+func (x JourneyStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *PurchaseTicketResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+// Deprecated: Use JourneyStatus.Descriptor instead.
+func (JourneyStatus) EnumDescriptor() ([]byte, []int) {
+	return file_proto_train_proto_rawDescGZIP(), []int{4}
 }
 
-func (*PurchaseTicketResponse) ProtoMessage() {}
+type SeatPosition int32
 
-func (x *PurchaseTicketResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_train_proto_msgTypes[3]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+const (
+	SeatPosition_SEAT_POSITION_UNSPECIFIED SeatPosition = 0
+	SeatPosition_SEAT_POSITION_WINDOW      SeatPosition = 1
+	SeatPosition_SEAT_POSITION_AISLE       SeatPosition = 2
+)
+
+// Enum value maps for SeatPosition.
+var (
+	SeatPosition_name = map[int32]string{
+		0: "SEAT_POSITION_UNSPECIFIED",
+		1: "SEAT_POSITION_WINDOW",
+		2: "SEAT_POSITION_AISLE",
 	}
-	return mi.MessageOf(x)
-}
+	SeatPosition_value = map[string]int32{
+		"SEAT_POSITION_UNSPECIFIED": 0,
+		"SEAT_POSITION_WINDOW":      1,
+		"SEAT_POSITION_AISLE":       2,
+	}
+)
 
-// Deprecated: Use PurchaseTicketResponse.ProtoReflect.Descriptor instead.
-func (*PurchaseTicketResponse) Descriptor() ([]byte, []int) {
-	return file_proto_train_proto_rawDescGZIP(), []int{3}
+func (x SeatPosition) Enum() *SeatPosition {
+	p := new(SeatPosition)
+	*p = x
+	return p
 }
 
-func (x *PurchaseTicketResponse) GetTicket() *Ticket {
-	if x != nil {
-		return x.Ticket
-	}
-	return nil
+func (x SeatPosition) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-type GetTicketRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (SeatPosition) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_train_proto_enumTypes[5].Descriptor()
+}
 
-	Email string `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+func (SeatPosition) Type() protoreflect.EnumType {
+	return &file_proto_train_proto_enumTypes[5]
 }
 
-func (x *GetTicketRequest) Reset() {
-	*x = GetTicketRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_train_proto_msgTypes[4]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+func (x SeatPosition) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *GetTicketRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+// Deprecated: Use SeatPosition.Descriptor instead.
+func (SeatPosition) EnumDescriptor() ([]byte, []int) {
+	return file_proto_train_proto_rawDescGZIP(), []int{5}
 }
 
-func (*GetTicketRequest) ProtoMessage() {}
+// GroupSeating is what a group booking falls back to when the group does
+// not fit in adjacent seats.
+type GroupSeating int32
 
-func (x *GetTicketRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_train_proto_msgTypes[4]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+const (
+	GroupSeating_GROUP_SEATING_UNSPECIFIED   GroupSeating = 0
+	GroupSeating_GROUP_SEATING_ANYWHERE      GroupSeating = 1
+	GroupSeating_GROUP_SEATING_SAME_COACH    GroupSeating = 2
+	GroupSeating_GROUP_SEATING_ADJACENT_ONLY GroupSeating = 3
+)
+
+// Enum value maps for GroupSeating.
+var (
+	GroupSeating_name = map[int32]string{
+		0: "GROUP_SEATING_UNSPECIFIED",
+		1: "GROUP_SEATING_ANYWHERE",
+		2: "GROUP_SEATING_SAME_COACH",
+		3: "GROUP_SEATING_ADJACENT_ONLY",
 	}
-	return mi.MessageOf(x)
+	GroupSeating_value = map[string]int32{
+		"GROUP_SEATING_UNSPECIFIED":   0,
+		"GROUP_SEATING_ANYWHERE":      1,
+		"GROUP_SEATING_SAME_COACH":    2,
+		"GROUP_SEATING_ADJACENT_ONLY": 3,
+	}
+)
+
+func (x GroupSeating) Enum() *GroupSeating {
+	p := new(GroupSeating)
+	*p = x
+	return p
 }
 
-// Deprecated: Use GetTicketRequest.ProtoReflect.Descriptor instead.
-func (*GetTicketRequest) Descriptor() ([]byte, []int) {
-	return file_proto_train_proto_rawDescGZIP(), []int{4}
+func (x GroupSeating) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (x *GetTicketRequest) GetEmail() string {
-	if x != nil {
-		return x.Email
+func (GroupSeating) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_train_proto_enumTypes[6].Descriptor()
+}
+
+func (GroupSeating) Type() protoreflect.EnumType {
+	return &file_proto_train_proto_enumTypes[6]
+}
+
+func (x GroupSeating) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GroupSeating.Descriptor instead.
+func (GroupSeating) EnumDescriptor() ([]byte, []int) {
+	return file_proto_train_proto_rawDescGZIP(), []int{6}
+}
+
+type ReceiptFormat int32
+
+const (
+	ReceiptFormat_RECEIPT_FORMAT_UNSPECIFIED ReceiptFormat = 0
+	ReceiptFormat_RECEIPT_FORMAT_TEXT        ReceiptFormat = 1
+	ReceiptFormat_RECEIPT_FORMAT_HTML        ReceiptFormat = 2
+	ReceiptFormat_RECEIPT_FORMAT_PDF         ReceiptFormat = 3
+)
+
+// Enum value maps for ReceiptFormat.
+var (
+	ReceiptFormat_name = map[int32]string{
+		0: "RECEIPT_FORMAT_UNSPECIFIED",
+		1: "RECEIPT_FORMAT_TEXT",
+		2: "RECEIPT_FORMAT_HTML",
+		3: "RECEIPT_FORMAT_PDF",
 	}
-	return ""
+	ReceiptFormat_value = map[string]int32{
+		"RECEIPT_FORMAT_UNSPECIFIED": 0,
+		"RECEIPT_FORMAT_TEXT":        1,
+		"RECEIPT_FORMAT_HTML":        2,
+		"RECEIPT_FORMAT_PDF":         3,
+	}
+)
+
+func (x ReceiptFormat) Enum() *ReceiptFormat {
+	p := new(ReceiptFormat)
+	*p = x
+	return p
 }
 
-type GetTicketResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x ReceiptFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
 
-	Ticket *Ticket `protobuf:"bytes,1,opt,name=ticket,proto3" json:"ticket,omitempty"`
+func (ReceiptFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_train_proto_enumTypes[7].Descriptor()
 }
 
-func (x *GetTicketResponse) Reset() {
-	*x = GetTicketResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_train_proto_msgTypes[5]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (ReceiptFormat) Type() protoreflect.EnumType {
+	return &file_proto_train_proto_enumTypes[7]
+}
+
+func (x ReceiptFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ReceiptFormat.Descriptor instead.
+func (ReceiptFormat) EnumDescriptor() ([]byte, []int) {
+	return file_proto_train_proto_rawDescGZIP(), []int{7}
+}
+
+type JourneySort int32
+
+const (
+	JourneySort_JOURNEY_SORT_UNSPECIFIED JourneySort = 0
+	JourneySort_JOURNEY_SORT_DEPARTURE   JourneySort = 1
+	JourneySort_JOURNEY_SORT_PRICE       JourneySort = 2
+)
+
+// Enum value maps for JourneySort.
+var (
+	JourneySort_name = map[int32]string{
+		0: "JOURNEY_SORT_UNSPECIFIED",
+		1: "JOURNEY_SORT_DEPARTURE",
+		2: "JOURNEY_SORT_PRICE",
+	}
+	JourneySort_value = map[string]int32{
+		"JOURNEY_SORT_UNSPECIFIED": 0,
+		"JOURNEY_SORT_DEPARTURE":   1,
+		"JOURNEY_SORT_PRICE":       2,
 	}
+)
+
+func (x JourneySort) Enum() *JourneySort {
+	p := new(JourneySort)
+	*p = x
+	return p
 }
 
-func (x *GetTicketResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x JourneySort) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (*GetTicketResponse) ProtoMessage() {}
+func (JourneySort) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_train_proto_enumTypes[8].Descriptor()
+}
 
-func (x *GetTicketResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_train_proto_msgTypes[5]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (JourneySort) Type() protoreflect.EnumType {
+	return &file_proto_train_proto_enumTypes[8]
+}
+
+func (x JourneySort) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use JourneySort.Descriptor instead.
+func (JourneySort) EnumDescriptor() ([]byte, []int) {
+	return file_proto_train_proto_rawDescGZIP(), []int{8}
+}
+
+// PassType is a season ticket, valid for any number of rides between two
+// stations for a date range, or a carnet of a fixed number of rides.
+type PassType int32
+
+const (
+	PassType_PASS_TYPE_UNSPECIFIED PassType = 0
+	PassType_PASS_TYPE_SEASON      PassType = 1
+	PassType_PASS_TYPE_CARNET      PassType = 2
+)
+
+// Enum value maps for PassType.
+var (
+	PassType_name = map[int32]string{
+		0: "PASS_TYPE_UNSPECIFIED",
+		1: "PASS_TYPE_SEASON",
+		2: "PASS_TYPE_CARNET",
 	}
-	return mi.MessageOf(x)
+	PassType_value = map[string]int32{
+		"PASS_TYPE_UNSPECIFIED": 0,
+		"PASS_TYPE_SEASON":      1,
+		"PASS_TYPE_CARNET":      2,
+	}
+)
+
+func (x PassType) Enum() *PassType {
+	p := new(PassType)
+	*p = x
+	return p
 }
 
-// Deprecated: Use GetTicketResponse.ProtoReflect.Descriptor instead.
-func (*GetTicketResponse) Descriptor() ([]byte, []int) {
-	return file_proto_train_proto_rawDescGZIP(), []int{5}
+func (x PassType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (x *GetTicketResponse) GetTicket() *Ticket {
-	if x != nil {
-		return x.Ticket
+func (PassType) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_train_proto_enumTypes[9].Descriptor()
+}
+
+func (PassType) Type() protoreflect.EnumType {
+	return &file_proto_train_proto_enumTypes[9]
+}
+
+func (x PassType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PassType.Descriptor instead.
+func (PassType) EnumDescriptor() ([]byte, []int) {
+	return file_proto_train_proto_rawDescGZIP(), []int{9}
+}
+
+// DisruptionAction is what a delay or cancellation did to a ticket.
+type DisruptionAction int32
+
+const (
+	DisruptionAction_DISRUPTION_ACTION_UNSPECIFIED DisruptionAction = 0
+	DisruptionAction_DISRUPTION_ACTION_DELAYED     DisruptionAction = 1
+	DisruptionAction_DISRUPTION_ACTION_REBOOKED    DisruptionAction = 2
+	DisruptionAction_DISRUPTION_ACTION_REFUNDED    DisruptionAction = 3
+)
+
+// Enum value maps for DisruptionAction.
+var (
+	DisruptionAction_name = map[int32]string{
+		0: "DISRUPTION_ACTION_UNSPECIFIED",
+		1: "DISRUPTION_ACTION_DELAYED",
+		2: "DISRUPTION_ACTION_REBOOKED",
+		3: "DISRUPTION_ACTION_REFUNDED",
 	}
-	return nil
+	DisruptionAction_value = map[string]int32{
+		"DISRUPTION_ACTION_UNSPECIFIED": 0,
+		"DISRUPTION_ACTION_DELAYED":     1,
+		"DISRUPTION_ACTION_REBOOKED":    2,
+		"DISRUPTION_ACTION_REFUNDED":    3,
+	}
+)
+
+func (x DisruptionAction) Enum() *DisruptionAction {
+	p := new(DisruptionAction)
+	*p = x
+	return p
 }
 
-type GetSeatsBySectionRequest struct {
+func (x DisruptionAction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DisruptionAction) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_train_proto_enumTypes[10].Descriptor()
+}
+
+func (DisruptionAction) Type() protoreflect.EnumType {
+	return &file_proto_train_proto_enumTypes[10]
+}
+
+func (x DisruptionAction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DisruptionAction.Descriptor instead.
+func (DisruptionAction) EnumDescriptor() ([]byte, []int) {
+	return file_proto_train_proto_rawDescGZIP(), []int{10}
+}
+
+type Ticket struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Section string `protobuf:"bytes,1,opt,name=section,proto3" json:"section,omitempty"`
+	From               string                 `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To                 string                 `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	User               *User                  `protobuf:"bytes,3,opt,name=user,proto3" json:"user,omitempty"`
+	Seat               string                 `protobuf:"bytes,5,opt,name=seat,proto3" json:"seat,omitempty"`
+	JourneyId          string                 `protobuf:"bytes,6,opt,name=journeyId,proto3" json:"journeyId,omitempty"`
+	Price              *Money                 `protobuf:"bytes,7,opt,name=price,proto3" json:"price,omitempty"`
+	FareBreakdown      []*FareComponent       `protobuf:"bytes,8,rep,name=fareBreakdown,proto3" json:"fareBreakdown,omitempty"`
+	SeatClass          SeatClass              `protobuf:"varint,9,opt,name=seatClass,proto3,enum=train.SeatClass" json:"seatClass,omitempty"`
+	PassengerType      PassengerType          `protobuf:"varint,10,opt,name=passengerType,proto3,enum=train.PassengerType" json:"passengerType,omitempty"`
+	Reference          string                 `protobuf:"bytes,11,opt,name=reference,proto3" json:"reference,omitempty"`
+	GroupReference     string                 `protobuf:"bytes,12,opt,name=groupReference,proto3" json:"groupReference,omitempty"`
+	PreviousHolders    []*PreviousHolder      `protobuf:"bytes,13,rep,name=previousHolders,proto3" json:"previousHolders,omitempty"`
+	NonRefundable      bool                   `protobuf:"varint,14,opt,name=nonRefundable,proto3" json:"nonRefundable,omitempty"`
+	Cancellation       *Cancellation          `protobuf:"bytes,15,opt,name=cancellation,proto3" json:"cancellation,omitempty"`
+	PaymentId          string                 `protobuf:"bytes,16,opt,name=paymentId,proto3" json:"paymentId,omitempty"`
+	PaymentStatus      PaymentStatus          `protobuf:"varint,17,opt,name=paymentStatus,proto3,enum=train.PaymentStatus" json:"paymentStatus,omitempty"`
+	InvoiceNumber      string                 `protobuf:"bytes,18,opt,name=invoiceNumber,proto3" json:"invoiceNumber,omitempty"`
+	InvoicedAt         *timestamppb.Timestamp `protobuf:"bytes,19,opt,name=invoicedAt,proto3" json:"invoicedAt,omitempty"`
+	Purchaser          *Purchaser             `protobuf:"bytes,20,opt,name=purchaser,proto3" json:"purchaser,omitempty"`
+	UpgradePayments    []*Payment             `protobuf:"bytes,21,rep,name=upgradePayments,proto3" json:"upgradePayments,omitempty"`
+	TripReference      string                 `protobuf:"bytes,22,opt,name=tripReference,proto3" json:"tripReference,omitempty"`
+	TripLeg            TripLeg                `protobuf:"varint,23,opt,name=tripLeg,proto3,enum=train.TripLeg" json:"tripLeg,omitempty"`
+	ItineraryReference string                 `protobuf:"bytes,24,opt,name=itineraryReference,proto3" json:"itineraryReference,omitempty"`
+	ItineraryLeg       int32                  `protobuf:"varint,25,opt,name=itineraryLeg,proto3" json:"itineraryLeg,omitempty"`
+	PassId             string                 `protobuf:"bytes,26,opt,name=passId,proto3" json:"passId,omitempty"`
 }
 
-func (x *GetSeatsBySectionRequest) Reset() {
-	*x = GetSeatsBySectionRequest{}
+func (x *Ticket) Reset() {
+	*x = Ticket{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_train_proto_msgTypes[6]
+		mi := &file_proto_train_proto_msgTypes[0]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetSeatsBySectionRequest) String() string {
+func (x *Ticket) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSeatsBySectionRequest) ProtoMessage() {}
+func (*Ticket) ProtoMessage() {}
 
-func (x *GetSeatsBySectionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_train_proto_msgTypes[6]
+func (x *Ticket) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_train_proto_msgTypes[0]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -401,410 +646,8442 @@ func (x *GetSeatsBySectionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSeatsBySectionRequest.ProtoReflect.Descriptor instead.
-func (*GetSeatsBySectionRequest) Descriptor() ([]byte, []int) {
-	return file_proto_train_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use Ticket.ProtoReflect.Descriptor instead.
+func (*Ticket) Descriptor() ([]byte, []int) {
+	return file_proto_train_proto_rawDescGZIP(), []int{0}
 }
 
-func (x *GetSeatsBySectionRequest) GetSection() string {
+func (x *Ticket) GetFrom() string {
 	if x != nil {
-		return x.Section
+		return x.From
 	}
 	return ""
 }
 
-type GetSeatsBySectionResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *Ticket) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
 
-	Seats map[string]string `protobuf:"bytes,1,rep,name=seats,proto3" json:"seats,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+func (x *Ticket) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
 }
 
-func (x *GetSeatsBySectionResponse) Reset() {
-	*x = GetSeatsBySectionResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_train_proto_msgTypes[7]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *Ticket) GetSeat() string {
+	if x != nil {
+		return x.Seat
 	}
+	return ""
 }
 
-func (x *GetSeatsBySectionResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Ticket) GetJourneyId() string {
+	if x != nil {
+		return x.JourneyId
+	}
+	return ""
 }
 
-func (*GetSeatsBySectionResponse) ProtoMessage() {}
+func (x *Ticket) GetPrice() *Money {
+	if x != nil {
+		return x.Price
+	}
+	return nil
+}
 
-func (x *GetSeatsBySectionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_train_proto_msgTypes[7]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *Ticket) GetFareBreakdown() []*FareComponent {
+	if x != nil {
+		return x.FareBreakdown
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use GetSeatsBySectionResponse.ProtoReflect.Descriptor instead.
-func (*GetSeatsBySectionResponse) Descriptor() ([]byte, []int) {
-	return file_proto_train_proto_rawDescGZIP(), []int{7}
+func (x *Ticket) GetSeatClass() SeatClass {
+	if x != nil {
+		return x.SeatClass
+	}
+	return SeatClass_SEAT_CLASS_UNSPECIFIED
 }
 
-func (x *GetSeatsBySectionResponse) GetSeats() map[string]string {
+func (x *Ticket) GetPassengerType() PassengerType {
 	if x != nil {
-		return x.Seats
+		return x.PassengerType
+	}
+	return PassengerType_PASSENGER_TYPE_UNSPECIFIED
+}
+
+func (x *Ticket) GetReference() string {
+	if x != nil {
+		return x.Reference
+	}
+	return ""
+}
+
+func (x *Ticket) GetGroupReference() string {
+	if x != nil {
+		return x.GroupReference
+	}
+	return ""
+}
+
+func (x *Ticket) GetPreviousHolders() []*PreviousHolder {
+	if x != nil {
+		return x.PreviousHolders
 	}
 	return nil
 }
 
-type RemoveUserRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *Ticket) GetNonRefundable() bool {
+	if x != nil {
+		return x.NonRefundable
+	}
+	return false
+}
 
-	Email string `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+func (x *Ticket) GetCancellation() *Cancellation {
+	if x != nil {
+		return x.Cancellation
+	}
+	return nil
 }
 
-func (x *RemoveUserRequest) Reset() {
-	*x = RemoveUserRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_train_proto_msgTypes[8]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *Ticket) GetPaymentId() string {
+	if x != nil {
+		return x.PaymentId
 	}
+	return ""
 }
 
-func (x *RemoveUserRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Ticket) GetPaymentStatus() PaymentStatus {
+	if x != nil {
+		return x.PaymentStatus
+	}
+	return PaymentStatus_PAYMENT_STATUS_UNSPECIFIED
 }
 
-func (*RemoveUserRequest) ProtoMessage() {}
+func (x *Ticket) GetInvoiceNumber() string {
+	if x != nil {
+		return x.InvoiceNumber
+	}
+	return ""
+}
 
-func (x *RemoveUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_train_proto_msgTypes[8]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
+func (x *Ticket) GetInvoicedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.InvoicedAt
+	}
+	return nil
+}
+
+func (x *Ticket) GetPurchaser() *Purchaser {
+	if x != nil {
+		return x.Purchaser
+	}
+	return nil
+}
+
+func (x *Ticket) GetUpgradePayments() []*Payment {
+	if x != nil {
+		return x.UpgradePayments
+	}
+	return nil
+}
+
+func (x *Ticket) GetTripReference() string {
+	if x != nil {
+		return x.TripReference
+	}
+	return ""
+}
+
+func (x *Ticket) GetTripLeg() TripLeg {
+	if x != nil {
+		return x.TripLeg
+	}
+	return TripLeg_TRIP_LEG_UNSPECIFIED
+}
+
+func (x *Ticket) GetItineraryReference() string {
+	if x != nil {
+		return x.ItineraryReference
+	}
+	return ""
+}
+
+func (x *Ticket) GetItineraryLeg() int32 {
+	if x != nil {
+		return x.ItineraryLeg
+	}
+	return 0
+}
+
+func (x *Ticket) GetPassId() string {
+	if x != nil {
+		return x.PassId
+	}
+	return ""
+}
+
+// Payment is an extra payment taken for a ticket after it was sold. It gets
+// its own invoice number once captured, the sale's invoice is not changed.
+type Payment struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Amount        *Money                 `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Status        PaymentStatus          `protobuf:"varint,3,opt,name=status,proto3,enum=train.PaymentStatus" json:"status,omitempty"`
+	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	InvoiceNumber string                 `protobuf:"bytes,5,opt,name=invoiceNumber,proto3" json:"invoiceNumber,omitempty"`
+	InvoicedAt    *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=invoicedAt,proto3" json:"invoicedAt,omitempty"`
+}
+
+func (x *Payment) Reset() {
+	*x = Payment{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_train_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Payment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Payment) ProtoMessage() {}
+
+func (x *Payment) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_train_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Payment.ProtoReflect.Descriptor instead.
+func (*Payment) Descriptor() ([]byte, []int) {
+	return file_proto_train_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Payment) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Payment) GetAmount() *Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *Payment) GetStatus() PaymentStatus {
+	if x != nil {
+		return x.Status
+	}
+	return PaymentStatus_PAYMENT_STATUS_UNSPECIFIED
+}
+
+func (x *Payment) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Payment) GetInvoiceNumber() string {
+	if x != nil {
+		return x.InvoiceNumber
+	}
+	return ""
+}
+
+func (x *Payment) GetInvoicedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.InvoicedAt
+	}
+	return nil
+}
+
+// Cancellation records what was refunded when a ticket was cancelled.
+type Cancellation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CancelledAt *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=cancelledAt,proto3" json:"cancelledAt,omitempty"`
+	Refund      *Money                 `protobuf:"bytes,2,opt,name=refund,proto3" json:"refund,omitempty"`
+	Fee         *Money                 `protobuf:"bytes,3,opt,name=fee,proto3" json:"fee,omitempty"`
+	Rule        string                 `protobuf:"bytes,4,opt,name=rule,proto3" json:"rule,omitempty"`
+}
+
+func (x *Cancellation) Reset() {
+	*x = Cancellation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_train_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Cancellation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Cancellation) ProtoMessage() {}
+
+func (x *Cancellation) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_train_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Cancellation.ProtoReflect.Descriptor instead.
+func (*Cancellation) Descriptor() ([]byte, []int) {
+	return file_proto_train_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Cancellation) GetCancelledAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CancelledAt
+	}
+	return nil
+}
+
+func (x *Cancellation) GetRefund() *Money {
+	if x != nil {
+		return x.Refund
+	}
+	return nil
+}
+
+func (x *Cancellation) GetFee() *Money {
+	if x != nil {
+		return x.Fee
+	}
+	return nil
+}
+
+func (x *Cancellation) GetRule() string {
+	if x != nil {
+		return x.Rule
+	}
+	return ""
+}
+
+// PreviousHolder records who held a ticket before it was transferred.
+type PreviousHolder struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	Reference     string                 `protobuf:"bytes,2,opt,name=reference,proto3" json:"reference,omitempty"`
+	TransferredAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=transferredAt,proto3" json:"transferredAt,omitempty"`
+}
+
+func (x *PreviousHolder) Reset() {
+	*x = PreviousHolder{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_train_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PreviousHolder) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreviousHolder) ProtoMessage() {}
+
+func (x *PreviousHolder) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_train_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreviousHolder.ProtoReflect.Descriptor instead.
+func (*PreviousHolder) Descriptor() ([]byte, []int) {
+	return file_proto_train_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *PreviousHolder) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *PreviousHolder) GetReference() string {
+	if x != nil {
+		return x.Reference
+	}
+	return ""
+}
+
+func (x *PreviousHolder) GetTransferredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.TransferredAt
+	}
+	return nil
+}
+
+// Money is an amount in the minor units of its currency, pence for GBP.
+type Money struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Currency   string `protobuf:"bytes,1,opt,name=currency,proto3" json:"currency,omitempty"`
+	MinorUnits int64  `protobuf:"varint,2,opt,name=minorUnits,proto3" json:"minorUnits,omitempty"`
+}
+
+func (x *Money) Reset() {
+	*x = Money{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_train_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Money) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Money) ProtoMessage() {}
+
+func (x *Money) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_train_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Money.ProtoReflect.Descriptor instead.
+func (*Money) Descriptor() ([]byte, []int) {
+	return file_proto_train_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Money) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *Money) GetMinorUnits() int64 {
+	if x != nil {
+		return x.MinorUnits
+	}
+	return 0
+}
+
+type FareComponent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Description string `protobuf:"bytes,1,opt,name=description,proto3" json:"description,omitempty"`
+	Amount      *Money `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (x *FareComponent) Reset() {
+	*x = FareComponent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_train_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FareComponent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FareComponent) ProtoMessage() {}
+
+func (x *FareComponent) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_train_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FareComponent.ProtoReflect.Descriptor instead.
+func (*FareComponent) Descriptor() ([]byte, []int) {
+	return file_proto_train_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *FareComponent) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *FareComponent) GetAmount() *Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+type Journey struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	TrainNumber string                 `protobuf:"bytes,2,opt,name=trainNumber,proto3" json:"trainNumber,omitempty"`
+	Departure   *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=departure,proto3" json:"departure,omitempty"`
+	Origin      string                 `protobuf:"bytes,4,opt,name=origin,proto3" json:"origin,omitempty"`
+	Destination string                 `protobuf:"bytes,5,opt,name=destination,proto3" json:"destination,omitempty"`
+	Retired     bool                   `protobuf:"varint,6,opt,name=retired,proto3" json:"retired,omitempty"`
+	Stops       []*Stop                `protobuf:"bytes,7,rep,name=stops,proto3" json:"stops,omitempty"`
+	Status      JourneyStatus          `protobuf:"varint,8,opt,name=status,proto3,enum=train.JourneyStatus" json:"status,omitempty"`
+	// delay is how late the journey runs against its timetable and
+	// estimatedArrival when it now reaches its last stop.
+	Delay            *durationpb.Duration   `protobuf:"bytes,9,opt,name=delay,proto3" json:"delay,omitempty"`
+	EstimatedArrival *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=estimatedArrival,proto3" json:"estimatedArrival,omitempty"`
+	DisruptionReason string                 `protobuf:"bytes,11,opt,name=disruptionReason,proto3" json:"disruptionReason,omitempty"`
+}
+
+func (x *Journey) Reset() {
+	*x = Journey{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_train_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Journey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Journey) ProtoMessage() {}
+
+func (x *Journey) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_train_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Journey.ProtoReflect.Descriptor instead.
+func (*Journey) Descriptor() ([]byte, []int) {
+	return file_proto_train_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *Journey) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Journey) GetTrainNumber() string {
+	if x != nil {
+		return x.TrainNumber
+	}
+	return ""
+}
+
+func (x *Journey) GetDeparture() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Departure
+	}
+	return nil
+}
+
+func (x *Journey) GetOrigin() string {
+	if x != nil {
+		return x.Origin
+	}
+	return ""
+}
+
+func (x *Journey) GetDestination() string {
+	if x != nil {
+		return x.Destination
+	}
+	return ""
+}
+
+func (x *Journey) GetRetired() bool {
+	if x != nil {
+		return x.Retired
+	}
+	return false
+}
+
+func (x *Journey) GetStops() []*Stop {
+	if x != nil {
+		return x.Stops
+	}
+	return nil
+}
+
+func (x *Journey) GetStatus() JourneyStatus {
+	if x != nil {
+		return x.Status
+	}
+	return JourneyStatus_JOURNEY_STATUS_UNSPECIFIED
+}
+
+func (x *Journey) GetDelay() *durationpb.Duration {
+	if x != nil {
+		return x.Delay
+	}
+	return nil
+}
+
+func (x *Journey) GetEstimatedArrival() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EstimatedArrival
+	}
+	return nil
+}
+
+func (x *Journey) GetDisruptionReason() string {
+	if x != nil {
+		return x.DisruptionReason
+	}
+	return ""
+}
+
+// Stop is a station a journey calls at, in travel order.
+type Stop struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Station   string                 `protobuf:"bytes,1,opt,name=station,proto3" json:"station,omitempty"`
+	Arrival   *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=arrival,proto3" json:"arrival,omitempty"`
+	Departure *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=departure,proto3" json:"departure,omitempty"`
+}
+
+func (x *Stop) Reset() {
+	*x = Stop{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_train_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Stop) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Stop) ProtoMessage() {}
+
+func (x *Stop) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_train_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Stop.ProtoReflect.Descriptor instead.
+func (*Stop) Descriptor() ([]byte, []int) {
+	return file_proto_train_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *Stop) GetStation() string {
+	if x != nil {
+		return x.Station
+	}
+	return ""
+}
+
+func (x *Stop) GetArrival() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Arrival
+	}
+	return nil
+}
+
+func (x *Stop) GetDeparture() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Departure
+	}
+	return nil
+}
+
+// SeatLayout describes the coaches of a train and the seats in them.
+type SeatLayout struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Coaches []*Coach `protobuf:"bytes,1,rep,name=coaches,proto3" json:"coaches,omitempty"`
+}
+
+func (x *SeatLayout) Reset() {
+	*x = SeatLayout{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_train_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SeatLayout) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SeatLayout) ProtoMessage() {}
+
+func (x *SeatLayout) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_train_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SeatLayout.ProtoReflect.Descriptor instead.
+func (*SeatLayout) Descriptor() ([]byte, []int) {
+	return file_proto_train_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *SeatLayout) GetCoaches() []*Coach {
+	if x != nil {
+		return x.Coaches
+	}
+	return nil
+}
+
+// Coach either lists its seats explicitly or generates them from rows and
+// columns, numbering seats row by row after the coach code (A1, A2, ...).
+type Coach struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code         string            `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Rows         int32             `protobuf:"varint,2,opt,name=rows,proto3" json:"rows,omitempty"`
+	Columns      string            `protobuf:"bytes,3,opt,name=columns,proto3" json:"columns,omitempty"`
+	AisleAfter   int32             `protobuf:"varint,4,opt,name=aisleAfter,proto3" json:"aisleAfter,omitempty"`
+	Seats        []*SeatDefinition `protobuf:"bytes,5,rep,name=seats,proto3" json:"seats,omitempty"`
+	BackwardRows []int32           `protobuf:"varint,6,rep,packed,name=backwardRows,proto3" json:"backwardRows,omitempty"`
+	SeatClass    SeatClass         `protobuf:"varint,7,opt,name=seatClass,proto3,enum=train.SeatClass" json:"seatClass,omitempty"`
+}
+
+func (x *Coach) Reset() {
+	*x = Coach{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_train_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Coach) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Coach) ProtoMessage() {}
+
+func (x *Coach) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_train_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Coach.ProtoReflect.Descriptor instead.
+func (*Coach) Descriptor() ([]byte, []int) {
+	return file_proto_train_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *Coach) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *Coach) GetRows() int32 {
+	if x != nil {
+		return x.Rows
+	}
+	return 0
+}
+
+func (x *Coach) GetColumns() string {
+	if x != nil {
+		return x.Columns
+	}
+	return ""
+}
+
+func (x *Coach) GetAisleAfter() int32 {
+	if x != nil {
+		return x.AisleAfter
+	}
+	return 0
+}
+
+func (x *Coach) GetSeats() []*SeatDefinition {
+	if x != nil {
+		return x.Seats
+	}
+	return nil
+}
+
+func (x *Coach) GetBackwardRows() []int32 {
+	if x != nil {
+		return x.BackwardRows
+	}
+	return nil
+}
+
+func (x *Coach) GetSeatClass() SeatClass {
+	if x != nil {
+		return x.SeatClass
+	}
+	return SeatClass_SEAT_CLASS_UNSPECIFIED
+}
+
+type SeatDefinition struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Row        int32    `protobuf:"varint,2,opt,name=row,proto3" json:"row,omitempty"`
+	Column     string   `protobuf:"bytes,3,opt,name=column,proto3" json:"column,omitempty"`
+	Attributes []string `protobuf:"bytes,4,rep,name=attributes,proto3" json:"attributes,omitempty"`
+}
+
+func (x *SeatDefinition) Reset() {
+	*x = SeatDefinition{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_train_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SeatDefinition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SeatDefinition) ProtoMessage() {}
+
+func (x *SeatDefinition) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_train_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SeatDefinition.ProtoReflect.Descriptor instead.
+func (*SeatDefinition) Descriptor() ([]byte, []int) {
+	return file_proto_train_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *SeatDefinition) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SeatDefinition) GetRow() int32 {
+	if x != nil {
+		return x.Row
+	}
+	return 0
+}
+
+func (x *SeatDefinition) GetColumn() string {
+	if x != nil {
+		return x.Column
+	}
+	return ""
+}
+
+func (x *SeatDefinition) GetAttributes() []string {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+type User struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FirstName string `protobuf:"bytes,1,opt,name=firstName,proto3" json:"firstName,omitempty"`
+	LastName  string `protobuf:"bytes,2,opt,name=lastName,proto3" json:"lastName,omitempty"`
+	Email     string `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+}
+
+func (x *User) Reset() {
+	*x = User{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_train_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *User) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*User) ProtoMessage() {}
+
+func (x *User) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_train_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use User.ProtoReflect.Descriptor instead.
+func (*User) Descriptor() ([]byte, []int) {
+	return file_proto_train_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *User) GetFirstName() string {
+	if x != nil {
+		return x.FirstName
+	}
+	return ""
+}
+
+func (x *User) GetLastName() string {
+	if x != nil {
+		return x.LastName
+	}
+	return ""
+}
+
+func (x *User) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+// Purchaser pays for and manages a booking, the passengers are the users
+// travelling on it. Passengers booked by a purchaser need not have an email.
+type Purchaser struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FirstName      string `protobuf:"bytes,1,opt,name=firstName,proto3" json:"firstName,omitempty"`
+	LastName       string `protobuf:"bytes,2,opt,name=lastName,proto3" json:"lastName,omitempty"`
+	Email          string `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	Phone          string `protobuf:"bytes,4,opt,name=phone,proto3" json:"phone,omitempty"`
+	BillingAddress string `protobuf:"bytes,5,opt,name=billingAddress,proto3" json:"billingAddress,omitempty"`
+}
+
+func (x *Purchaser) Reset() {
+	*x = Purchaser{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_train_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Purchaser) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Purchaser) ProtoMessage() {}
+
+func (x *Purchaser) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_train_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Purchaser.ProtoReflect.Descriptor instead.
+func (*Purchaser) Descriptor() ([]byte, []int) {
+	return file_proto_train_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *Purchaser) GetFirstName() string {
+	if x != nil {
+		return x.FirstName
+	}
+	return ""
+}
+
+func (x *Purchaser) GetLastName() string {
+	if x != nil {
+		return x.LastName
+	}
+	return ""
+}
+
+func (x *Purchaser) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *Purchaser) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+func (x *Purchaser) GetBillingAddress() string {
+	if x != nil {
+		return x.BillingAddress
+	}
+	return ""
+}
+
+type PurchaseTicketRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	From          string           `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To            string           `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	User          *User            `protobuf:"bytes,3,opt,name=user,proto3" json:"user,omitempty"`
+	JourneyId     string           `protobuf:"bytes,4,opt,name=journeyId,proto3" json:"journeyId,omitempty"`
+	PassengerType PassengerType    `protobuf:"varint,5,opt,name=passengerType,proto3,enum=train.PassengerType" json:"passengerType,omitempty"`
+	Preferences   *SeatPreferences `protobuf:"bytes,6,opt,name=preferences,proto3" json:"preferences,omitempty"`
+	JoinWaitlist  bool             `protobuf:"varint,7,opt,name=joinWaitlist,proto3" json:"joinWaitlist,omitempty"`
+	Purchaser     *Purchaser       `protobuf:"bytes,8,opt,name=purchaser,proto3" json:"purchaser,omitempty"`
+	SeatClass     SeatClass        `protobuf:"varint,9,opt,name=seatClass,proto3,enum=train.SeatClass" json:"seatClass,omitempty"`
+	// passId redeems a ride on the passenger's pass instead of paying the
+	// fare, only the difference is paid when seatClass is above the pass's.
+	PassId string `protobuf:"bytes,10,opt,name=passId,proto3" json:"passId,omitempty"`
+}
+
+func (x *PurchaseTicketRequest) Reset() {
+	*x = PurchaseTicketRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_train_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PurchaseTicketRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurchaseTicketRequest) ProtoMessage() {}
+
+func (x *PurchaseTicketRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_train_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurchaseTicketRequest.ProtoReflect.Descriptor instead.
+func (*PurchaseTicketRequest) Descriptor() ([]byte, []int) {
+	return file_proto_train_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *PurchaseTicketRequest) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *PurchaseTicketRequest) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *PurchaseTicketRequest) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *PurchaseTicketRequest) GetJourneyId() string {
+	if x != nil {
+		return x.JourneyId
+	}
+	return ""
+}
+
+func (x *PurchaseTicketRequest) GetPassengerType() PassengerType {
+	if x != nil {
+		return x.PassengerType
+	}
+	return PassengerType_PASSENGER_TYPE_UNSPECIFIED
+}
+
+func (x *PurchaseTicketRequest) GetPreferences() *SeatPreferences {
+	if x != nil {
+		return x.Preferences
+	}
+	return nil
+}
+
+func (x *PurchaseTicketRequest) GetJoinWaitlist() bool {
+	if x != nil {
+		return x.JoinWaitlist
+	}
+	return false
+}
+
+func (x *PurchaseTicketRequest) GetPurchaser() *Purchaser {
+	if x != nil {
+		return x.Purchaser
+	}
+	return nil
+}
+
+func (x *PurchaseTicketRequest) GetSeatClass() SeatClass {
+	if x != nil {
+		return x.SeatClass
+	}
+	return SeatClass_SEAT_CLASS_UNSPECIFIED
+}
+
+func (x *PurchaseTicketRequest) GetPassId() string {
+	if x != nil {
+		return x.PassId
+	}
+	return ""
+}
+
+// SeatPreferences are honoured where possible, the purchase response says
+// which ones were not.
+type SeatPreferences struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Section       string       `protobuf:"bytes,1,opt,name=section,proto3" json:"section,omitempty"`
+	Position      SeatPosition `protobuf:"varint,2,opt,name=position,proto3,enum=train.SeatPosition" json:"position,omitempty"`
+	ForwardFacing bool         `protobuf:"varint,3,opt,name=forwardFacing,proto3" json:"forwardFacing,omitempty"`
+	SeatId        string       `protobuf:"bytes,4,opt,name=seatId,proto3" json:"seatId,omitempty"`
+}
+
+func (x *SeatPreferences) Reset() {
+	*x = SeatPreferences{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_train_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SeatPreferences) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SeatPreferences) ProtoMessage() {}
+
+func (x *SeatPreferences) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_train_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SeatPreferences.ProtoReflect.Descriptor instead.
+func (*SeatPreferences) Descriptor() ([]byte, []int) {
+	return file_proto_train_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *SeatPreferences) GetSection() string {
+	if x != nil {
+		return x.Section
+	}
+	return ""
+}
+
+func (x *SeatPreferences) GetPosition() SeatPosition {
+	if x != nil {
+		return x.Position
+	}
+	return SeatPosition_SEAT_POSITION_UNSPECIFIED
+}
+
+func (x *SeatPreferences) GetForwardFacing() bool {
+	if x != nil {
+		return x.ForwardFacing
+	}
+	return false
+}
+
+func (x *SeatPreferences) GetSeatId() string {
+	if x != nil {
+		return x.SeatId
+	}
+	return ""
+}
+
+// PurchaseTicketResponse carries the ticket, or the waitlist entry when the
+// journey was full and the request asked to join the waitlist.
+type PurchaseTicketResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ticket          *Ticket        `protobuf:"bytes,1,opt,name=ticket,proto3" json:"ticket,omitempty"`
+	AllocationNotes []string       `protobuf:"bytes,2,rep,name=allocationNotes,proto3" json:"allocationNotes,omitempty"`
+	Waitlist        *WaitlistEntry `protobuf:"bytes,3,opt,name=waitlist,proto3" json:"waitlist,omitempty"`
+}
+
+func (x *PurchaseTicketResponse) Reset() {
+	*x = PurchaseTicketResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_train_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PurchaseTicketResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurchaseTicketResponse) ProtoMessage() {}
+
+func (x *PurchaseTicketResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_train_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurchaseTicketResponse.ProtoReflect.Descriptor instead.
+func (*PurchaseTicketResponse) Descriptor() ([]byte, []int) {
+	return file_proto_train_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *PurchaseTicketResponse) GetTicket() *Ticket {
+	if x != nil {
+		return x.Ticket
+	}
+	return nil
+}
+
+func (x *PurchaseTicketResponse) GetAllocationNotes() []string {
+	if x != nil {
+		return x.AllocationNotes
+	}
+	return nil
+}
+
+func (x *PurchaseTicketResponse) GetWaitlist() *WaitlistEntry {
+	if x != nil {
+		return x.Waitlist
+	}
+	return nil
+}
+
+type WaitlistEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	JourneyId string                 `protobuf:"bytes,2,opt,name=journeyId,proto3" json:"journeyId,omitempty"`
+	From      string                 `protobuf:"bytes,3,opt,name=from,proto3" json:"from,omitempty"`
+	To        string                 `protobuf:"bytes,4,opt,name=to,proto3" json:"to,omitempty"`
+	User      *User                  `protobuf:"bytes,5,opt,name=user,proto3" json:"user,omitempty"`
+	Position  int32                  `protobuf:"varint,6,opt,name=position,proto3" json:"position,omitempty"`
+	JoinedAt  *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=joinedAt,proto3" json:"joinedAt,omitempty"`
+}
+
+func (x *WaitlistEntry) Reset() {
+	*x = WaitlistEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_train_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WaitlistEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WaitlistEntry) ProtoMessage() {}
+
+func (x *WaitlistEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_train_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WaitlistEntry.ProtoReflect.Descriptor instead.
+func (*WaitlistEntry) Descriptor() ([]byte, []int) {
+	return file_proto_train_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *WaitlistEntry) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *WaitlistEntry) GetJourneyId() string {
+	if x != nil {
+		return x.JourneyId
+	}
+	return ""
+}
+
+func (x *WaitlistEntry) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *WaitlistEntry) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *WaitlistEntry) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *WaitlistEntry) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *WaitlistEntry) GetJoinedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.JoinedAt
+	}
+	return nil
+}
+
+type GetTicketRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Email     string `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	Reference string `protobuf:"bytes,2,opt,name=reference,proto3" json:"reference,omitempty"`
+}
+
+func (x *GetTicketRequest) Reset() {
+	*x = GetTicketRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_train_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTicketRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTicketRequest) ProtoMessage() {}
+
+func (x *GetTicketRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_train_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTicketRequest.ProtoReflect.Descriptor instead.
+func (*GetTicketRequest) Descriptor() ([]byte, []int) {
+	return file_proto_train_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetTicketRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *GetTicketRequest) GetReference() string {
+	if x != nil {
+		return x.Reference
+	}
+	return ""
+}
+
+// GetTicketResponse lists every leg of the ticket's trip in trip when it is
+// part of a round trip or an itinerary.
+type GetTicketResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ticket *Ticket   `protobuf:"bytes,1,opt,name=ticket,proto3" json:"ticket,omitempty"`
+	Trip   []*Ticket `protobuf:"bytes,2,rep,name=trip,proto3" json:"trip,omitempty"`
+}
+
+func (x *GetTicketResponse) Reset() {
+	*x = GetTicketResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_train_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTicketResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTicketResponse) ProtoMessage() {}
+
+func (x *GetTicketResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_train_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTicketResponse.ProtoReflect.Descriptor instead.
+func (*GetTicketResponse) Descriptor() ([]byte, []int) {
+	return file_proto_train_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GetTicketResponse) GetTicket() *Ticket {
+	if x != nil {
+		return x.Ticket
+	}
+	return nil
+}
+
+func (x *GetTicketResponse) GetTrip() []*Ticket {
+	if x != nil {
+		return x.Trip
+	}
+	return nil
+}
+
+type GetSeatsBySectionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Section   string `protobuf:"bytes,1,opt,name=section,proto3" json:"section,omitempty"`
+	JourneyId string `protobuf:"bytes,2,opt,name=journeyId,proto3" json:"journeyId,omitempty"`
+	From      string `protobuf:"bytes,3,opt,name=from,proto3" json:"from,omitempty"`
+	To        string `protobuf:"bytes,4,opt,name=to,proto3" json:"to,omitempty"`
+}
+
+func (x *GetSeatsBySectionRequest) Reset() {
+	*x = GetSeatsBySectionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_train_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetSeatsBySectionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSeatsBySectionRequest) ProtoMessage() {}
+
+func (x *GetSeatsBySectionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_train_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSeatsBySectionRequest.ProtoReflect.Descriptor instead.
+func (*GetSeatsBySectionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_train_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetSeatsBySectionRequest) GetSection() string {
+	if x != nil {
+		return x.Section
+	}
+	return ""
+}
+
+func (x *GetSeatsBySectionRequest) GetJourneyId() string {
+	if x != nil {
+		return x.JourneyId
+	}
+	return ""
+}
+
+func (x *GetSeatsBySectionRequest) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *GetSeatsBySectionRequest) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+type GetSeatsBySectionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Seats map[string]string `protobuf:"bytes,1,rep,name=seats,proto3" json:"seats,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *GetSeatsBySectionResponse) Reset() {
+	*x = GetSeatsBySectionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_train_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetSeatsBySectionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSeatsBySectionResponse) ProtoMessage() {}
+
+func (x *GetSeatsBySectionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_train_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSeatsBySectionResponse.ProtoReflect.Descriptor instead.
+func (*GetSeatsBySectionResponse) Descriptor() ([]byte, []int) {
+	return file_proto_train_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GetSeatsBySectionResponse) GetSeats() map[string]string {
+	if x != nil {
+		return x.Seats
+	}
+	return nil
+}
+
+type RemoveUserRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Email     string `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	Reference string `protobuf:"bytes,2,opt,name=reference,proto3" json:"reference,omitempty"`
+}
+
+func (x *RemoveUserRequest) Reset() {
+	*x = RemoveUserRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_train_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveUserRequest) ProtoMessage() {}
+
+func (x *RemoveUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_train_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveUserRequest.ProtoReflect.Descriptor instead.
+func (*RemoveUserRequest) Descriptor() ([]byte, []int) {
+	return file_proto_train_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *RemoveUserRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *RemoveUserRequest) GetReference() string {
+	if x != nil {
+		return x.Reference
+	}
+	return ""
+}
+
+type RemoveUserResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success      bool          `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Cancellation *Cancellation `protobuf:"bytes,2,opt,name=cancellation,proto3" json:"cancellation,omitempty"`
+}
+
+func (x *RemoveUserResponse) Reset() {
+	*x = RemoveUserResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_train_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveUserResponse) ProtoMessage() {}
+
+func (x *RemoveUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_train_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveUserResponse.ProtoReflect.Descriptor instead.
+func (*RemoveUserResponse) Descriptor() ([]byte, []int) {
+	return file_proto_train_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *RemoveUserResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *RemoveUserResponse) GetCancellation() *Cancellation {
+	if x != nil {
+		return x.Cancellation
+	}
+	return nil
+}
+
+type ModifySeatRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Email     string `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	NewSeat   string `protobuf:"bytes,2,opt,name=newSeat,proto3" json:"newSeat,omitempty"`
+	JourneyId string `protobuf:"bytes,3,opt,name=journeyId,proto3" json:"journeyId,omitempty"`
+	Reference string `protobuf:"bytes,4,opt,name=reference,proto3" json:"reference,omitempty"`
+}
+
+func (x *ModifySeatRequest) Reset() {
+	*x = ModifySeatRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_train_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ModifySeatRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModifySeatRequest) ProtoMessage() {}
+
+func (x *ModifySeatRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_train_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModifySeatRequest.ProtoReflect.Descriptor instead.
+func (*ModifySeatRequest) Descriptor() ([]byte, []int) {
+	return file_proto_train_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ModifySeatRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *ModifySeatRequest) GetNewSeat() string {
+	if x != nil {
+		return x.NewSeat
+	}
+	return ""
+}
+
+func (x *ModifySeatRequest) GetJourneyId() string {
+	if x != nil {
+		return x.JourneyId
+	}
+	return ""
+}
+
+func (x *ModifySeatRequest) GetReference() string {
+	if x != nil {
+		return x.Reference
+	}
+	return ""
+}
+
+type ModifySeatResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+}
+
+func (x *ModifySeatResponse) Reset() {
+	*x = ModifySeatResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_train_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ModifySeatResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModifySeatResponse) ProtoMessage() {}
+
+func (x *ModifySeatResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_train_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModifySeatResponse.ProtoReflect.Descriptor instead.
+func (*ModifySeatResponse) Descriptor() ([]byte, []int) {
+	return file_proto_train_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ModifySeatResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type CreateJourneyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TrainNumber string                 `protobuf:"bytes,1,opt,name=trainNumber,proto3" json:"trainNumber,omitempty"`
+	Departure   *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=departure,proto3" json:"departure,omitempty"`
+	Origin      string                 `protobuf:"bytes,3,opt,name=origin,proto3" json:"origin,omitempty"`
+	Destination string                 `protobuf:"bytes,4,opt,name=destination,proto3" json:"destination,omitempty"`
+	Layout      *SeatLayout            `protobuf:"bytes,5,opt,name=layout,proto3" json:"layout,omitempty"`
+	Stops       []*Stop                `protobuf:"bytes,6,rep,name=stops,proto3" json:"stops,omitempty"`
+}
+
+func (x *CreateJourneyRequest) Reset() {
+	*x = CreateJourneyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_train_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateJourneyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateJourneyRequest) ProtoMessage() {}
+
+func (x *CreateJourneyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_train_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateJourneyRequest.ProtoReflect.Descriptor instead.
+func (*CreateJourneyRequest) Descriptor() ([]byte, []int) {
+	return file_proto_train_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *CreateJourneyRequest) GetTrainNumber() string {
+	if x != nil {
+		return x.TrainNumber
+	}
+	return ""
+}
+
+func (x *CreateJourneyRequest) GetDeparture() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Departure
+	}
+	return nil
+}
+
+func (x *CreateJourneyRequest) GetOrigin() string {
+	if x != nil {
+		return x.Origin
+	}
+	return ""
+}
+
+func (x *CreateJourneyRequest) GetDestination() string {
+	if x != nil {
+		return x.Destination
+	}
+	return ""
+}
+
+func (x *CreateJourneyRequest) GetLayout() *SeatLayout {
+	if x != nil {
+		return x.Layout
+	}
+	return nil
+}
+
+func (x *CreateJourneyRequest) GetStops() []*Stop {
+	if x != nil {
+		return x.Stops
+	}
+	return nil
+}
+
+type CreateJourneyResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Journey *Journey `protobuf:"bytes,1,opt,name=journey,proto3" json:"journey,omitempty"`
+}
+
+func (x *CreateJourneyResponse) Reset() {
+	*x = CreateJourneyResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_train_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateJourneyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateJourneyResponse) ProtoMessage() {}
+
+func (x *CreateJourneyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_train_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateJourneyResponse.ProtoReflect.Descriptor instead.
+func (*CreateJourneyResponse) Descriptor() ([]byte, []int) {
+	return file_proto_train_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *CreateJourneyResponse) GetJourney() *Journey {
+	if x != nil {
+		return x.Journey
+	}
+	return nil
+}
+
+type ListJourneysRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	IncludeRetired bool `protobuf:"varint,1,opt,name=includeRetired,proto3" json:"includeRetired,omitempty"`
+}
+
+func (x *ListJourneysRequest) Reset() {
+	*x = ListJourneysRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_train_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListJourneysRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListJourneysRequest) ProtoMessage() {}
+
+func (x *ListJourneysRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_train_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListJourneysRequest.ProtoReflect.Descriptor instead.
+func (*ListJourneysRequest) Descriptor() ([]byte, []int) {
+	return file_proto_train_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ListJourneysRequest) GetIncludeRetired() bool {
+	if x != nil {
+		return x.IncludeRetired
+	}
+	return false
+}
+
+type ListJourneysResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Journeys []*Journey `protobuf:"bytes,1,rep,name=journeys,proto3" json:"journeys,omitempty"`
+}
+
+func (x *ListJourneysResponse) Reset() {
+	*x = ListJourneysResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_train_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListJourneysResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListJourneysResponse) ProtoMessage() {}
+
+func (x *ListJourneysResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_train_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListJourneysResponse.ProtoReflect.Descriptor instead.
+func (*ListJourneysResponse) Descriptor() ([]byte, []int) {
+	return file_proto_train_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ListJourneysResponse) GetJourneys() []*Journey {
+	if x != nil {
+		return x.Journeys
+	}
+	return nil
+}
+
+type RetireJourneyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	JourneyId string `protobuf:"bytes,1,opt,name=journeyId,proto3" json:"journeyId,omitempty"`
+}
+
+func (x *RetireJourneyRequest) Reset() {
+	*x = RetireJourneyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_train_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RetireJourneyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetireJourneyRequest) ProtoMessage() {}
+
+func (x *RetireJourneyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_train_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetireJourneyRequest.ProtoReflect.Descriptor instead.
+func (*RetireJourneyRequest) Descriptor() ([]byte, []int) {
+	return file_proto_train_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *RetireJourneyRequest) GetJourneyId() string {
+	if x != nil {
+		return x.JourneyId
+	}
+	return ""
+}
+
+type RetireJourneyResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+}
+
+func (x *RetireJourneyResponse) Reset() {
+	*x = RetireJourneyResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_train_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RetireJourneyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetireJourneyResponse) ProtoMessage() {}
+
+func (x *RetireJourneyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_train_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetireJourneyResponse.ProtoReflect.Descriptor instead.
+func (*RetireJourneyResponse) Descriptor() ([]byte, []int) {
+	return file_proto_train_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *RetireJourneyResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type SetSeatLayoutRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	JourneyId string      `protobuf:"bytes,1,opt,name=journeyId,proto3" json:"journeyId,omitempty"`
+	Layout    *SeatLayout `protobuf:"bytes,2,opt,name=layout,proto3" json:"layout,omitempty"`
+}
+
+func (x *SetSeatLayoutRequest) Reset() {
+	*x = SetSeatLayoutRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_train_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetSeatLayoutRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetSeatLayoutRequest) ProtoMessage() {}
+
+func (x *SetSeatLayoutRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_train_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetSeatLayoutRequest.ProtoReflect.Descriptor instead.
+func (*SetSeatLayoutRequest) Descriptor() ([]byte, []int) {
+	return file_proto_train_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *SetSeatLayoutRequest) GetJourneyId() string {
+	if x != nil {
+		return x.JourneyId
+	}
+	return ""
+}
+
+func (x *SetSeatLayoutRequest) GetLayout() *SeatLayout {
+	if x != nil {
+		return x.Layout
+	}
+	return nil
+}
+
+type SetSeatLayoutResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Seats int32 `protobuf:"varint,1,opt,name=seats,proto3" json:"seats,omitempty"`
+}
+
+func (x *SetSeatLayoutResponse) Reset() {
+	*x = SetSeatLayoutResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_train_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetSeatLayoutResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetSeatLayoutResponse) ProtoMessage() {}
+
+func (x *SetSeatLayoutResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_train_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetSeatLayoutResponse.ProtoReflect.Descriptor instead.
+func (*SetSeatLayoutResponse) Descriptor() ([]byte, []int) {
+	return file_proto_train_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *SetSeatLayoutResponse) GetSeats() int32 {
+	if x != nil {
+		return x.Seats
+	}
+	return 0
+}
+
+type QuoteFareRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	JourneyId     string        `protobuf:"bytes,1,opt,name=journeyId,proto3" json:"journeyId,omitempty"`
+	From          string        `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	To            string        `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	SeatClass     SeatClass     `protobuf:"varint,4,opt,name=seatClass,proto3,enum=train.SeatClass" json:"seatClass,omitempty"`
+	PassengerType PassengerType `protobuf:"varint,5,opt,name=passengerType,proto3,enum=train.PassengerType" json:"passengerType,omitempty"`
+}
+
+func (x *QuoteFareRequest) Reset() {
+	*x = QuoteFareRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_train_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QuoteFareRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuoteFareRequest) ProtoMessage() {}
+
+func (x *QuoteFareRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_train_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuoteFareRequest.ProtoReflect.Descriptor instead.
+func (*QuoteFareRequest) Descriptor() ([]byte, []int) {
+	return file_proto_train_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *QuoteFareRequest) GetJourneyId() string {
+	if x != nil {
+		return x.JourneyId
+	}
+	return ""
+}
+
+func (x *QuoteFareRequest) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *QuoteFareRequest) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *QuoteFareRequest) GetSeatClass() SeatClass {
+	if x != nil {
+		return x.SeatClass
+	}
+	return SeatClass_SEAT_CLASS_UNSPECIFIED
+}
+
+func (x *QuoteFareRequest) GetPassengerType() PassengerType {
+	if x != nil {
+		return x.PassengerType
+	}
+	return PassengerType_PASSENGER_TYPE_UNSPECIFIED
+}
+
+type QuoteFareResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Total     *Money           `protobuf:"bytes,1,opt,name=total,proto3" json:"total,omitempty"`
+	Breakdown []*FareComponent `protobuf:"bytes,2,rep,name=breakdown,proto3" json:"breakdown,omitempty"`
+}
+
+func (x *QuoteFareResponse) Reset() {
+	*x = QuoteFareResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_train_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QuoteFareResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuoteFareResponse) ProtoMessage() {}
+
+func (x *QuoteFareResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_train_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuoteFareResponse.ProtoReflect.Descriptor instead.
+func (*QuoteFareResponse) Descriptor() ([]byte, []int) {
+	return file_proto_train_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *QuoteFareResponse) GetTotal() *Money {
+	if x != nil {
+		return x.Total
+	}
+	return nil
+}
+
+func (x *QuoteFareResponse) GetBreakdown() []*FareComponent {
+	if x != nil {
+		return x.Breakdown
+	}
+	return nil
+}
+
+type ListTicketsByUserRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Email string `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+}
+
+func (x *ListTicketsByUserRequest) Reset() {
+	*x = ListTicketsByUserRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_train_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListTicketsByUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTicketsByUserRequest) ProtoMessage() {}
+
+func (x *ListTicketsByUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_train_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTicketsByUserRequest.ProtoReflect.Descriptor instead.
+func (*ListTicketsByUserRequest) Descriptor() ([]byte, []int) {
+	return file_proto_train_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ListTicketsByUserRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+type ListTicketsByUserResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tickets []*Ticket `protobuf:"bytes,1,rep,name=tickets,proto3" json:"tickets,omitempty"`
+}
+
+func (x *ListTicketsByUserResponse) Reset() {
+	*x = ListTicketsByUserResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_train_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListTicketsByUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTicketsByUserResponse) ProtoMessage() {}
+
+func (x *ListTicketsByUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_train_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTicketsByUserResponse.ProtoReflect.Descriptor instead.
+func (*ListTicketsByUserResponse) Descriptor() ([]byte, []int) {
+	return file_proto_train_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ListTicketsByUserResponse) GetTickets() []*Ticket {
+	if x != nil {
+		return x.Tickets
+	}
+	return nil
+}
+
+// PurchaseGroupRequest books every passenger on one journey. passengerTypes
+// gives the type of the passenger at the same position, passengers without
+// one travel as adults.
+type PurchaseGroupRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	JourneyId      string          `protobuf:"bytes,1,opt,name=journeyId,proto3" json:"journeyId,omitempty"`
+	From           string          `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	To             string          `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	Passengers     []*User         `protobuf:"bytes,4,rep,name=passengers,proto3" json:"passengers,omitempty"`
+	Fallback       GroupSeating    `protobuf:"varint,5,opt,name=fallback,proto3,enum=train.GroupSeating" json:"fallback,omitempty"`
+	Purchaser      *Purchaser      `protobuf:"bytes,6,opt,name=purchaser,proto3" json:"purchaser,omitempty"`
+	SeatClass      SeatClass       `protobuf:"varint,7,opt,name=seatClass,proto3,enum=train.SeatClass" json:"seatClass,omitempty"`
+	PassengerTypes []PassengerType `protobuf:"varint,8,rep,packed,name=passengerTypes,proto3,enum=train.PassengerType" json:"passengerTypes,omitempty"`
+}
+
+func (x *PurchaseGroupRequest) Reset() {
+	*x = PurchaseGroupRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_train_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PurchaseGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurchaseGroupRequest) ProtoMessage() {}
+
+func (x *PurchaseGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_train_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurchaseGroupRequest.ProtoReflect.Descriptor instead.
+func (*PurchaseGroupRequest) Descriptor() ([]byte, []int) {
+	return file_proto_train_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *PurchaseGroupRequest) GetJourneyId() string {
+	if x != nil {
+		return x.JourneyId
+	}
+	return ""
+}
+
+func (x *PurchaseGroupRequest) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *PurchaseGroupRequest) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *PurchaseGroupRequest) GetPassengers() []*User {
+	if x != nil {
+		return x.Passengers
+	}
+	return nil
+}
+
+func (x *PurchaseGroupRequest) GetFallback() GroupSeating {
+	if x != nil {
+		return x.Fallback
+	}
+	return GroupSeating_GROUP_SEATING_UNSPECIFIED
+}
+
+func (x *PurchaseGroupRequest) GetPurchaser() *Purchaser {
+	if x != nil {
+		return x.Purchaser
+	}
+	return nil
+}
+
+func (x *PurchaseGroupRequest) GetSeatClass() SeatClass {
+	if x != nil {
+		return x.SeatClass
+	}
+	return SeatClass_SEAT_CLASS_UNSPECIFIED
+}
+
+func (x *PurchaseGroupRequest) GetPassengerTypes() []PassengerType {
+	if x != nil {
+		return x.PassengerTypes
+	}
+	return nil
+}
+
+type PurchaseGroupResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GroupReference string    `protobuf:"bytes,1,opt,name=groupReference,proto3" json:"groupReference,omitempty"`
+	Tickets        []*Ticket `protobuf:"bytes,2,rep,name=tickets,proto3" json:"tickets,omitempty"`
+}
+
+func (x *PurchaseGroupResponse) Reset() {
+	*x = PurchaseGroupResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_train_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PurchaseGroupResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurchaseGroupResponse) ProtoMessage() {}
+
+func (x *PurchaseGroupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_train_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurchaseGroupResponse.ProtoReflect.Descriptor instead.
+func (*PurchaseGroupResponse) Descriptor() ([]byte, []int) {
+	return file_proto_train_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *PurchaseGroupResponse) GetGroupReference() string {
+	if x != nil {
+		return x.GroupReference
+	}
+	return ""
+}
+
+func (x *PurchaseGroupResponse) GetTickets() []*Ticket {
+	if x != nil {
+		return x.Tickets
+	}
+	return nil
+}
+
+// Hold keeps a seat out of sale until it is confirmed, released or expires.
+type Hold struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	JourneyId string                 `protobuf:"bytes,2,opt,name=journeyId,proto3" json:"journeyId,omitempty"`
+	Seat      string                 `protobuf:"bytes,3,opt,name=seat,proto3" json:"seat,omitempty"`
+	From      string                 `protobuf:"bytes,4,opt,name=from,proto3" json:"from,omitempty"`
+	To        string                 `protobuf:"bytes,5,opt,name=to,proto3" json:"to,omitempty"`
+	User      *User                  `protobuf:"bytes,6,opt,name=user,proto3" json:"user,omitempty"`
+	ExpiresAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=expiresAt,proto3" json:"expiresAt,omitempty"`
+	Price     *Money                 `protobuf:"bytes,8,opt,name=price,proto3" json:"price,omitempty"`
+	Purchaser *Purchaser             `protobuf:"bytes,9,opt,name=purchaser,proto3" json:"purchaser,omitempty"`
+}
+
+func (x *Hold) Reset() {
+	*x = Hold{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_train_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Hold) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Hold) ProtoMessage() {}
+
+func (x *Hold) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_train_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Hold.ProtoReflect.Descriptor instead.
+func (*Hold) Descriptor() ([]byte, []int) {
+	return file_proto_train_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *Hold) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Hold) GetJourneyId() string {
+	if x != nil {
+		return x.JourneyId
+	}
+	return ""
+}
+
+func (x *Hold) GetSeat() string {
+	if x != nil {
+		return x.Seat
+	}
+	return ""
+}
+
+func (x *Hold) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *Hold) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *Hold) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *Hold) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *Hold) GetPrice() *Money {
+	if x != nil {
+		return x.Price
+	}
+	return nil
+}
+
+func (x *Hold) GetPurchaser() *Purchaser {
+	if x != nil {
+		return x.Purchaser
+	}
+	return nil
+}
+
+type HoldSeatRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	JourneyId     string           `protobuf:"bytes,1,opt,name=journeyId,proto3" json:"journeyId,omitempty"`
+	From          string           `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	To            string           `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	User          *User            `protobuf:"bytes,4,opt,name=user,proto3" json:"user,omitempty"`
+	PassengerType PassengerType    `protobuf:"varint,5,opt,name=passengerType,proto3,enum=train.PassengerType" json:"passengerType,omitempty"`
+	Preferences   *SeatPreferences `protobuf:"bytes,6,opt,name=preferences,proto3" json:"preferences,omitempty"`
+	Purchaser     *Purchaser       `protobuf:"bytes,7,opt,name=purchaser,proto3" json:"purchaser,omitempty"`
+	SeatClass     SeatClass        `protobuf:"varint,8,opt,name=seatClass,proto3,enum=train.SeatClass" json:"seatClass,omitempty"`
+}
+
+func (x *HoldSeatRequest) Reset() {
+	*x = HoldSeatRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_train_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HoldSeatRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HoldSeatRequest) ProtoMessage() {}
+
+func (x *HoldSeatRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_train_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HoldSeatRequest.ProtoReflect.Descriptor instead.
+func (*HoldSeatRequest) Descriptor() ([]byte, []int) {
+	return file_proto_train_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *HoldSeatRequest) GetJourneyId() string {
+	if x != nil {
+		return x.JourneyId
+	}
+	return ""
+}
+
+func (x *HoldSeatRequest) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *HoldSeatRequest) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *HoldSeatRequest) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *HoldSeatRequest) GetPassengerType() PassengerType {
+	if x != nil {
+		return x.PassengerType
+	}
+	return PassengerType_PASSENGER_TYPE_UNSPECIFIED
+}
+
+func (x *HoldSeatRequest) GetPreferences() *SeatPreferences {
+	if x != nil {
+		return x.Preferences
+	}
+	return nil
+}
+
+func (x *HoldSeatRequest) GetPurchaser() *Purchaser {
+	if x != nil {
+		return x.Purchaser
+	}
+	return nil
+}
+
+func (x *HoldSeatRequest) GetSeatClass() SeatClass {
+	if x != nil {
+		return x.SeatClass
+	}
+	return SeatClass_SEAT_CLASS_UNSPECIFIED
+}
+
+type HoldSeatResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Hold            *Hold    `protobuf:"bytes,1,opt,name=hold,proto3" json:"hold,omitempty"`
+	AllocationNotes []string `protobuf:"bytes,2,rep,name=allocationNotes,proto3" json:"allocationNotes,omitempty"`
+}
+
+func (x *HoldSeatResponse) Reset() {
+	*x = HoldSeatResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_train_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HoldSeatResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HoldSeatResponse) ProtoMessage() {}
+
+func (x *HoldSeatResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_train_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HoldSeatResponse.ProtoReflect.Descriptor instead.
+func (*HoldSeatResponse) Descriptor() ([]byte, []int) {
+	return file_proto_train_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *HoldSeatResponse) GetHold() *Hold {
+	if x != nil {
+		return x.Hold
+	}
+	return nil
+}
+
+func (x *HoldSeatResponse) GetAllocationNotes() []string {
+	if x != nil {
+		return x.AllocationNotes
+	}
+	return nil
+}
+
+type ConfirmHoldRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	HoldId string `protobuf:"bytes,1,opt,name=holdId,proto3" json:"holdId,omitempty"`
+}
+
+func (x *ConfirmHoldRequest) Reset() {
+	*x = ConfirmHoldRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_train_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConfirmHoldRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmHoldRequest) ProtoMessage() {}
+
+func (x *ConfirmHoldRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_train_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmHoldRequest.ProtoReflect.Descriptor instead.
+func (*ConfirmHoldRequest) Descriptor() ([]byte, []int) {
+	return file_proto_train_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ConfirmHoldRequest) GetHoldId() string {
+	if x != nil {
+		return x.HoldId
+	}
+	return ""
+}
+
+type ConfirmHoldResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ticket *Ticket `protobuf:"bytes,1,opt,name=ticket,proto3" json:"ticket,omitempty"`
+}
+
+func (x *ConfirmHoldResponse) Reset() {
+	*x = ConfirmHoldResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_train_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConfirmHoldResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmHoldResponse) ProtoMessage() {}
+
+func (x *ConfirmHoldResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_train_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmHoldResponse.ProtoReflect.Descriptor instead.
+func (*ConfirmHoldResponse) Descriptor() ([]byte, []int) {
+	return file_proto_train_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ConfirmHoldResponse) GetTicket() *Ticket {
+	if x != nil {
+		return x.Ticket
+	}
+	return nil
+}
+
+type ReleaseHoldRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	HoldId string `protobuf:"bytes,1,opt,name=holdId,proto3" json:"holdId,omitempty"`
+}
+
+func (x *ReleaseHoldRequest) Reset() {
+	*x = ReleaseHoldRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_train_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReleaseHoldRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseHoldRequest) ProtoMessage() {}
+
+func (x *ReleaseHoldRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_train_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseHoldRequest.ProtoReflect.Descriptor instead.
+func (*ReleaseHoldRequest) Descriptor() ([]byte, []int) {
+	return file_proto_train_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *ReleaseHoldRequest) GetHoldId() string {
+	if x != nil {
+		return x.HoldId
+	}
+	return ""
+}
+
+type ReleaseHoldResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+}
+
+func (x *ReleaseHoldResponse) Reset() {
+	*x = ReleaseHoldResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_train_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReleaseHoldResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseHoldResponse) ProtoMessage() {}
+
+func (x *ReleaseHoldResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_train_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseHoldResponse.ProtoReflect.Descriptor instead.
+func (*ReleaseHoldResponse) Descriptor() ([]byte, []int) {
+	return file_proto_train_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *ReleaseHoldResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type GetWaitlistPositionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WaitlistId string `protobuf:"bytes,1,opt,name=waitlistId,proto3" json:"waitlistId,omitempty"`
+}
+
+func (x *GetWaitlistPositionRequest) Reset() {
+	*x = GetWaitlistPositionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_train_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetWaitlistPositionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWaitlistPositionRequest) ProtoMessage() {}
+
+func (x *GetWaitlistPositionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_train_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWaitlistPositionRequest.ProtoReflect.Descriptor instead.
+func (*GetWaitlistPositionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_train_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *GetWaitlistPositionRequest) GetWaitlistId() string {
+	if x != nil {
+		return x.WaitlistId
+	}
+	return ""
+}
+
+type GetWaitlistPositionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Entry *WaitlistEntry `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry,omitempty"`
+}
+
+func (x *GetWaitlistPositionResponse) Reset() {
+	*x = GetWaitlistPositionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_train_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetWaitlistPositionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWaitlistPositionResponse) ProtoMessage() {}
+
+func (x *GetWaitlistPositionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_train_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWaitlistPositionResponse.ProtoReflect.Descriptor instead.
+func (*GetWaitlistPositionResponse) Descriptor() ([]byte, []int) {
+	return file_proto_train_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *GetWaitlistPositionResponse) GetEntry() *WaitlistEntry {
+	if x != nil {
+		return x.Entry
+	}
+	return nil
+}
+
+type LeaveWaitlistRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WaitlistId string `protobuf:"bytes,1,opt,name=waitlistId,proto3" json:"waitlistId,omitempty"`
+}
+
+func (x *LeaveWaitlistRequest) Reset() {
+	*x = LeaveWaitlistRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_train_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LeaveWaitlistRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaveWaitlistRequest) ProtoMessage() {}
+
+func (x *LeaveWaitlistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_train_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaveWaitlistRequest.ProtoReflect.Descriptor instead.
+func (*LeaveWaitlistRequest) Descriptor() ([]byte, []int) {
+	return file_proto_train_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *LeaveWaitlistRequest) GetWaitlistId() string {
+	if x != nil {
+		return x.WaitlistId
+	}
+	return ""
+}
+
+type LeaveWaitlistResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+}
+
+func (x *LeaveWaitlistResponse) Reset() {
+	*x = LeaveWaitlistResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_train_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LeaveWaitlistResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaveWaitlistResponse) ProtoMessage() {}
+
+func (x *LeaveWaitlistResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_train_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaveWaitlistResponse.ProtoReflect.Descriptor instead.
+func (*LeaveWaitlistResponse) Descriptor() ([]byte, []int) {
+	return file_proto_train_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *LeaveWaitlistResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// SwapSeatsRequest exchanges the seats of two tickets on the same journey.
+// It is made by the holder of reference, email proves it. The swap waits
+// until the holder of otherReference accepts it, unless both tickets were
+// bought by the same purchaser and requireConsent is not set.
+type SwapSeatsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Reference      string `protobuf:"bytes,1,opt,name=reference,proto3" json:"reference,omitempty"`
+	OtherReference string `protobuf:"bytes,2,opt,name=otherReference,proto3" json:"otherReference,omitempty"`
+	RequireConsent bool   `protobuf:"varint,3,opt,name=requireConsent,proto3" json:"requireConsent,omitempty"`
+	Email          string `protobuf:"bytes,4,opt,name=email,proto3" json:"email,omitempty"`
+}
+
+func (x *SwapSeatsRequest) Reset() {
+	*x = SwapSeatsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_train_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SwapSeatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SwapSeatsRequest) ProtoMessage() {}
+
+func (x *SwapSeatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_train_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SwapSeatsRequest.ProtoReflect.Descriptor instead.
+func (*SwapSeatsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_train_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *SwapSeatsRequest) GetReference() string {
+	if x != nil {
+		return x.Reference
+	}
+	return ""
+}
+
+func (x *SwapSeatsRequest) GetOtherReference() string {
+	if x != nil {
+		return x.OtherReference
+	}
+	return ""
+}
+
+func (x *SwapSeatsRequest) GetRequireConsent() bool {
+	if x != nil {
+		return x.RequireConsent
+	}
+	return false
+}
+
+func (x *SwapSeatsRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+type SwapSeatsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Swapped bool      `protobuf:"varint,1,opt,name=swapped,proto3" json:"swapped,omitempty"`
+	SwapId  string    `protobuf:"bytes,2,opt,name=swapId,proto3" json:"swapId,omitempty"`
+	Tickets []*Ticket `protobuf:"bytes,3,rep,name=tickets,proto3" json:"tickets,omitempty"`
+}
+
+func (x *SwapSeatsResponse) Reset() {
+	*x = SwapSeatsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_train_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SwapSeatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SwapSeatsResponse) ProtoMessage() {}
+
+func (x *SwapSeatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_train_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SwapSeatsResponse.ProtoReflect.Descriptor instead.
+func (*SwapSeatsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_train_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *SwapSeatsResponse) GetSwapped() bool {
+	if x != nil {
+		return x.Swapped
+	}
+	return false
+}
+
+func (x *SwapSeatsResponse) GetSwapId() string {
+	if x != nil {
+		return x.SwapId
+	}
+	return ""
+}
+
+func (x *SwapSeatsResponse) GetTickets() []*Ticket {
+	if x != nil {
+		return x.Tickets
+	}
+	return nil
+}
+
+type AcceptSwapRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SwapId    string `protobuf:"bytes,1,opt,name=swapId,proto3" json:"swapId,omitempty"`
+	Reference string `protobuf:"bytes,2,opt,name=reference,proto3" json:"reference,omitempty"`
+	Email     string `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+}
+
+func (x *AcceptSwapRequest) Reset() {
+	*x = AcceptSwapRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_train_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AcceptSwapRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptSwapRequest) ProtoMessage() {}
+
+func (x *AcceptSwapRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_train_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptSwapRequest.ProtoReflect.Descriptor instead.
+func (*AcceptSwapRequest) Descriptor() ([]byte, []int) {
+	return file_proto_train_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *AcceptSwapRequest) GetSwapId() string {
+	if x != nil {
+		return x.SwapId
+	}
+	return ""
+}
+
+func (x *AcceptSwapRequest) GetReference() string {
+	if x != nil {
+		return x.Reference
+	}
+	return ""
+}
+
+func (x *AcceptSwapRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+type AcceptSwapResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tickets []*Ticket `protobuf:"bytes,1,rep,name=tickets,proto3" json:"tickets,omitempty"`
+}
+
+func (x *AcceptSwapResponse) Reset() {
+	*x = AcceptSwapResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_train_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AcceptSwapResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptSwapResponse) ProtoMessage() {}
+
+func (x *AcceptSwapResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_train_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptSwapResponse.ProtoReflect.Descriptor instead.
+func (*AcceptSwapResponse) Descriptor() ([]byte, []int) {
+	return file_proto_train_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *AcceptSwapResponse) GetTickets() []*Ticket {
+	if x != nil {
+		return x.Tickets
+	}
+	return nil
+}
+
+// TransferTicketRequest hands a ticket over to another passenger, the
+// ticket keeps its seat and gets a new booking reference. Only the
+// purchaser may transfer a ticket, email must be theirs.
+type TransferTicketRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Reference string `protobuf:"bytes,1,opt,name=reference,proto3" json:"reference,omitempty"`
+	NewUser   *User  `protobuf:"bytes,2,opt,name=newUser,proto3" json:"newUser,omitempty"`
+	Email     string `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+}
+
+func (x *TransferTicketRequest) Reset() {
+	*x = TransferTicketRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_train_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransferTicketRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferTicketRequest) ProtoMessage() {}
+
+func (x *TransferTicketRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_train_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferTicketRequest.ProtoReflect.Descriptor instead.
+func (*TransferTicketRequest) Descriptor() ([]byte, []int) {
+	return file_proto_train_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *TransferTicketRequest) GetReference() string {
+	if x != nil {
+		return x.Reference
+	}
+	return ""
+}
+
+func (x *TransferTicketRequest) GetNewUser() *User {
+	if x != nil {
+		return x.NewUser
+	}
+	return nil
+}
+
+func (x *TransferTicketRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+type TransferTicketResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ticket *Ticket   `protobuf:"bytes,1,opt,name=ticket,proto3" json:"ticket,omitempty"`
+	Trip   []*Ticket `protobuf:"bytes,2,rep,name=trip,proto3" json:"trip,omitempty"`
+}
+
+func (x *TransferTicketResponse) Reset() {
+	*x = TransferTicketResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_train_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransferTicketResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferTicketResponse) ProtoMessage() {}
+
+func (x *TransferTicketResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_train_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferTicketResponse.ProtoReflect.Descriptor instead.
+func (*TransferTicketResponse) Descriptor() ([]byte, []int) {
+	return file_proto_train_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *TransferTicketResponse) GetTicket() *Ticket {
+	if x != nil {
+		return x.Ticket
+	}
+	return nil
+}
+
+func (x *TransferTicketResponse) GetTrip() []*Ticket {
+	if x != nil {
+		return x.Trip
+	}
+	return nil
+}
+
+// GetReceiptRequest asks for the receipt of a ticket, the first receipt of a
+// ticket gives it the next invoice number.
+type GetReceiptRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Reference string        `protobuf:"bytes,1,opt,name=reference,proto3" json:"reference,omitempty"`
+	Email     string        `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	Format    ReceiptFormat `protobuf:"varint,3,opt,name=format,proto3,enum=train.ReceiptFormat" json:"format,omitempty"`
+}
+
+func (x *GetReceiptRequest) Reset() {
+	*x = GetReceiptRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_train_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetReceiptRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReceiptRequest) ProtoMessage() {}
+
+func (x *GetReceiptRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_train_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReceiptRequest.ProtoReflect.Descriptor instead.
+func (*GetReceiptRequest) Descriptor() ([]byte, []int) {
+	return file_proto_train_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *GetReceiptRequest) GetReference() string {
+	if x != nil {
+		return x.Reference
+	}
+	return ""
+}
+
+func (x *GetReceiptRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *GetReceiptRequest) GetFormat() ReceiptFormat {
+	if x != nil {
+		return x.Format
+	}
+	return ReceiptFormat_RECEIPT_FORMAT_UNSPECIFIED
+}
+
+type GetReceiptResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	InvoiceNumber string `protobuf:"bytes,1,opt,name=invoiceNumber,proto3" json:"invoiceNumber,omitempty"`
+	ContentType   string `protobuf:"bytes,2,opt,name=contentType,proto3" json:"contentType,omitempty"`
+	FileName      string `protobuf:"bytes,3,opt,name=fileName,proto3" json:"fileName,omitempty"`
+	Content       []byte `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`
+}
+
+func (x *GetReceiptResponse) Reset() {
+	*x = GetReceiptResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_train_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetReceiptResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReceiptResponse) ProtoMessage() {}
+
+func (x *GetReceiptResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_train_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReceiptResponse.ProtoReflect.Descriptor instead.
+func (*GetReceiptResponse) Descriptor() ([]byte, []int) {
+	return file_proto_train_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *GetReceiptResponse) GetInvoiceNumber() string {
+	if x != nil {
+		return x.InvoiceNumber
+	}
+	return ""
+}
+
+func (x *GetReceiptResponse) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *GetReceiptResponse) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *GetReceiptResponse) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+// UpgradeSeatRequest moves a ticket to a higher class, into newSeat when
+// given. The purchaser is charged the difference between the two fares.
+type UpgradeSeatRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Reference string    `protobuf:"bytes,1,opt,name=reference,proto3" json:"reference,omitempty"`
+	Email     string    `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	SeatClass SeatClass `protobuf:"varint,3,opt,name=seatClass,proto3,enum=train.SeatClass" json:"seatClass,omitempty"`
+	NewSeat   string    `protobuf:"bytes,4,opt,name=newSeat,proto3" json:"newSeat,omitempty"`
+}
+
+func (x *UpgradeSeatRequest) Reset() {
+	*x = UpgradeSeatRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_train_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpgradeSeatRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpgradeSeatRequest) ProtoMessage() {}
+
+func (x *UpgradeSeatRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_train_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpgradeSeatRequest.ProtoReflect.Descriptor instead.
+func (*UpgradeSeatRequest) Descriptor() ([]byte, []int) {
+	return file_proto_train_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *UpgradeSeatRequest) GetReference() string {
+	if x != nil {
+		return x.Reference
+	}
+	return ""
+}
+
+func (x *UpgradeSeatRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *UpgradeSeatRequest) GetSeatClass() SeatClass {
+	if x != nil {
+		return x.SeatClass
+	}
+	return SeatClass_SEAT_CLASS_UNSPECIFIED
+}
+
+func (x *UpgradeSeatRequest) GetNewSeat() string {
+	if x != nil {
+		return x.NewSeat
+	}
+	return ""
+}
+
+type UpgradeSeatResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ticket  *Ticket `protobuf:"bytes,1,opt,name=ticket,proto3" json:"ticket,omitempty"`
+	Charged *Money  `protobuf:"bytes,2,opt,name=charged,proto3" json:"charged,omitempty"`
+}
+
+func (x *UpgradeSeatResponse) Reset() {
+	*x = UpgradeSeatResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_train_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpgradeSeatResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpgradeSeatResponse) ProtoMessage() {}
+
+func (x *UpgradeSeatResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_train_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpgradeSeatResponse.ProtoReflect.Descriptor instead.
+func (*UpgradeSeatResponse) Descriptor() ([]byte, []int) {
+	return file_proto_train_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *UpgradeSeatResponse) GetTicket() *Ticket {
+	if x != nil {
+		return x.Ticket
+	}
+	return nil
+}
+
+func (x *UpgradeSeatResponse) GetCharged() *Money {
+	if x != nil {
+		return x.Charged
+	}
+	return nil
+}
+
+// SeatBlock takes a seat of a journey out of service for a reason, from
+// start until until. No start means at once, no until means until it is
+// unblocked.
+type SeatBlock struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	JourneyId string                 `protobuf:"bytes,2,opt,name=journeyId,proto3" json:"journeyId,omitempty"`
+	Seat      string                 `protobuf:"bytes,3,opt,name=seat,proto3" json:"seat,omitempty"`
+	Reason    string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	Start     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=start,proto3" json:"start,omitempty"`
+	Until     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=until,proto3" json:"until,omitempty"`
+}
+
+func (x *SeatBlock) Reset() {
+	*x = SeatBlock{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_train_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SeatBlock) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SeatBlock) ProtoMessage() {}
+
+func (x *SeatBlock) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_train_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SeatBlock.ProtoReflect.Descriptor instead.
+func (*SeatBlock) Descriptor() ([]byte, []int) {
+	return file_proto_train_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *SeatBlock) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SeatBlock) GetJourneyId() string {
+	if x != nil {
+		return x.JourneyId
+	}
+	return ""
+}
+
+func (x *SeatBlock) GetSeat() string {
+	if x != nil {
+		return x.Seat
+	}
+	return ""
+}
+
+func (x *SeatBlock) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *SeatBlock) GetStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Start
+	}
+	return nil
+}
+
+func (x *SeatBlock) GetUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Until
+	}
+	return nil
+}
+
+type BlockSeatRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	JourneyId string                 `protobuf:"bytes,1,opt,name=journeyId,proto3" json:"journeyId,omitempty"`
+	Seat      string                 `protobuf:"bytes,2,opt,name=seat,proto3" json:"seat,omitempty"`
+	Reason    string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	Start     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=start,proto3" json:"start,omitempty"`
+	Until     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=until,proto3" json:"until,omitempty"`
+}
+
+func (x *BlockSeatRequest) Reset() {
+	*x = BlockSeatRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_train_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BlockSeatRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockSeatRequest) ProtoMessage() {}
+
+func (x *BlockSeatRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_train_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockSeatRequest.ProtoReflect.Descriptor instead.
+func (*BlockSeatRequest) Descriptor() ([]byte, []int) {
+	return file_proto_train_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *BlockSeatRequest) GetJourneyId() string {
+	if x != nil {
+		return x.JourneyId
+	}
+	return ""
+}
+
+func (x *BlockSeatRequest) GetSeat() string {
+	if x != nil {
+		return x.Seat
+	}
+	return ""
+}
+
+func (x *BlockSeatRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *BlockSeatRequest) GetStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Start
+	}
+	return nil
+}
+
+func (x *BlockSeatRequest) GetUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Until
+	}
+	return nil
+}
+
+// BlockSeatResponse lists the tickets moved off a seat blocked at once,
+// unplaced are the bookings left on it because no other seat was free.
+type BlockSeatResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Block    *SeatBlock `protobuf:"bytes,1,opt,name=block,proto3" json:"block,omitempty"`
+	Reseated []*Ticket  `protobuf:"bytes,2,rep,name=reseated,proto3" json:"reseated,omitempty"`
+	Unplaced []string   `protobuf:"bytes,3,rep,name=unplaced,proto3" json:"unplaced,omitempty"`
+}
+
+func (x *BlockSeatResponse) Reset() {
+	*x = BlockSeatResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_train_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BlockSeatResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockSeatResponse) ProtoMessage() {}
+
+func (x *BlockSeatResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_train_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockSeatResponse.ProtoReflect.Descriptor instead.
+func (*BlockSeatResponse) Descriptor() ([]byte, []int) {
+	return file_proto_train_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *BlockSeatResponse) GetBlock() *SeatBlock {
+	if x != nil {
+		return x.Block
+	}
+	return nil
+}
+
+func (x *BlockSeatResponse) GetReseated() []*Ticket {
+	if x != nil {
+		return x.Reseated
+	}
+	return nil
+}
+
+func (x *BlockSeatResponse) GetUnplaced() []string {
+	if x != nil {
+		return x.Unplaced
+	}
+	return nil
+}
+
+type UnblockSeatRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BlockId string `protobuf:"bytes,1,opt,name=blockId,proto3" json:"blockId,omitempty"`
+}
+
+func (x *UnblockSeatRequest) Reset() {
+	*x = UnblockSeatRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_train_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnblockSeatRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnblockSeatRequest) ProtoMessage() {}
+
+func (x *UnblockSeatRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_train_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnblockSeatRequest.ProtoReflect.Descriptor instead.
+func (*UnblockSeatRequest) Descriptor() ([]byte, []int) {
+	return file_proto_train_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *UnblockSeatRequest) GetBlockId() string {
+	if x != nil {
+		return x.BlockId
+	}
+	return ""
+}
+
+type UnblockSeatResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+}
+
+func (x *UnblockSeatResponse) Reset() {
+	*x = UnblockSeatResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_train_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnblockSeatResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnblockSeatResponse) ProtoMessage() {}
+
+func (x *UnblockSeatResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_train_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnblockSeatResponse.ProtoReflect.Descriptor instead.
+func (*UnblockSeatResponse) Descriptor() ([]byte, []int) {
+	return file_proto_train_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *UnblockSeatResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// Station is a stop known to the station registry, tickets and journeys use
+// its name. minConnection is the least time needed to change trains there.
+type Station struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code          string               `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Name          string               `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Aliases       []string             `protobuf:"bytes,3,rep,name=aliases,proto3" json:"aliases,omitempty"`
+	TimeZone      string               `protobuf:"bytes,4,opt,name=timeZone,proto3" json:"timeZone,omitempty"`
+	MinConnection *durationpb.Duration `protobuf:"bytes,5,opt,name=minConnection,proto3" json:"minConnection,omitempty"`
+}
+
+func (x *Station) Reset() {
+	*x = Station{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_train_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Station) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Station) ProtoMessage() {}
+
+func (x *Station) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_train_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Station.ProtoReflect.Descriptor instead.
+func (*Station) Descriptor() ([]byte, []int) {
+	return file_proto_train_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *Station) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *Station) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Station) GetAliases() []string {
+	if x != nil {
+		return x.Aliases
+	}
+	return nil
+}
+
+func (x *Station) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
+func (x *Station) GetMinConnection() *durationpb.Duration {
+	if x != nil {
+		return x.MinConnection
+	}
+	return nil
+}
+
+type ListStationsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListStationsRequest) Reset() {
+	*x = ListStationsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_train_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListStationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStationsRequest) ProtoMessage() {}
+
+func (x *ListStationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_train_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStationsRequest.ProtoReflect.Descriptor instead.
+func (*ListStationsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_train_proto_rawDescGZIP(), []int{66}
+}
+
+type ListStationsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Stations []*Station `protobuf:"bytes,1,rep,name=stations,proto3" json:"stations,omitempty"`
+}
+
+func (x *ListStationsResponse) Reset() {
+	*x = ListStationsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_train_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListStationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStationsResponse) ProtoMessage() {}
+
+func (x *ListStationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_train_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStationsResponse.ProtoReflect.Descriptor instead.
+func (*ListStationsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_train_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *ListStationsResponse) GetStations() []*Station {
+	if x != nil {
+		return x.Stations
+	}
+	return nil
+}
+
+// SearchStationsRequest looks stations up by part of their code, name or an
+// alias, best matches first. limit defaults to 10.
+type SearchStationsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Query string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	Limit int32  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *SearchStationsRequest) Reset() {
+	*x = SearchStationsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_train_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchStationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchStationsRequest) ProtoMessage() {}
+
+func (x *SearchStationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_train_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchStationsRequest.ProtoReflect.Descriptor instead.
+func (*SearchStationsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_train_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *SearchStationsRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchStationsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type SearchStationsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Stations []*Station `protobuf:"bytes,1,rep,name=stations,proto3" json:"stations,omitempty"`
+}
+
+func (x *SearchStationsResponse) Reset() {
+	*x = SearchStationsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_train_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchStationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchStationsResponse) ProtoMessage() {}
+
+func (x *SearchStationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_train_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchStationsResponse.ProtoReflect.Descriptor instead.
+func (*SearchStationsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_train_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *SearchStationsResponse) GetStations() []*Station {
+	if x != nil {
+		return x.Stations
+	}
+	return nil
+}
+
+// SearchJourneysRequest finds journeys on sale from one station to another,
+// on date (YYYY-MM-DD in the time zone of from) when given. Results are
+// sorted by departure unless sortBy says otherwise, pageSize defaults to 20
+// and pageToken continues from a previous response.
+type SearchJourneysRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	From          string        `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To            string        `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	Date          string        `protobuf:"bytes,3,opt,name=date,proto3" json:"date,omitempty"`
+	PassengerType PassengerType `protobuf:"varint,4,opt,name=passengerType,proto3,enum=train.PassengerType" json:"passengerType,omitempty"`
+	SortBy        JourneySort   `protobuf:"varint,5,opt,name=sortBy,proto3,enum=train.JourneySort" json:"sortBy,omitempty"`
+	PageSize      int32         `protobuf:"varint,6,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	PageToken     string        `protobuf:"bytes,7,opt,name=pageToken,proto3" json:"pageToken,omitempty"`
+}
+
+func (x *SearchJourneysRequest) Reset() {
+	*x = SearchJourneysRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_train_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchJourneysRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchJourneysRequest) ProtoMessage() {}
+
+func (x *SearchJourneysRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_train_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchJourneysRequest.ProtoReflect.Descriptor instead.
+func (*SearchJourneysRequest) Descriptor() ([]byte, []int) {
+	return file_proto_train_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *SearchJourneysRequest) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *SearchJourneysRequest) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *SearchJourneysRequest) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *SearchJourneysRequest) GetPassengerType() PassengerType {
+	if x != nil {
+		return x.PassengerType
+	}
+	return PassengerType_PASSENGER_TYPE_UNSPECIFIED
+}
+
+func (x *SearchJourneysRequest) GetSortBy() JourneySort {
+	if x != nil {
+		return x.SortBy
+	}
+	return JourneySort_JOURNEY_SORT_UNSPECIFIED
+}
+
+func (x *SearchJourneysRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *SearchJourneysRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+// ClassAvailability is what is left of one class for a trip. fare is unset
+// when the class is sold out or cannot be priced.
+type ClassAvailability struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SeatClass SeatClass `protobuf:"varint,1,opt,name=seatClass,proto3,enum=train.SeatClass" json:"seatClass,omitempty"`
+	SeatsLeft int32     `protobuf:"varint,2,opt,name=seatsLeft,proto3" json:"seatsLeft,omitempty"`
+	Fare      *Money    `protobuf:"bytes,3,opt,name=fare,proto3" json:"fare,omitempty"`
+}
+
+func (x *ClassAvailability) Reset() {
+	*x = ClassAvailability{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_train_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClassAvailability) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClassAvailability) ProtoMessage() {}
+
+func (x *ClassAvailability) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_train_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClassAvailability.ProtoReflect.Descriptor instead.
+func (*ClassAvailability) Descriptor() ([]byte, []int) {
+	return file_proto_train_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *ClassAvailability) GetSeatClass() SeatClass {
+	if x != nil {
+		return x.SeatClass
+	}
+	return SeatClass_SEAT_CLASS_UNSPECIFIED
+}
+
+func (x *ClassAvailability) GetSeatsLeft() int32 {
+	if x != nil {
+		return x.SeatsLeft
+	}
+	return 0
+}
+
+func (x *ClassAvailability) GetFare() *Money {
+	if x != nil {
+		return x.Fare
+	}
+	return nil
+}
+
+// JourneyResult is one journey matching a search. lowestFare is the
+// cheapest class with seats left and is unset when the trip is sold out.
+type JourneyResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	JourneyId   string                 `protobuf:"bytes,1,opt,name=journeyId,proto3" json:"journeyId,omitempty"`
+	TrainNumber string                 `protobuf:"bytes,2,opt,name=trainNumber,proto3" json:"trainNumber,omitempty"`
+	From        string                 `protobuf:"bytes,3,opt,name=from,proto3" json:"from,omitempty"`
+	To          string                 `protobuf:"bytes,4,opt,name=to,proto3" json:"to,omitempty"`
+	Departure   *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=departure,proto3" json:"departure,omitempty"`
+	Arrival     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=arrival,proto3" json:"arrival,omitempty"`
+	Classes     []*ClassAvailability   `protobuf:"bytes,7,rep,name=classes,proto3" json:"classes,omitempty"`
+	LowestFare  *Money                 `protobuf:"bytes,8,opt,name=lowestFare,proto3" json:"lowestFare,omitempty"`
+}
+
+func (x *JourneyResult) Reset() {
+	*x = JourneyResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_train_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JourneyResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JourneyResult) ProtoMessage() {}
+
+func (x *JourneyResult) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_train_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JourneyResult.ProtoReflect.Descriptor instead.
+func (*JourneyResult) Descriptor() ([]byte, []int) {
+	return file_proto_train_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *JourneyResult) GetJourneyId() string {
+	if x != nil {
+		return x.JourneyId
+	}
+	return ""
+}
+
+func (x *JourneyResult) GetTrainNumber() string {
+	if x != nil {
+		return x.TrainNumber
+	}
+	return ""
+}
+
+func (x *JourneyResult) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *JourneyResult) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *JourneyResult) GetDeparture() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Departure
+	}
+	return nil
+}
+
+func (x *JourneyResult) GetArrival() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Arrival
+	}
+	return nil
+}
+
+func (x *JourneyResult) GetClasses() []*ClassAvailability {
+	if x != nil {
+		return x.Classes
+	}
+	return nil
+}
+
+func (x *JourneyResult) GetLowestFare() *Money {
+	if x != nil {
+		return x.LowestFare
+	}
+	return nil
+}
+
+type SearchJourneysResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Results       []*JourneyResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	NextPageToken string           `protobuf:"bytes,2,opt,name=nextPageToken,proto3" json:"nextPageToken,omitempty"`
+	TotalResults  int32            `protobuf:"varint,3,opt,name=totalResults,proto3" json:"totalResults,omitempty"`
+}
+
+func (x *SearchJourneysResponse) Reset() {
+	*x = SearchJourneysResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_train_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchJourneysResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchJourneysResponse) ProtoMessage() {}
+
+func (x *SearchJourneysResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_train_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchJourneysResponse.ProtoReflect.Descriptor instead.
+func (*SearchJourneysResponse) Descriptor() ([]byte, []int) {
+	return file_proto_train_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *SearchJourneysResponse) GetResults() []*JourneyResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *SearchJourneysResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *SearchJourneysResponse) GetTotalResults() int32 {
+	if x != nil {
+		return x.TotalResults
+	}
+	return 0
+}
+
+// PurchaseReturnRequest books a round trip from one station to another on
+// the outbound journey and back on the return journey, paid in one go.
+type PurchaseReturnRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	From              string           `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To                string           `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	OutboundJourneyId string           `protobuf:"bytes,3,opt,name=outboundJourneyId,proto3" json:"outboundJourneyId,omitempty"`
+	ReturnJourneyId   string           `protobuf:"bytes,4,opt,name=returnJourneyId,proto3" json:"returnJourneyId,omitempty"`
+	User              *User            `protobuf:"bytes,5,opt,name=user,proto3" json:"user,omitempty"`
+	Purchaser         *Purchaser       `protobuf:"bytes,6,opt,name=purchaser,proto3" json:"purchaser,omitempty"`
+	SeatClass         SeatClass        `protobuf:"varint,7,opt,name=seatClass,proto3,enum=train.SeatClass" json:"seatClass,omitempty"`
+	PassengerType     PassengerType    `protobuf:"varint,8,opt,name=passengerType,proto3,enum=train.PassengerType" json:"passengerType,omitempty"`
+	Preferences       *SeatPreferences `protobuf:"bytes,9,opt,name=preferences,proto3" json:"preferences,omitempty"`
+}
+
+func (x *PurchaseReturnRequest) Reset() {
+	*x = PurchaseReturnRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_train_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PurchaseReturnRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurchaseReturnRequest) ProtoMessage() {}
+
+func (x *PurchaseReturnRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_train_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurchaseReturnRequest.ProtoReflect.Descriptor instead.
+func (*PurchaseReturnRequest) Descriptor() ([]byte, []int) {
+	return file_proto_train_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *PurchaseReturnRequest) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *PurchaseReturnRequest) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *PurchaseReturnRequest) GetOutboundJourneyId() string {
+	if x != nil {
+		return x.OutboundJourneyId
+	}
+	return ""
+}
+
+func (x *PurchaseReturnRequest) GetReturnJourneyId() string {
+	if x != nil {
+		return x.ReturnJourneyId
+	}
+	return ""
+}
+
+func (x *PurchaseReturnRequest) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *PurchaseReturnRequest) GetPurchaser() *Purchaser {
+	if x != nil {
+		return x.Purchaser
+	}
+	return nil
+}
+
+func (x *PurchaseReturnRequest) GetSeatClass() SeatClass {
+	if x != nil {
+		return x.SeatClass
+	}
+	return SeatClass_SEAT_CLASS_UNSPECIFIED
+}
+
+func (x *PurchaseReturnRequest) GetPassengerType() PassengerType {
+	if x != nil {
+		return x.PassengerType
+	}
+	return PassengerType_PASSENGER_TYPE_UNSPECIFIED
+}
+
+func (x *PurchaseReturnRequest) GetPreferences() *SeatPreferences {
+	if x != nil {
+		return x.Preferences
+	}
+	return nil
+}
+
+// PurchaseReturnResponse holds the outbound and the return ticket, both
+// carry tripReference.
+type PurchaseReturnResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TripReference string    `protobuf:"bytes,1,opt,name=tripReference,proto3" json:"tripReference,omitempty"`
+	Tickets       []*Ticket `protobuf:"bytes,2,rep,name=tickets,proto3" json:"tickets,omitempty"`
+}
+
+func (x *PurchaseReturnResponse) Reset() {
+	*x = PurchaseReturnResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_train_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PurchaseReturnResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurchaseReturnResponse) ProtoMessage() {}
+
+func (x *PurchaseReturnResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_train_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurchaseReturnResponse.ProtoReflect.Descriptor instead.
+func (*PurchaseReturnResponse) Descriptor() ([]byte, []int) {
+	return file_proto_train_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *PurchaseReturnResponse) GetTripReference() string {
+	if x != nil {
+		return x.TripReference
+	}
+	return ""
+}
+
+func (x *PurchaseReturnResponse) GetTickets() []*Ticket {
+	if x != nil {
+		return x.Tickets
+	}
+	return nil
+}
+
+// ItineraryLeg is one train of an itinerary.
+type ItineraryLeg struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	JourneyId string `protobuf:"bytes,1,opt,name=journeyId,proto3" json:"journeyId,omitempty"`
+	From      string `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	To        string `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+}
+
+func (x *ItineraryLeg) Reset() {
+	*x = ItineraryLeg{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_train_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ItineraryLeg) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ItineraryLeg) ProtoMessage() {}
+
+func (x *ItineraryLeg) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_train_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ItineraryLeg.ProtoReflect.Descriptor instead.
+func (*ItineraryLeg) Descriptor() ([]byte, []int) {
+	return file_proto_train_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *ItineraryLeg) GetJourneyId() string {
+	if x != nil {
+		return x.JourneyId
+	}
+	return ""
+}
+
+func (x *ItineraryLeg) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *ItineraryLeg) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+// PurchaseItineraryRequest books a journey that changes trains, every leg
+// starts where the one before ends. Either every leg is booked or none is.
+type PurchaseItineraryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Legs          []*ItineraryLeg  `protobuf:"bytes,1,rep,name=legs,proto3" json:"legs,omitempty"`
+	User          *User            `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"`
+	Purchaser     *Purchaser       `protobuf:"bytes,3,opt,name=purchaser,proto3" json:"purchaser,omitempty"`
+	SeatClass     SeatClass        `protobuf:"varint,4,opt,name=seatClass,proto3,enum=train.SeatClass" json:"seatClass,omitempty"`
+	PassengerType PassengerType    `protobuf:"varint,5,opt,name=passengerType,proto3,enum=train.PassengerType" json:"passengerType,omitempty"`
+	Preferences   *SeatPreferences `protobuf:"bytes,6,opt,name=preferences,proto3" json:"preferences,omitempty"`
+}
+
+func (x *PurchaseItineraryRequest) Reset() {
+	*x = PurchaseItineraryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_train_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PurchaseItineraryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurchaseItineraryRequest) ProtoMessage() {}
+
+func (x *PurchaseItineraryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_train_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurchaseItineraryRequest.ProtoReflect.Descriptor instead.
+func (*PurchaseItineraryRequest) Descriptor() ([]byte, []int) {
+	return file_proto_train_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *PurchaseItineraryRequest) GetLegs() []*ItineraryLeg {
+	if x != nil {
+		return x.Legs
+	}
+	return nil
+}
+
+func (x *PurchaseItineraryRequest) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *PurchaseItineraryRequest) GetPurchaser() *Purchaser {
+	if x != nil {
+		return x.Purchaser
+	}
+	return nil
+}
+
+func (x *PurchaseItineraryRequest) GetSeatClass() SeatClass {
+	if x != nil {
+		return x.SeatClass
+	}
+	return SeatClass_SEAT_CLASS_UNSPECIFIED
+}
+
+func (x *PurchaseItineraryRequest) GetPassengerType() PassengerType {
+	if x != nil {
+		return x.PassengerType
+	}
+	return PassengerType_PASSENGER_TYPE_UNSPECIFIED
+}
+
+func (x *PurchaseItineraryRequest) GetPreferences() *SeatPreferences {
+	if x != nil {
+		return x.Preferences
+	}
+	return nil
+}
+
+// PurchaseItineraryResponse holds a ticket per leg in travel order, all
+// carrying itineraryReference.
+type PurchaseItineraryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ItineraryReference string    `protobuf:"bytes,1,opt,name=itineraryReference,proto3" json:"itineraryReference,omitempty"`
+	Tickets            []*Ticket `protobuf:"bytes,2,rep,name=tickets,proto3" json:"tickets,omitempty"`
+}
+
+func (x *PurchaseItineraryResponse) Reset() {
+	*x = PurchaseItineraryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_train_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PurchaseItineraryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurchaseItineraryResponse) ProtoMessage() {}
+
+func (x *PurchaseItineraryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_train_proto_msgTypes[78]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurchaseItineraryResponse.ProtoReflect.Descriptor instead.
+func (*PurchaseItineraryResponse) Descriptor() ([]byte, []int) {
+	return file_proto_train_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *PurchaseItineraryResponse) GetItineraryReference() string {
+	if x != nil {
+		return x.ItineraryReference
+	}
+	return ""
+}
+
+func (x *PurchaseItineraryResponse) GetTickets() []*Ticket {
+	if x != nil {
+		return x.Tickets
+	}
+	return nil
+}
+
+// Pass is a prepaid product for travelling the same route again and again,
+// either way. rides is zero for a season ticket, which has no ride limit.
+type Pass struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type           PassType               `protobuf:"varint,2,opt,name=type,proto3,enum=train.PassType" json:"type,omitempty"`
+	From           string                 `protobuf:"bytes,3,opt,name=from,proto3" json:"from,omitempty"`
+	To             string                 `protobuf:"bytes,4,opt,name=to,proto3" json:"to,omitempty"`
+	User           *User                  `protobuf:"bytes,5,opt,name=user,proto3" json:"user,omitempty"`
+	Purchaser      *Purchaser             `protobuf:"bytes,6,opt,name=purchaser,proto3" json:"purchaser,omitempty"`
+	SeatClass      SeatClass              `protobuf:"varint,7,opt,name=seatClass,proto3,enum=train.SeatClass" json:"seatClass,omitempty"`
+	PassengerType  PassengerType          `protobuf:"varint,8,opt,name=passengerType,proto3,enum=train.PassengerType" json:"passengerType,omitempty"`
+	ValidFrom      *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=validFrom,proto3" json:"validFrom,omitempty"`
+	ValidUntil     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=validUntil,proto3" json:"validUntil,omitempty"`
+	Rides          int32                  `protobuf:"varint,11,opt,name=rides,proto3" json:"rides,omitempty"`
+	RidesUsed      int32                  `protobuf:"varint,12,opt,name=ridesUsed,proto3" json:"ridesUsed,omitempty"`
+	RidesRemaining int32                  `protobuf:"varint,13,opt,name=ridesRemaining,proto3" json:"ridesRemaining,omitempty"`
+	Price          *Money                 `protobuf:"bytes,14,opt,name=price,proto3" json:"price,omitempty"`
+	PaymentId      string                 `protobuf:"bytes,15,opt,name=paymentId,proto3" json:"paymentId,omitempty"`
+	PaymentStatus  PaymentStatus          `protobuf:"varint,16,opt,name=paymentStatus,proto3,enum=train.PaymentStatus" json:"paymentStatus,omitempty"`
+}
+
+func (x *Pass) Reset() {
+	*x = Pass{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_train_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Pass) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pass) ProtoMessage() {}
+
+func (x *Pass) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_train_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pass.ProtoReflect.Descriptor instead.
+func (*Pass) Descriptor() ([]byte, []int) {
+	return file_proto_train_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *Pass) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Pass) GetType() PassType {
+	if x != nil {
+		return x.Type
+	}
+	return PassType_PASS_TYPE_UNSPECIFIED
+}
+
+func (x *Pass) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *Pass) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *Pass) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *Pass) GetPurchaser() *Purchaser {
+	if x != nil {
+		return x.Purchaser
+	}
+	return nil
+}
+
+func (x *Pass) GetSeatClass() SeatClass {
+	if x != nil {
+		return x.SeatClass
+	}
+	return SeatClass_SEAT_CLASS_UNSPECIFIED
+}
+
+func (x *Pass) GetPassengerType() PassengerType {
+	if x != nil {
+		return x.PassengerType
+	}
+	return PassengerType_PASSENGER_TYPE_UNSPECIFIED
+}
+
+func (x *Pass) GetValidFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ValidFrom
+	}
+	return nil
+}
+
+func (x *Pass) GetValidUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ValidUntil
+	}
+	return nil
+}
+
+func (x *Pass) GetRides() int32 {
+	if x != nil {
+		return x.Rides
+	}
+	return 0
+}
+
+func (x *Pass) GetRidesUsed() int32 {
+	if x != nil {
+		return x.RidesUsed
+	}
+	return 0
+}
+
+func (x *Pass) GetRidesRemaining() int32 {
+	if x != nil {
+		return x.RidesRemaining
+	}
+	return 0
+}
+
+func (x *Pass) GetPrice() *Money {
+	if x != nil {
+		return x.Price
+	}
+	return nil
+}
+
+func (x *Pass) GetPaymentId() string {
+	if x != nil {
+		return x.PaymentId
+	}
+	return ""
+}
+
+func (x *Pass) GetPaymentStatus() PaymentStatus {
+	if x != nil {
+		return x.PaymentStatus
+	}
+	return PaymentStatus_PAYMENT_STATUS_UNSPECIFIED
+}
+
+// PurchasePassRequest buys a pass for user. startDate is YYYY-MM-DD in the
+// time zone of from and defaults to today, days defaults to 30 for a season
+// ticket and is fixed for a carnet.
+type PurchasePassRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type          PassType      `protobuf:"varint,1,opt,name=type,proto3,enum=train.PassType" json:"type,omitempty"`
+	From          string        `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	To            string        `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	User          *User         `protobuf:"bytes,4,opt,name=user,proto3" json:"user,omitempty"`
+	Purchaser     *Purchaser    `protobuf:"bytes,5,opt,name=purchaser,proto3" json:"purchaser,omitempty"`
+	SeatClass     SeatClass     `protobuf:"varint,6,opt,name=seatClass,proto3,enum=train.SeatClass" json:"seatClass,omitempty"`
+	PassengerType PassengerType `protobuf:"varint,7,opt,name=passengerType,proto3,enum=train.PassengerType" json:"passengerType,omitempty"`
+	StartDate     string        `protobuf:"bytes,8,opt,name=startDate,proto3" json:"startDate,omitempty"`
+	Days          int32         `protobuf:"varint,9,opt,name=days,proto3" json:"days,omitempty"`
+}
+
+func (x *PurchasePassRequest) Reset() {
+	*x = PurchasePassRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_train_proto_msgTypes[80]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PurchasePassRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurchasePassRequest) ProtoMessage() {}
+
+func (x *PurchasePassRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_train_proto_msgTypes[80]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurchasePassRequest.ProtoReflect.Descriptor instead.
+func (*PurchasePassRequest) Descriptor() ([]byte, []int) {
+	return file_proto_train_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *PurchasePassRequest) GetType() PassType {
+	if x != nil {
+		return x.Type
+	}
+	return PassType_PASS_TYPE_UNSPECIFIED
+}
+
+func (x *PurchasePassRequest) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *PurchasePassRequest) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *PurchasePassRequest) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *PurchasePassRequest) GetPurchaser() *Purchaser {
+	if x != nil {
+		return x.Purchaser
+	}
+	return nil
+}
+
+func (x *PurchasePassRequest) GetSeatClass() SeatClass {
+	if x != nil {
+		return x.SeatClass
+	}
+	return SeatClass_SEAT_CLASS_UNSPECIFIED
+}
+
+func (x *PurchasePassRequest) GetPassengerType() PassengerType {
+	if x != nil {
+		return x.PassengerType
+	}
+	return PassengerType_PASSENGER_TYPE_UNSPECIFIED
+}
+
+func (x *PurchasePassRequest) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *PurchasePassRequest) GetDays() int32 {
+	if x != nil {
+		return x.Days
+	}
+	return 0
+}
+
+type PurchasePassResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pass *Pass `protobuf:"bytes,1,opt,name=pass,proto3" json:"pass,omitempty"`
+}
+
+func (x *PurchasePassResponse) Reset() {
+	*x = PurchasePassResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_train_proto_msgTypes[81]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PurchasePassResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurchasePassResponse) ProtoMessage() {}
+
+func (x *PurchasePassResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_train_proto_msgTypes[81]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurchasePassResponse.ProtoReflect.Descriptor instead.
+func (*PurchasePassResponse) Descriptor() ([]byte, []int) {
+	return file_proto_train_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *PurchasePassResponse) GetPass() *Pass {
+	if x != nil {
+		return x.Pass
+	}
+	return nil
+}
+
+type GetPassRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *GetPassRequest) Reset() {
+	*x = GetPassRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_train_proto_msgTypes[82]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPassRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPassRequest) ProtoMessage() {}
+
+func (x *GetPassRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_train_proto_msgTypes[82]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPassRequest.ProtoReflect.Descriptor instead.
+func (*GetPassRequest) Descriptor() ([]byte, []int) {
+	return file_proto_train_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *GetPassRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// GetPassResponse holds the pass and the tickets booked on it, cancelled
+// rides included.
+type GetPassResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pass    *Pass     `protobuf:"bytes,1,opt,name=pass,proto3" json:"pass,omitempty"`
+	Tickets []*Ticket `protobuf:"bytes,2,rep,name=tickets,proto3" json:"tickets,omitempty"`
+}
+
+func (x *GetPassResponse) Reset() {
+	*x = GetPassResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_train_proto_msgTypes[83]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPassResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPassResponse) ProtoMessage() {}
+
+func (x *GetPassResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_train_proto_msgTypes[83]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPassResponse.ProtoReflect.Descriptor instead.
+func (*GetPassResponse) Descriptor() ([]byte, []int) {
+	return file_proto_train_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *GetPassResponse) GetPass() *Pass {
+	if x != nil {
+		return x.Pass
+	}
+	return nil
+}
+
+func (x *GetPassResponse) GetTickets() []*Ticket {
+	if x != nil {
+		return x.Tickets
+	}
+	return nil
+}
+
+// DisruptionNotice tells one passenger how a disrupted journey affects their
+// ticket. A rebooked ticket keeps its reference and moves to newJourneyId
+// and newSeat, estimatedArrival is when the passenger now gets to their
+// destination.
+type DisruptionNotice struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Reference        string                 `protobuf:"bytes,1,opt,name=reference,proto3" json:"reference,omitempty"`
+	User             *User                  `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"`
+	Purchaser        *Purchaser             `protobuf:"bytes,3,opt,name=purchaser,proto3" json:"purchaser,omitempty"`
+	Action           DisruptionAction       `protobuf:"varint,4,opt,name=action,proto3,enum=train.DisruptionAction" json:"action,omitempty"`
+	JourneyId        string                 `protobuf:"bytes,5,opt,name=journeyId,proto3" json:"journeyId,omitempty"`
+	NewJourneyId     string                 `protobuf:"bytes,6,opt,name=newJourneyId,proto3" json:"newJourneyId,omitempty"`
+	NewSeat          string                 `protobuf:"bytes,7,opt,name=newSeat,proto3" json:"newSeat,omitempty"`
+	EstimatedArrival *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=estimatedArrival,proto3" json:"estimatedArrival,omitempty"`
+	Refund           *Money                 `protobuf:"bytes,9,opt,name=refund,proto3" json:"refund,omitempty"`
+	Message          string                 `protobuf:"bytes,10,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *DisruptionNotice) Reset() {
+	*x = DisruptionNotice{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_train_proto_msgTypes[84]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DisruptionNotice) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisruptionNotice) ProtoMessage() {}
+
+func (x *DisruptionNotice) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_train_proto_msgTypes[84]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisruptionNotice.ProtoReflect.Descriptor instead.
+func (*DisruptionNotice) Descriptor() ([]byte, []int) {
+	return file_proto_train_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *DisruptionNotice) GetReference() string {
+	if x != nil {
+		return x.Reference
+	}
+	return ""
+}
+
+func (x *DisruptionNotice) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *DisruptionNotice) GetPurchaser() *Purchaser {
+	if x != nil {
+		return x.Purchaser
+	}
+	return nil
+}
+
+func (x *DisruptionNotice) GetAction() DisruptionAction {
+	if x != nil {
+		return x.Action
+	}
+	return DisruptionAction_DISRUPTION_ACTION_UNSPECIFIED
+}
+
+func (x *DisruptionNotice) GetJourneyId() string {
+	if x != nil {
+		return x.JourneyId
+	}
+	return ""
+}
+
+func (x *DisruptionNotice) GetNewJourneyId() string {
+	if x != nil {
+		return x.NewJourneyId
+	}
+	return ""
+}
+
+func (x *DisruptionNotice) GetNewSeat() string {
+	if x != nil {
+		return x.NewSeat
+	}
+	return ""
+}
+
+func (x *DisruptionNotice) GetEstimatedArrival() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EstimatedArrival
+	}
+	return nil
+}
+
+func (x *DisruptionNotice) GetRefund() *Money {
+	if x != nil {
+		return x.Refund
+	}
+	return nil
+}
+
+func (x *DisruptionNotice) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// DelayJourneyRequest sets when a journey is now expected at its last stop,
+// the scheduled arrival puts the journey back on time.
+type DelayJourneyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	JourneyId        string                 `protobuf:"bytes,1,opt,name=journeyId,proto3" json:"journeyId,omitempty"`
+	EstimatedArrival *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=estimatedArrival,proto3" json:"estimatedArrival,omitempty"`
+	Reason           string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *DelayJourneyRequest) Reset() {
+	*x = DelayJourneyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_train_proto_msgTypes[85]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DelayJourneyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DelayJourneyRequest) ProtoMessage() {}
+
+func (x *DelayJourneyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_train_proto_msgTypes[85]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DelayJourneyRequest.ProtoReflect.Descriptor instead.
+func (*DelayJourneyRequest) Descriptor() ([]byte, []int) {
+	return file_proto_train_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *DelayJourneyRequest) GetJourneyId() string {
+	if x != nil {
+		return x.JourneyId
+	}
+	return ""
+}
+
+func (x *DelayJourneyRequest) GetEstimatedArrival() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EstimatedArrival
+	}
+	return nil
+}
+
+func (x *DelayJourneyRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// DelayJourneyResponse holds a notice per ticket on the journey.
+type DelayJourneyResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Journey *Journey            `protobuf:"bytes,1,opt,name=journey,proto3" json:"journey,omitempty"`
+	Report  []*DisruptionNotice `protobuf:"bytes,2,rep,name=report,proto3" json:"report,omitempty"`
+}
+
+func (x *DelayJourneyResponse) Reset() {
+	*x = DelayJourneyResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_train_proto_msgTypes[86]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DelayJourneyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DelayJourneyResponse) ProtoMessage() {}
+
+func (x *DelayJourneyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_train_proto_msgTypes[86]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DelayJourneyResponse.ProtoReflect.Descriptor instead.
+func (*DelayJourneyResponse) Descriptor() ([]byte, []int) {
+	return file_proto_train_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *DelayJourneyResponse) GetJourney() *Journey {
+	if x != nil {
+		return x.Journey
+	}
+	return nil
+}
+
+func (x *DelayJourneyResponse) GetReport() []*DisruptionNotice {
+	if x != nil {
+		return x.Report
+	}
+	return nil
+}
+
+type CancelJourneyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	JourneyId string `protobuf:"bytes,1,opt,name=journeyId,proto3" json:"journeyId,omitempty"`
+	Reason    string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *CancelJourneyRequest) Reset() {
+	*x = CancelJourneyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_train_proto_msgTypes[87]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelJourneyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelJourneyRequest) ProtoMessage() {}
+
+func (x *CancelJourneyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_train_proto_msgTypes[87]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelJourneyRequest.ProtoReflect.Descriptor instead.
+func (*CancelJourneyRequest) Descriptor() ([]byte, []int) {
+	return file_proto_train_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *CancelJourneyRequest) GetJourneyId() string {
+	if x != nil {
+		return x.JourneyId
+	}
+	return ""
+}
+
+func (x *CancelJourneyRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// CancelJourneyResponse holds a notice per ticket that was on the journey,
+// rebooked onto a later journey or refunded in full.
+type CancelJourneyResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Journey *Journey            `protobuf:"bytes,1,opt,name=journey,proto3" json:"journey,omitempty"`
+	Report  []*DisruptionNotice `protobuf:"bytes,2,rep,name=report,proto3" json:"report,omitempty"`
+}
+
+func (x *CancelJourneyResponse) Reset() {
+	*x = CancelJourneyResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_train_proto_msgTypes[88]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelJourneyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelJourneyResponse) ProtoMessage() {}
+
+func (x *CancelJourneyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_train_proto_msgTypes[88]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelJourneyResponse.ProtoReflect.Descriptor instead.
+func (*CancelJourneyResponse) Descriptor() ([]byte, []int) {
+	return file_proto_train_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *CancelJourneyResponse) GetJourney() *Journey {
+	if x != nil {
+		return x.Journey
+	}
+	return nil
+}
+
+func (x *CancelJourneyResponse) GetReport() []*DisruptionNotice {
+	if x != nil {
+		return x.Report
+	}
+	return nil
+}
+
+var File_proto_train_proto protoreflect.FileDescriptor
+
+var file_proto_train_proto_rawDesc = []byte{
+	0x0a, 0x11, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x12, 0x05, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x99, 0x08, 0x0a, 0x06,
+	0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x6f,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x1f, 0x0a, 0x04, 0x75, 0x73,
+	0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e,
+	0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x73,
+	0x65, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x65, 0x61, 0x74, 0x12,
+	0x1c, 0x0a, 0x09, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x49, 0x64, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x49, 0x64, 0x12, 0x22, 0x0a,
+	0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x74,
+	0x72, 0x61, 0x69, 0x6e, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63,
+	0x65, 0x12, 0x3a, 0x0a, 0x0d, 0x66, 0x61, 0x72, 0x65, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x64, 0x6f,
+	0x77, 0x6e, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e,
+	0x2e, 0x46, 0x61, 0x72, 0x65, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x52, 0x0d,
+	0x66, 0x61, 0x72, 0x65, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x64, 0x6f, 0x77, 0x6e, 0x12, 0x2e, 0x0a,
+	0x09, 0x73, 0x65, 0x61, 0x74, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x10, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x2e, 0x53, 0x65, 0x61, 0x74, 0x43, 0x6c, 0x61,
+	0x73, 0x73, 0x52, 0x09, 0x73, 0x65, 0x61, 0x74, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x12, 0x3a, 0x0a,
+	0x0d, 0x70, 0x61, 0x73, 0x73, 0x65, 0x6e, 0x67, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x2e, 0x50, 0x61, 0x73,
+	0x73, 0x65, 0x6e, 0x67, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0d, 0x70, 0x61, 0x73, 0x73,
+	0x65, 0x6e, 0x67, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x66,
+	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65,
+	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12,
+	0x3f, 0x0a, 0x0f, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x48, 0x6f, 0x6c, 0x64, 0x65,
+	0x72, 0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e,
+	0x2e, 0x50, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x48, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x52,
+	0x0f, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x48, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x73,
+	0x12, 0x24, 0x0a, 0x0d, 0x6e, 0x6f, 0x6e, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x61, 0x62, 0x6c,
+	0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x6e, 0x6f, 0x6e, 0x52, 0x65, 0x66, 0x75,
+	0x6e, 0x64, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x37, 0x0a, 0x0c, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c,
+	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x74,
+	0x72, 0x61, 0x69, 0x6e, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x0c, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x1c, 0x0a, 0x09, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x10, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x3a, 0x0a,
+	0x0d, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x11,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x2e, 0x50, 0x61, 0x79,
+	0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x0d, 0x70, 0x61, 0x79, 0x6d,
+	0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x69, 0x6e, 0x76,
+	0x6f, 0x69, 0x63, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x12, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0d, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12,
+	0x3a, 0x0a, 0x0a, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x64, 0x41, 0x74, 0x18, 0x13, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x0a, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x64, 0x41, 0x74, 0x12, 0x2e, 0x0a, 0x09, 0x70,
+	0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x65, 0x72, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10,
+	0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x2e, 0x50, 0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x65, 0x72,
+	0x52, 0x09, 0x70, 0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x65, 0x72, 0x12, 0x38, 0x0a, 0x0f, 0x75,
+	0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x15,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x2e, 0x50, 0x61, 0x79,
+	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0f, 0x75, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x50, 0x61, 0x79,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x74, 0x72, 0x69, 0x70, 0x52, 0x65, 0x66,
+	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x16, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x74, 0x72,
+	0x69, 0x70, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x28, 0x0a, 0x07, 0x74,
+	0x72, 0x69, 0x70, 0x4c, 0x65, 0x67, 0x18, 0x17, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x74,
+	0x72, 0x61, 0x69, 0x6e, 0x2e, 0x54, 0x72, 0x69, 0x70, 0x4c, 0x65, 0x67, 0x52, 0x07, 0x74, 0x72,
+	0x69, 0x70, 0x4c, 0x65, 0x67, 0x12, 0x2e, 0x0a, 0x12, 0x69, 0x74, 0x69, 0x6e, 0x65, 0x72, 0x61,
+	0x72, 0x79, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x18, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x12, 0x69, 0x74, 0x69, 0x6e, 0x65, 0x72, 0x61, 0x72, 0x79, 0x52, 0x65, 0x66, 0x65,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x69, 0x74, 0x69, 0x6e, 0x65, 0x72, 0x61,
+	0x72, 0x79, 0x4c, 0x65, 0x67, 0x18, 0x19, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x69, 0x74, 0x69,
+	0x6e, 0x65, 0x72, 0x61, 0x72, 0x79, 0x4c, 0x65, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x61, 0x73,
+	0x73, 0x49, 0x64, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x61, 0x73, 0x73, 0x49,
+	0x64, 0x4a, 0x04, 0x08, 0x04, 0x10, 0x05, 0x22, 0xf1, 0x01, 0x0a, 0x07, 0x50, 0x61, 0x79, 0x6d,
+	0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x24, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x2e, 0x4d, 0x6f, 0x6e, 0x65,
+	0x79, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2c, 0x0a, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x74, 0x72, 0x61, 0x69,
+	0x6e, 0x2e, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x24, 0x0a, 0x0d, 0x69, 0x6e, 0x76,
+	0x6f, 0x69, 0x63, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0d, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12,
+	0x3a, 0x0a, 0x0a, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x64, 0x41, 0x74, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x0a, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x64, 0x41, 0x74, 0x22, 0xa6, 0x01, 0x0a, 0x0c,
+	0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3c, 0x0a, 0x0b,
+	0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x65, 0x64, 0x41, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x63,
+	0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x65, 0x64, 0x41, 0x74, 0x12, 0x24, 0x0a, 0x06, 0x72, 0x65,
+	0x66, 0x75, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x74, 0x72, 0x61,
+	0x69, 0x6e, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x06, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64,
+	0x12, 0x1e, 0x0a, 0x03, 0x66, 0x65, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e,
+	0x74, 0x72, 0x61, 0x69, 0x6e, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x03, 0x66, 0x65, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x72, 0x75, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x72, 0x75, 0x6c, 0x65, 0x22, 0x91, 0x01, 0x0a, 0x0e, 0x50, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75,
+	0x73, 0x48, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x12, 0x1f, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x2e, 0x55, 0x73,
+	0x65, 0x72, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x66, 0x65,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x66,
+	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x40, 0x0a, 0x0d, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66,
+	0x65, 0x72, 0x72, 0x65, 0x64, 0x41, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0d, 0x74, 0x72, 0x61, 0x6e, 0x73,
+	0x66, 0x65, 0x72, 0x72, 0x65, 0x64, 0x41, 0x74, 0x22, 0x43, 0x0a, 0x05, 0x4d, 0x6f, 0x6e, 0x65,
+	0x79, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x1e, 0x0a,
+	0x0a, 0x6d, 0x69, 0x6e, 0x6f, 0x72, 0x55, 0x6e, 0x69, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0a, 0x6d, 0x69, 0x6e, 0x6f, 0x72, 0x55, 0x6e, 0x69, 0x74, 0x73, 0x22, 0x57, 0x0a,
+	0x0d, 0x46, 0x61, 0x72, 0x65, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x12, 0x20,
+	0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x24, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0c, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x06,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xbf, 0x03, 0x0a, 0x07, 0x4a, 0x6f, 0x75, 0x72, 0x6e,
+	0x65, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x4e, 0x75, 0x6d, 0x62, 0x65,
+	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x4e, 0x75,
+	0x6d, 0x62, 0x65, 0x72, 0x12, 0x38, 0x0a, 0x09, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x09, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73,
+	0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x74, 0x69,
+	0x72, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x72, 0x65, 0x74, 0x69, 0x72,
+	0x65, 0x64, 0x12, 0x21, 0x0a, 0x05, 0x73, 0x74, 0x6f, 0x70, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x0b, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x2e, 0x53, 0x74, 0x6f, 0x70, 0x52, 0x05,
+	0x73, 0x74, 0x6f, 0x70, 0x73, 0x12, 0x2c, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x2e, 0x4a, 0x6f,
+	0x75, 0x72, 0x6e, 0x65, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x2f, 0x0a, 0x05, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x05, 0x64,
+	0x65, 0x6c, 0x61, 0x79, 0x12, 0x46, 0x0a, 0x10, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65,
+	0x64, 0x41, 0x72, 0x72, 0x69, 0x76, 0x61, 0x6c, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x10, 0x65, 0x73, 0x74, 0x69,
+	0x6d, 0x61, 0x74, 0x65, 0x64, 0x41, 0x72, 0x72, 0x69, 0x76, 0x61, 0x6c, 0x12, 0x2a, 0x0a, 0x10,
+	0x64, 0x69, 0x73, 0x72, 0x75, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x64, 0x69, 0x73, 0x72, 0x75, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x90, 0x01, 0x0a, 0x04, 0x53, 0x74, 0x6f,
+	0x70, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x34, 0x0a, 0x07, 0x61,
+	0x72, 0x72, 0x69, 0x76, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x61, 0x72, 0x72, 0x69, 0x76, 0x61,
+	0x6c, 0x12, 0x38, 0x0a, 0x09, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x09, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x22, 0x34, 0x0a, 0x0a, 0x53,
+	0x65, 0x61, 0x74, 0x4c, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x12, 0x26, 0x0a, 0x07, 0x63, 0x6f, 0x61,
+	0x63, 0x68, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x74, 0x72, 0x61,
+	0x69, 0x6e, 0x2e, 0x43, 0x6f, 0x61, 0x63, 0x68, 0x52, 0x07, 0x63, 0x6f, 0x61, 0x63, 0x68, 0x65,
+	0x73, 0x22, 0xea, 0x01, 0x0a, 0x05, 0x43, 0x6f, 0x61, 0x63, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x63,
+	0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x72,
+	0x6f, 0x77, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x12, 0x1e, 0x0a,
+	0x0a, 0x61, 0x69, 0x73, 0x6c, 0x65, 0x41, 0x66, 0x74, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0a, 0x61, 0x69, 0x73, 0x6c, 0x65, 0x41, 0x66, 0x74, 0x65, 0x72, 0x12, 0x2b, 0x0a,
+	0x05, 0x73, 0x65, 0x61, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74,
+	0x72, 0x61, 0x69, 0x6e, 0x2e, 0x53, 0x65, 0x61, 0x74, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x05, 0x73, 0x65, 0x61, 0x74, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x62, 0x61,
+	0x63, 0x6b, 0x77, 0x61, 0x72, 0x64, 0x52, 0x6f, 0x77, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x05,
+	0x52, 0x0c, 0x62, 0x61, 0x63, 0x6b, 0x77, 0x61, 0x72, 0x64, 0x52, 0x6f, 0x77, 0x73, 0x12, 0x2e,
+	0x0a, 0x09, 0x73, 0x65, 0x61, 0x74, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x10, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x2e, 0x53, 0x65, 0x61, 0x74, 0x43, 0x6c,
+	0x61, 0x73, 0x73, 0x52, 0x09, 0x73, 0x65, 0x61, 0x74, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x22, 0x6a,
+	0x0a, 0x0e, 0x53, 0x65, 0x61, 0x74, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x10, 0x0a, 0x03, 0x72, 0x6f, 0x77, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x72,
+	0x6f, 0x77, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x74,
+	0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a,
+	0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x22, 0x56, 0x0a, 0x04, 0x55, 0x73,
+	0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x66, 0x69, 0x72, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x66, 0x69, 0x72, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61,
+	0x69, 0x6c, 0x22, 0x99, 0x01, 0x0a, 0x09, 0x50, 0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x65, 0x72,
+	0x12, 0x1c, 0x0a, 0x09, 0x66, 0x69, 0x72, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x66, 0x69, 0x72, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a,
+	0x0a, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d,
+	0x61, 0x69, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c,
+	0x12, 0x14, 0x0a, 0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e,
+	0x67, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e,
+	0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x8c,
+	0x03, 0x0a, 0x15, 0x50, 0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x65, 0x54, 0x69, 0x63, 0x6b, 0x65,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x0e, 0x0a, 0x02,
+	0x74, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x1f, 0x0a, 0x04,
+	0x75, 0x73, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x74, 0x72, 0x61,
+	0x69, 0x6e, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x1c, 0x0a,
+	0x09, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x49, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x49, 0x64, 0x12, 0x3a, 0x0a, 0x0d, 0x70,
+	0x61, 0x73, 0x73, 0x65, 0x6e, 0x67, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x14, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x2e, 0x50, 0x61, 0x73, 0x73, 0x65,
+	0x6e, 0x67, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0d, 0x70, 0x61, 0x73, 0x73, 0x65, 0x6e,
+	0x67, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x38, 0x0a, 0x0b, 0x70, 0x72, 0x65, 0x66, 0x65,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x74,
+	0x72, 0x61, 0x69, 0x6e, 0x2e, 0x53, 0x65, 0x61, 0x74, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65,
+	0x6e, 0x63, 0x65, 0x73, 0x52, 0x0b, 0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
+	0x73, 0x12, 0x22, 0x0a, 0x0c, 0x6a, 0x6f, 0x69, 0x6e, 0x57, 0x61, 0x69, 0x74, 0x6c, 0x69, 0x73,
+	0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x6a, 0x6f, 0x69, 0x6e, 0x57, 0x61, 0x69,
+	0x74, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x2e, 0x0a, 0x09, 0x70, 0x75, 0x72, 0x63, 0x68, 0x61, 0x73,
+	0x65, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e,
+	0x2e, 0x50, 0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x65, 0x72, 0x52, 0x09, 0x70, 0x75, 0x72, 0x63,
+	0x68, 0x61, 0x73, 0x65, 0x72, 0x12, 0x2e, 0x0a, 0x09, 0x73, 0x65, 0x61, 0x74, 0x43, 0x6c, 0x61,
+	0x73, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e,
+	0x2e, 0x53, 0x65, 0x61, 0x74, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x52, 0x09, 0x73, 0x65, 0x61, 0x74,
+	0x43, 0x6c, 0x61, 0x73, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x61, 0x73, 0x73, 0x49, 0x64, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x61, 0x73, 0x73, 0x49, 0x64, 0x22, 0x9a, 0x01,
+	0x0a, 0x0f, 0x53, 0x65, 0x61, 0x74, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
+	0x73, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2f, 0x0a, 0x08, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e,
+	0x74, 0x72, 0x61, 0x69, 0x6e, 0x2e, 0x53, 0x65, 0x61, 0x74, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x24, 0x0a, 0x0d,
+	0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x46, 0x61, 0x63, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0d, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x46, 0x61, 0x63, 0x69,
+	0x6e, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x61, 0x74, 0x49, 0x64, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x61, 0x74, 0x49, 0x64, 0x22, 0x9b, 0x01, 0x0a, 0x16, 0x50,
+	0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x65, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x06, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x2e, 0x54, 0x69,
+	0x63, 0x6b, 0x65, 0x74, 0x52, 0x06, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x28, 0x0a, 0x0f,
+	0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x6f, 0x74, 0x65, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x4e, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x30, 0x0a, 0x08, 0x77, 0x61, 0x69, 0x74, 0x6c, 0x69,
+	0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e,
+	0x2e, 0x57, 0x61, 0x69, 0x74, 0x6c, 0x69, 0x73, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08,
+	0x77, 0x61, 0x69, 0x74, 0x6c, 0x69, 0x73, 0x74, 0x22, 0xd6, 0x01, 0x0a, 0x0d, 0x57, 0x61, 0x69,
+	0x74, 0x6c, 0x69, 0x73, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x6a, 0x6f,
+	0x75, 0x72, 0x6e, 0x65, 0x79, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6a,
+	0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x0e, 0x0a, 0x02,
+	0x74, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x1f, 0x0a, 0x04,
+	0x75, 0x73, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x74, 0x72, 0x61,
+	0x69, 0x6e, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x1a, 0x0a,
+	0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x36, 0x0a, 0x08, 0x6a, 0x6f, 0x69,
+	0x6e, 0x65, 0x64, 0x41, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x6a, 0x6f, 0x69, 0x6e, 0x65, 0x64, 0x41,
+	0x74, 0x22, 0x46, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x1c, 0x0a, 0x09, 0x72,
+	0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x22, 0x5d, 0x0a, 0x11, 0x47, 0x65, 0x74,
+	0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25,
+	0x0a, 0x06, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d,
+	0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x2e, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x06, 0x74,
+	0x69, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x21, 0x0a, 0x04, 0x74, 0x72, 0x69, 0x70, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x2e, 0x54, 0x69, 0x63, 0x6b,
+	0x65, 0x74, 0x52, 0x04, 0x74, 0x72, 0x69, 0x70, 0x22, 0x76, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x53,
+	0x65, 0x61, 0x74, 0x73, 0x42, 0x79, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c,
+	0x0a, 0x09, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04,
+	0x66, 0x72, 0x6f, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d,
+	0x12, 0x0e, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x74, 0x6f,
+	0x22, 0x98, 0x01, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x53, 0x65, 0x61, 0x74, 0x73, 0x42, 0x79, 0x53,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41,
+	0x0a, 0x05, 0x73, 0x65, 0x61, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e,
+	0x74, 0x72, 0x61, 0x69, 0x6e, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x61, 0x74, 0x73, 0x42, 0x79,
+	0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e,
+	0x53, 0x65, 0x61, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x73, 0x65, 0x61, 0x74,
+	0x73, 0x1a, 0x38, 0x0a, 0x0a, 0x53, 0x65, 0x61, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x47, 0x0a, 0x11, 0x52,
+	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65,
+	0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x66, 0x65, 0x72,
+	0x65, 0x6e, 0x63, 0x65, 0x22, 0x67, 0x0a, 0x12, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x55, 0x73,
+	0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x12, 0x37, 0x0a, 0x0c, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x74, 0x72, 0x61,
+	0x69, 0x6e, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x0c, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x7f, 0x0a,
+	0x11, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x79, 0x53, 0x65, 0x61, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x6e, 0x65, 0x77, 0x53,
+	0x65, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6e, 0x65, 0x77, 0x53, 0x65,
+	0x61, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x49, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x49, 0x64,
+	0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x22, 0x2e,
+	0x0a, 0x12, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x79, 0x53, 0x65, 0x61, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0xfa,
+	0x01, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x74, 0x72, 0x61, 0x69, 0x6e,
+	0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x74, 0x72,
+	0x61, 0x69, 0x6e, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x38, 0x0a, 0x09, 0x64, 0x65, 0x70,
+	0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74,
+	0x75, 0x72, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x64,
+	0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x29, 0x0a,
+	0x06, 0x6c, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e,
+	0x74, 0x72, 0x61, 0x69, 0x6e, 0x2e, 0x53, 0x65, 0x61, 0x74, 0x4c, 0x61, 0x79, 0x6f, 0x75, 0x74,
+	0x52, 0x06, 0x6c, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x12, 0x21, 0x0a, 0x05, 0x73, 0x74, 0x6f, 0x70,
+	0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x2e,
+	0x53, 0x74, 0x6f, 0x70, 0x52, 0x05, 0x73, 0x74, 0x6f, 0x70, 0x73, 0x22, 0x41, 0x0a, 0x15, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x07, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x2e, 0x4a, 0x6f,
+	0x75, 0x72, 0x6e, 0x65, 0x79, 0x52, 0x07, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x22, 0x3d,
+	0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65,
+	0x52, 0x65, 0x74, 0x69, 0x72, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x69,
+	0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x52, 0x65, 0x74, 0x69, 0x72, 0x65, 0x64, 0x22, 0x42, 0x0a,
+	0x14, 0x4c, 0x69, 0x73, 0x74, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x08, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x2e,
+	0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x52, 0x08, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79,
+	0x73, 0x22, 0x34, 0x0a, 0x14, 0x52, 0x65, 0x74, 0x69, 0x72, 0x65, 0x4a, 0x6f, 0x75, 0x72, 0x6e,
+	0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x6a, 0x6f, 0x75,
+	0x72, 0x6e, 0x65, 0x79, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6a, 0x6f,
+	0x75, 0x72, 0x6e, 0x65, 0x79, 0x49, 0x64, 0x22, 0x31, 0x0a, 0x15, 0x52, 0x65, 0x74, 0x69, 0x72,
+	0x65, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x5f, 0x0a, 0x14, 0x53, 0x65,
+	0x74, 0x53, 0x65, 0x61, 0x74, 0x4c, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x49, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x49, 0x64,
+	0x12, 0x29, 0x0a, 0x06, 0x6c, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x11, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x2e, 0x53, 0x65, 0x61, 0x74, 0x4c, 0x61, 0x79,
+	0x6f, 0x75, 0x74, 0x52, 0x06, 0x6c, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x22, 0x2d, 0x0a, 0x15, 0x53,
+	0x65, 0x74, 0x53, 0x65, 0x61, 0x74, 0x4c, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x65, 0x61, 0x74, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x05, 0x73, 0x65, 0x61, 0x74, 0x73, 0x22, 0xc0, 0x01, 0x0a, 0x10, 0x51,
+	0x75, 0x6f, 0x74, 0x65, 0x46, 0x61, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1c, 0x0a, 0x09, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x49, 0x64, 0x12, 0x12, 0x0a,
+	0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x72, 0x6f,
+	0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x74,
+	0x6f, 0x12, 0x2e, 0x0a, 0x09, 0x73, 0x65, 0x61, 0x74, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x2e, 0x53, 0x65, 0x61,
+	0x74, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x52, 0x09, 0x73, 0x65, 0x61, 0x74, 0x43, 0x6c, 0x61, 0x73,
+	0x73, 0x12, 0x3a, 0x0a, 0x0d, 0x70, 0x61, 0x73, 0x73, 0x65, 0x6e, 0x67, 0x65, 0x72, 0x54, 0x79,
+	0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e,
+	0x2e, 0x50, 0x61, 0x73, 0x73, 0x65, 0x6e, 0x67, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0d,
+	0x70, 0x61, 0x73, 0x73, 0x65, 0x6e, 0x67, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x22, 0x6b, 0x0a,
+	0x11, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x46, 0x61, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x22, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0c, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52,
+	0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x32, 0x0a, 0x09, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x64,
+	0x6f, 0x77, 0x6e, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x74, 0x72, 0x61, 0x69,
+	0x6e, 0x2e, 0x46, 0x61, 0x72, 0x65, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x52,
+	0x09, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x64, 0x6f, 0x77, 0x6e, 0x22, 0x30, 0x0a, 0x18, 0x4c, 0x69,
+	0x73, 0x74, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x42, 0x79, 0x55, 0x73, 0x65, 0x72, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x22, 0x44, 0x0a, 0x19,
+	0x4c, 0x69, 0x73, 0x74, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x42, 0x79, 0x55, 0x73, 0x65,
+	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x07, 0x74, 0x69, 0x63,
+	0x6b, 0x65, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x74, 0x72, 0x61,
+	0x69, 0x6e, 0x2e, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x07, 0x74, 0x69, 0x63, 0x6b, 0x65,
+	0x74, 0x73, 0x22, 0xd4, 0x02, 0x0a, 0x14, 0x50, 0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x65, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x6a,
+	0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f,
+	0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x0e, 0x0a,
+	0x02, 0x74, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x2b, 0x0a,
+	0x0a, 0x70, 0x61, 0x73, 0x73, 0x65, 0x6e, 0x67, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x0b, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x0a,
+	0x70, 0x61, 0x73, 0x73, 0x65, 0x6e, 0x67, 0x65, 0x72, 0x73, 0x12, 0x2f, 0x0a, 0x08, 0x66, 0x61,
+	0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x74,
+	0x72, 0x61, 0x69, 0x6e, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x53, 0x65, 0x61, 0x74, 0x69, 0x6e,
+	0x67, 0x52, 0x08, 0x66, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x12, 0x2e, 0x0a, 0x09, 0x70,
+	0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10,
+	0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x2e, 0x50, 0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x65, 0x72,
+	0x52, 0x09, 0x70, 0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x65, 0x72, 0x12, 0x2e, 0x0a, 0x09, 0x73,
+	0x65, 0x61, 0x74, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10,
+	0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x2e, 0x53, 0x65, 0x61, 0x74, 0x43, 0x6c, 0x61, 0x73, 0x73,
+	0x52, 0x09, 0x73, 0x65, 0x61, 0x74, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x12, 0x3c, 0x0a, 0x0e, 0x70,
+	0x61, 0x73, 0x73, 0x65, 0x6e, 0x67, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x73, 0x18, 0x08, 0x20,
+	0x03, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x2e, 0x50, 0x61, 0x73, 0x73,
+	0x65, 0x6e, 0x67, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0e, 0x70, 0x61, 0x73, 0x73, 0x65,
+	0x6e, 0x67, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x73, 0x22, 0x68, 0x0a, 0x15, 0x50, 0x75, 0x72,
+	0x63, 0x68, 0x61, 0x73, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x66, 0x65, 0x72,
+	0x65, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x27, 0x0a, 0x07, 0x74, 0x69,
+	0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x74, 0x72,
+	0x61, 0x69, 0x6e, 0x2e, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x07, 0x74, 0x69, 0x63, 0x6b,
+	0x65, 0x74, 0x73, 0x22, 0x9b, 0x02, 0x0a, 0x04, 0x48, 0x6f, 0x6c, 0x64, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1c, 0x0a, 0x09,
+	0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x65,
+	0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x65, 0x61, 0x74, 0x12, 0x12,
+	0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x72,
+	0x6f, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x74, 0x6f, 0x12, 0x1f, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0b, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x75,
+	0x73, 0x65, 0x72, 0x12, 0x38, 0x0a, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x12, 0x22, 0x0a,
+	0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x74,
+	0x72, 0x61, 0x69, 0x6e, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63,
+	0x65, 0x12, 0x2e, 0x0a, 0x09, 0x70, 0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x65, 0x72, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x2e, 0x50, 0x75, 0x72,
+	0x63, 0x68, 0x61, 0x73, 0x65, 0x72, 0x52, 0x09, 0x70, 0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x65,
+	0x72, 0x22, 0xca, 0x02, 0x0a, 0x0f, 0x48, 0x6f, 0x6c, 0x64, 0x53, 0x65, 0x61, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79,
+	0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65,
+	0x79, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x1f, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x2e, 0x55, 0x73,
+	0x65, 0x72, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x3a, 0x0a, 0x0d, 0x70, 0x61, 0x73, 0x73,
+	0x65, 0x6e, 0x67, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x14, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x2e, 0x50, 0x61, 0x73, 0x73, 0x65, 0x6e, 0x67, 0x65,
+	0x72, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0d, 0x70, 0x61, 0x73, 0x73, 0x65, 0x6e, 0x67, 0x65, 0x72,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x38, 0x0a, 0x0b, 0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x74, 0x72, 0x61, 0x69,
+	0x6e, 0x2e, 0x53, 0x65, 0x61, 0x74, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
+	0x73, 0x52, 0x0b, 0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x2e,
+	0x0a, 0x09, 0x70, 0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x10, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x2e, 0x50, 0x75, 0x72, 0x63, 0x68, 0x61,
+	0x73, 0x65, 0x72, 0x52, 0x09, 0x70, 0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x65, 0x72, 0x12, 0x2e,
+	0x0a, 0x09, 0x73, 0x65, 0x61, 0x74, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x10, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x2e, 0x53, 0x65, 0x61, 0x74, 0x43, 0x6c,
+	0x61, 0x73, 0x73, 0x52, 0x09, 0x73, 0x65, 0x61, 0x74, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x22, 0x5d,
+	0x0a, 0x10, 0x48, 0x6f, 0x6c, 0x64, 0x53, 0x65, 0x61, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x1f, 0x0a, 0x04, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0b, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x2e, 0x48, 0x6f, 0x6c, 0x64, 0x52, 0x04, 0x68,
+	0x6f, 0x6c, 0x64, 0x12, 0x28, 0x0a, 0x0f, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x4e, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x61, 0x6c,
+	0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x6f, 0x74, 0x65, 0x73, 0x22, 0x2c, 0x0a,
+	0x12, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x48, 0x6f, 0x6c, 0x64, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x6f, 0x6c, 0x64, 0x49, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x68, 0x6f, 0x6c, 0x64, 0x49, 0x64, 0x22, 0x3c, 0x0a, 0x13, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x48, 0x6f, 0x6c, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x25, 0x0a, 0x06, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x2e, 0x54, 0x69, 0x63, 0x6b, 0x65,
+	0x74, 0x52, 0x06, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x22, 0x2c, 0x0a, 0x12, 0x52, 0x65, 0x6c,
+	0x65, 0x61, 0x73, 0x65, 0x48, 0x6f, 0x6c, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x16, 0x0a, 0x06, 0x68, 0x6f, 0x6c, 0x64, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x68, 0x6f, 0x6c, 0x64, 0x49, 0x64, 0x22, 0x2f, 0x0a, 0x13, 0x52, 0x65, 0x6c, 0x65, 0x61,
+	0x73, 0x65, 0x48, 0x6f, 0x6c, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x3c, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x57,
+	0x61, 0x69, 0x74, 0x6c, 0x69, 0x73, 0x74, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x77, 0x61, 0x69, 0x74, 0x6c, 0x69,
+	0x73, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x77, 0x61, 0x69, 0x74,
+	0x6c, 0x69, 0x73, 0x74, 0x49, 0x64, 0x22, 0x49, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x57, 0x61, 0x69,
+	0x74, 0x6c, 0x69, 0x73, 0x74, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x05, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x2e, 0x57, 0x61, 0x69,
+	0x74, 0x6c, 0x69, 0x73, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x65, 0x6e, 0x74, 0x72,
+	0x79, 0x22, 0x36, 0x0a, 0x14, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x57, 0x61, 0x69, 0x74, 0x6c, 0x69,
+	0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x77, 0x61, 0x69,
+	0x74, 0x6c, 0x69, 0x73, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x77,
+	0x61, 0x69, 0x74, 0x6c, 0x69, 0x73, 0x74, 0x49, 0x64, 0x22, 0x31, 0x0a, 0x15, 0x4c, 0x65, 0x61,
+	0x76, 0x65, 0x57, 0x61, 0x69, 0x74, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x96, 0x01, 0x0a,
+	0x10, 0x53, 0x77, 0x61, 0x70, 0x53, 0x65, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12,
+	0x26, 0x0a, 0x0e, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x52, 0x65,
+	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x72, 0x65, 0x71, 0x75, 0x69,
+	0x72, 0x65, 0x43, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0e, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x43, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x74, 0x12,
+	0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x65, 0x6d, 0x61, 0x69, 0x6c, 0x22, 0x6e, 0x0a, 0x11, 0x53, 0x77, 0x61, 0x70, 0x53, 0x65, 0x61,
+	0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x77,
+	0x61, 0x70, 0x70, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x77, 0x61,
+	0x70, 0x70, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x77, 0x61, 0x70, 0x49, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x77, 0x61, 0x70, 0x49, 0x64, 0x12, 0x27, 0x0a, 0x07,
+	0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e,
+	0x74, 0x72, 0x61, 0x69, 0x6e, 0x2e, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x07, 0x74, 0x69,
+	0x63, 0x6b, 0x65, 0x74, 0x73, 0x22, 0x5f, 0x0a, 0x11, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x53,
+	0x77, 0x61, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x77,
+	0x61, 0x70, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x77, 0x61, 0x70,
+	0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x22, 0x3d, 0x0a, 0x12, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74,
+	0x53, 0x77, 0x61, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x07,
+	0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e,
+	0x74, 0x72, 0x61, 0x69, 0x6e, 0x2e, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x07, 0x74, 0x69,
+	0x63, 0x6b, 0x65, 0x74, 0x73, 0x22, 0x72, 0x0a, 0x15, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65,
+	0x72, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c,
+	0x0a, 0x09, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x25, 0x0a, 0x07,
+	0x6e, 0x65, 0x77, 0x55, 0x73, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e,
+	0x74, 0x72, 0x61, 0x69, 0x6e, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x07, 0x6e, 0x65, 0x77, 0x55,
+	0x73, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x22, 0x62, 0x0a, 0x16, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x66, 0x65, 0x72, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x06, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x2e, 0x54, 0x69, 0x63, 0x6b,
+	0x65, 0x74, 0x52, 0x06, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x21, 0x0a, 0x04, 0x74, 0x72,
+	0x69, 0x70, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e,
+	0x2e, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x04, 0x74, 0x72, 0x69, 0x70, 0x22, 0x75, 0x0a,
+	0x11, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x2c, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x2e, 0x52,
+	0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x06, 0x66, 0x6f,
+	0x72, 0x6d, 0x61, 0x74, 0x22, 0x92, 0x01, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x65,
+	0x69, 0x70, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x69,
+	0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0d, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65,
+	0x72, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22, 0x92, 0x01, 0x0a, 0x12, 0x55, 0x70,
+	0x67, 0x72, 0x61, 0x64, 0x65, 0x53, 0x65, 0x61, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65,
+	0x6d, 0x61, 0x69, 0x6c, 0x12, 0x2e, 0x0a, 0x09, 0x73, 0x65, 0x61, 0x74, 0x43, 0x6c, 0x61, 0x73,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x2e,
+	0x53, 0x65, 0x61, 0x74, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x52, 0x09, 0x73, 0x65, 0x61, 0x74, 0x43,
+	0x6c, 0x61, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6e, 0x65, 0x77, 0x53, 0x65, 0x61, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6e, 0x65, 0x77, 0x53, 0x65, 0x61, 0x74, 0x22, 0x64,
+	0x0a, 0x13, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x53, 0x65, 0x61, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x06, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x2e, 0x54, 0x69,
+	0x63, 0x6b, 0x65, 0x74, 0x52, 0x06, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x26, 0x0a, 0x07,
+	0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e,
+	0x74, 0x72, 0x61, 0x69, 0x6e, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x07, 0x63, 0x68, 0x61,
+	0x72, 0x67, 0x65, 0x64, 0x22, 0xc9, 0x01, 0x0a, 0x09, 0x53, 0x65, 0x61, 0x74, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x49, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x49, 0x64,
+	0x12, 0x12, 0x0a, 0x04, 0x73, 0x65, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x73, 0x65, 0x61, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x30, 0x0a, 0x05,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x30,
+	0x0a, 0x05, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x05, 0x75, 0x6e, 0x74, 0x69, 0x6c,
+	0x22, 0xc0, 0x01, 0x0a, 0x10, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x65, 0x61, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79,
+	0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65,
+	0x79, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x65, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x73, 0x65, 0x61, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12,
+	0x30, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x12, 0x30, 0x0a, 0x05, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x05, 0x75, 0x6e,
+	0x74, 0x69, 0x6c, 0x22, 0x82, 0x01, 0x0a, 0x11, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x65, 0x61,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x05, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e,
+	0x2e, 0x53, 0x65, 0x61, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x05, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x12, 0x29, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x2e, 0x54, 0x69, 0x63, 0x6b,
+	0x65, 0x74, 0x52, 0x08, 0x72, 0x65, 0x73, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08,
+	0x75, 0x6e, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x64, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08,
+	0x75, 0x6e, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x64, 0x22, 0x2e, 0x0a, 0x12, 0x55, 0x6e, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x53, 0x65, 0x61, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18,
+	0x0a, 0x07, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x64, 0x22, 0x2f, 0x0a, 0x13, 0x55, 0x6e, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x53, 0x65, 0x61, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0xa8, 0x01, 0x0a, 0x07, 0x53, 0x74,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a,
+	0x07, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07,
+	0x61, 0x6c, 0x69, 0x61, 0x73, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x5a,
+	0x6f, 0x6e, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x5a,
+	0x6f, 0x6e, 0x65, 0x12, 0x3f, 0x0a, 0x0d, 0x6d, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x6d, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x22, 0x15, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x42, 0x0a, 0x14, 0x4c,
+	0x69, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x08, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x2e, 0x53, 0x74,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22,
+	0x43, 0x0a, 0x15, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x53, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x12, 0x14,
+	0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c,
+	0x69, 0x6d, 0x69, 0x74, 0x22, 0x44, 0x0a, 0x16, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x53, 0x74,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a,
+	0x0a, 0x08, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x0e, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x08, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xf1, 0x01, 0x0a, 0x15, 0x53,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x12, 0x3a, 0x0a, 0x0d,
+	0x70, 0x61, 0x73, 0x73, 0x65, 0x6e, 0x67, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x2e, 0x50, 0x61, 0x73, 0x73,
+	0x65, 0x6e, 0x67, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0d, 0x70, 0x61, 0x73, 0x73, 0x65,
+	0x6e, 0x67, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x2a, 0x0a, 0x06, 0x73, 0x6f, 0x72, 0x74,
+	0x42, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e,
+	0x2e, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x53, 0x6f, 0x72, 0x74, 0x52, 0x06, 0x73, 0x6f,
+	0x72, 0x74, 0x42, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65,
+	0x12, 0x1c, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x83,
+	0x01, 0x0a, 0x11, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69,
+	0x6c, 0x69, 0x74, 0x79, 0x12, 0x2e, 0x0a, 0x09, 0x73, 0x65, 0x61, 0x74, 0x43, 0x6c, 0x61, 0x73,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x2e,
+	0x53, 0x65, 0x61, 0x74, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x52, 0x09, 0x73, 0x65, 0x61, 0x74, 0x43,
+	0x6c, 0x61, 0x73, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x61, 0x74, 0x73, 0x4c, 0x65, 0x66,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x73, 0x65, 0x61, 0x74, 0x73, 0x4c, 0x65,
+	0x66, 0x74, 0x12, 0x20, 0x0a, 0x04, 0x66, 0x61, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0c, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x04,
+	0x66, 0x61, 0x72, 0x65, 0x22, 0xc5, 0x02, 0x0a, 0x0d, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65,
+	0x79, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6a, 0x6f, 0x75, 0x72, 0x6e,
+	0x65, 0x79, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x4e, 0x75, 0x6d,
+	0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x74, 0x72, 0x61, 0x69, 0x6e,
+	0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x6f,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x38, 0x0a, 0x09, 0x64, 0x65,
+	0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x64, 0x65, 0x70, 0x61, 0x72,
+	0x74, 0x75, 0x72, 0x65, 0x12, 0x34, 0x0a, 0x07, 0x61, 0x72, 0x72, 0x69, 0x76, 0x61, 0x6c, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x07, 0x61, 0x72, 0x72, 0x69, 0x76, 0x61, 0x6c, 0x12, 0x32, 0x0a, 0x07, 0x63, 0x6c,
+	0x61, 0x73, 0x73, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74, 0x72,
+	0x61, 0x69, 0x6e, 0x2e, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62,
+	0x69, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x07, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x65, 0x73, 0x12, 0x2c,
+	0x0a, 0x0a, 0x6c, 0x6f, 0x77, 0x65, 0x73, 0x74, 0x46, 0x61, 0x72, 0x65, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79,
+	0x52, 0x0a, 0x6c, 0x6f, 0x77, 0x65, 0x73, 0x74, 0x46, 0x61, 0x72, 0x65, 0x22, 0x92, 0x01, 0x0a,
+	0x16, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e,
+	0x2e, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07,
+	0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50,
+	0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
+	0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x22, 0x0a,
+	0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x73, 0x22, 0x8a, 0x03, 0x0a, 0x15, 0x50, 0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x65, 0x52, 0x65,
+	0x74, 0x75, 0x72, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x66,
+	0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12,
+	0x0e, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x74, 0x6f, 0x12,
+	0x2c, 0x0a, 0x11, 0x6f, 0x75, 0x74, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x4a, 0x6f, 0x75, 0x72, 0x6e,
+	0x65, 0x79, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x6f, 0x75, 0x74, 0x62,
+	0x6f, 0x75, 0x6e, 0x64, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x49, 0x64, 0x12, 0x28, 0x0a,
+	0x0f, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x49, 0x64,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x4a, 0x6f,
+	0x75, 0x72, 0x6e, 0x65, 0x79, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x2e, 0x55, 0x73,
+	0x65, 0x72, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x2e, 0x0a, 0x09, 0x70, 0x75, 0x72, 0x63,
+	0x68, 0x61, 0x73, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x74, 0x72,
+	0x61, 0x69, 0x6e, 0x2e, 0x50, 0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x65, 0x72, 0x52, 0x09, 0x70,
+	0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x65, 0x72, 0x12, 0x2e, 0x0a, 0x09, 0x73, 0x65, 0x61, 0x74,
+	0x43, 0x6c, 0x61, 0x73, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x74, 0x72,
+	0x61, 0x69, 0x6e, 0x2e, 0x53, 0x65, 0x61, 0x74, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x52, 0x09, 0x73,
+	0x65, 0x61, 0x74, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x12, 0x3a, 0x0a, 0x0d, 0x70, 0x61, 0x73, 0x73,
+	0x65, 0x6e, 0x67, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x14, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x2e, 0x50, 0x61, 0x73, 0x73, 0x65, 0x6e, 0x67, 0x65,
+	0x72, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0d, 0x70, 0x61, 0x73, 0x73, 0x65, 0x6e, 0x67, 0x65, 0x72,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x38, 0x0a, 0x0b, 0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x74, 0x72, 0x61, 0x69,
+	0x6e, 0x2e, 0x53, 0x65, 0x61, 0x74, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
+	0x73, 0x52, 0x0b, 0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x22, 0x67,
+	0x0a, 0x16, 0x50, 0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x65, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x74, 0x72, 0x69, 0x70,
+	0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0d, 0x74, 0x72, 0x69, 0x70, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x27,
+	0x0a, 0x07, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x0d, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x2e, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x07,
+	0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x22, 0x50, 0x0a, 0x0c, 0x49, 0x74, 0x69, 0x6e, 0x65,
+	0x72, 0x61, 0x72, 0x79, 0x4c, 0x65, 0x67, 0x12, 0x1c, 0x0a, 0x09, 0x6a, 0x6f, 0x75, 0x72, 0x6e,
+	0x65, 0x79, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6a, 0x6f, 0x75, 0x72,
+	0x6e, 0x65, 0x79, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x6f, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x74, 0x6f, 0x22, 0xba, 0x02, 0x0a, 0x18, 0x50, 0x75,
+	0x72, 0x63, 0x68, 0x61, 0x73, 0x65, 0x49, 0x74, 0x69, 0x6e, 0x65, 0x72, 0x61, 0x72, 0x79, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x04, 0x6c, 0x65, 0x67, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x2e, 0x49, 0x74, 0x69,
+	0x6e, 0x65, 0x72, 0x61, 0x72, 0x79, 0x4c, 0x65, 0x67, 0x52, 0x04, 0x6c, 0x65, 0x67, 0x73, 0x12,
+	0x1f, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e,
+	0x74, 0x72, 0x61, 0x69, 0x6e, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72,
+	0x12, 0x2e, 0x0a, 0x09, 0x70, 0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x65, 0x72, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x2e, 0x50, 0x75, 0x72, 0x63,
+	0x68, 0x61, 0x73, 0x65, 0x72, 0x52, 0x09, 0x70, 0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x65, 0x72,
+	0x12, 0x2e, 0x0a, 0x09, 0x73, 0x65, 0x61, 0x74, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x2e, 0x53, 0x65, 0x61, 0x74,
+	0x43, 0x6c, 0x61, 0x73, 0x73, 0x52, 0x09, 0x73, 0x65, 0x61, 0x74, 0x43, 0x6c, 0x61, 0x73, 0x73,
+	0x12, 0x3a, 0x0a, 0x0d, 0x70, 0x61, 0x73, 0x73, 0x65, 0x6e, 0x67, 0x65, 0x72, 0x54, 0x79, 0x70,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x2e,
+	0x50, 0x61, 0x73, 0x73, 0x65, 0x6e, 0x67, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0d, 0x70,
+	0x61, 0x73, 0x73, 0x65, 0x6e, 0x67, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x38, 0x0a, 0x0b,
+	0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x16, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x2e, 0x53, 0x65, 0x61, 0x74, 0x50, 0x72,
+	0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x0b, 0x70, 0x72, 0x65, 0x66, 0x65,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x22, 0x74, 0x0a, 0x19, 0x50, 0x75, 0x72, 0x63, 0x68, 0x61,
+	0x73, 0x65, 0x49, 0x74, 0x69, 0x6e, 0x65, 0x72, 0x61, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x12, 0x69, 0x74, 0x69, 0x6e, 0x65, 0x72, 0x61, 0x72, 0x79,
+	0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x12, 0x69, 0x74, 0x69, 0x6e, 0x65, 0x72, 0x61, 0x72, 0x79, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65,
+	0x6e, 0x63, 0x65, 0x12, 0x27, 0x0a, 0x07, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x2e, 0x54, 0x69, 0x63,
+	0x6b, 0x65, 0x74, 0x52, 0x07, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x22, 0xec, 0x04, 0x0a,
+	0x04, 0x50, 0x61, 0x73, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x23, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x2e, 0x50, 0x61, 0x73, 0x73,
+	0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72,
+	0x6f, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x0e,
+	0x0a, 0x02, 0x74, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x1f,
+	0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x74,
+	0x72, 0x61, 0x69, 0x6e, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12,
+	0x2e, 0x0a, 0x09, 0x70, 0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x10, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x2e, 0x50, 0x75, 0x72, 0x63, 0x68,
+	0x61, 0x73, 0x65, 0x72, 0x52, 0x09, 0x70, 0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x65, 0x72, 0x12,
+	0x2e, 0x0a, 0x09, 0x73, 0x65, 0x61, 0x74, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x10, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x2e, 0x53, 0x65, 0x61, 0x74, 0x43,
+	0x6c, 0x61, 0x73, 0x73, 0x52, 0x09, 0x73, 0x65, 0x61, 0x74, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x12,
+	0x3a, 0x0a, 0x0d, 0x70, 0x61, 0x73, 0x73, 0x65, 0x6e, 0x67, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x2e, 0x50,
+	0x61, 0x73, 0x73, 0x65, 0x6e, 0x67, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0d, 0x70, 0x61,
+	0x73, 0x73, 0x65, 0x6e, 0x67, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x38, 0x0a, 0x09, 0x76,
+	0x61, 0x6c, 0x69, 0x64, 0x46, 0x72, 0x6f, 0x6d, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x3a, 0x0a, 0x0a, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x55, 0x6e,
+	0x74, 0x69, 0x6c, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x55, 0x6e, 0x74, 0x69,
+	0x6c, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x69, 0x64, 0x65, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x05, 0x72, 0x69, 0x64, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x69, 0x64, 0x65, 0x73,
+	0x55, 0x73, 0x65, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x72, 0x69, 0x64, 0x65,
+	0x73, 0x55, 0x73, 0x65, 0x64, 0x12, 0x26, 0x0a, 0x0e, 0x72, 0x69, 0x64, 0x65, 0x73, 0x52, 0x65,
+	0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x72,
+	0x69, 0x64, 0x65, 0x73, 0x52, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x22, 0x0a,
+	0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x74,
+	0x72, 0x61, 0x69, 0x6e, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63,
+	0x65, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x0f,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12,
+	0x3a, 0x0a, 0x0d, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x10, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x2e, 0x50,
+	0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x0d, 0x70, 0x61,
+	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0xcd, 0x02, 0x0a, 0x13,
+	0x50, 0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x65, 0x50, 0x61, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x0f, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x2e, 0x50, 0x61, 0x73, 0x73, 0x54, 0x79,
+	0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x0e, 0x0a, 0x02,
+	0x74, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x1f, 0x0a, 0x04,
+	0x75, 0x73, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x74, 0x72, 0x61,
+	0x69, 0x6e, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x2e, 0x0a,
+	0x09, 0x70, 0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x10, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x2e, 0x50, 0x75, 0x72, 0x63, 0x68, 0x61, 0x73,
+	0x65, 0x72, 0x52, 0x09, 0x70, 0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x65, 0x72, 0x12, 0x2e, 0x0a,
+	0x09, 0x73, 0x65, 0x61, 0x74, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x10, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x2e, 0x53, 0x65, 0x61, 0x74, 0x43, 0x6c, 0x61,
+	0x73, 0x73, 0x52, 0x09, 0x73, 0x65, 0x61, 0x74, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x12, 0x3a, 0x0a,
+	0x0d, 0x70, 0x61, 0x73, 0x73, 0x65, 0x6e, 0x67, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x2e, 0x50, 0x61, 0x73,
+	0x73, 0x65, 0x6e, 0x67, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0d, 0x70, 0x61, 0x73, 0x73,
+	0x65, 0x6e, 0x67, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x44, 0x61, 0x74, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x44, 0x61, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x79, 0x73, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x64, 0x61, 0x79, 0x73, 0x22, 0x37, 0x0a, 0x14, 0x50,
+	0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x65, 0x50, 0x61, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x04, 0x70, 0x61, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0b, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x2e, 0x50, 0x61, 0x73, 0x73, 0x52, 0x04,
+	0x70, 0x61, 0x73, 0x73, 0x22, 0x20, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x50, 0x61, 0x73, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x5b, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x50, 0x61, 0x73,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x04, 0x70, 0x61, 0x73,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x2e,
+	0x50, 0x61, 0x73, 0x73, 0x52, 0x04, 0x70, 0x61, 0x73, 0x73, 0x12, 0x27, 0x0a, 0x07, 0x74, 0x69,
+	0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x74, 0x72,
+	0x61, 0x69, 0x6e, 0x2e, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x07, 0x74, 0x69, 0x63, 0x6b,
+	0x65, 0x74, 0x73, 0x22, 0x96, 0x03, 0x0a, 0x10, 0x44, 0x69, 0x73, 0x72, 0x75, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x4e, 0x6f, 0x74, 0x69, 0x63, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x66, 0x65,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x66,
+	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x1f, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x2e, 0x55, 0x73, 0x65,
+	0x72, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x2e, 0x0a, 0x09, 0x70, 0x75, 0x72, 0x63, 0x68,
+	0x61, 0x73, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x74, 0x72, 0x61,
+	0x69, 0x6e, 0x2e, 0x50, 0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x65, 0x72, 0x52, 0x09, 0x70, 0x75,
+	0x72, 0x63, 0x68, 0x61, 0x73, 0x65, 0x72, 0x12, 0x2f, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x2e,
+	0x44, 0x69, 0x73, 0x72, 0x75, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x6a, 0x6f, 0x75, 0x72,
+	0x6e, 0x65, 0x79, 0x49, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6a, 0x6f, 0x75,
+	0x72, 0x6e, 0x65, 0x79, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x6e, 0x65, 0x77, 0x4a, 0x6f, 0x75,
+	0x72, 0x6e, 0x65, 0x79, 0x49, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6e, 0x65,
+	0x77, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x6e, 0x65,
+	0x77, 0x53, 0x65, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6e, 0x65, 0x77,
+	0x53, 0x65, 0x61, 0x74, 0x12, 0x46, 0x0a, 0x10, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65,
+	0x64, 0x41, 0x72, 0x72, 0x69, 0x76, 0x61, 0x6c, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x10, 0x65, 0x73, 0x74, 0x69,
+	0x6d, 0x61, 0x74, 0x65, 0x64, 0x41, 0x72, 0x72, 0x69, 0x76, 0x61, 0x6c, 0x12, 0x24, 0x0a, 0x06,
+	0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x74,
+	0x72, 0x61, 0x69, 0x6e, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x06, 0x72, 0x65, 0x66, 0x75,
+	0x6e, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x93, 0x01, 0x0a,
+	0x13, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x49,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79,
+	0x49, 0x64, 0x12, 0x46, 0x0a, 0x10, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x64, 0x41,
+	0x72, 0x72, 0x69, 0x76, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x10, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61,
+	0x74, 0x65, 0x64, 0x41, 0x72, 0x72, 0x69, 0x76, 0x61, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x22, 0x71, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x4a, 0x6f, 0x75, 0x72, 0x6e,
+	0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x07, 0x6a, 0x6f,
+	0x75, 0x72, 0x6e, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x74, 0x72,
+	0x61, 0x69, 0x6e, 0x2e, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x52, 0x07, 0x6a, 0x6f, 0x75,
+	0x72, 0x6e, 0x65, 0x79, 0x12, 0x2f, 0x0a, 0x06, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x2e, 0x44, 0x69, 0x73,
+	0x72, 0x75, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x6f, 0x74, 0x69, 0x63, 0x65, 0x52, 0x06, 0x72,
+	0x65, 0x70, 0x6f, 0x72, 0x74, 0x22, 0x4c, 0x0a, 0x14, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4a,
+	0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a,
+	0x09, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x22, 0x72, 0x0a, 0x15, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4a, 0x6f, 0x75,
+	0x72, 0x6e, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x07,
+	0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e,
+	0x74, 0x72, 0x61, 0x69, 0x6e, 0x2e, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x52, 0x07, 0x6a,
+	0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x12, 0x2f, 0x0a, 0x06, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x2e, 0x44,
+	0x69, 0x73, 0x72, 0x75, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x6f, 0x74, 0x69, 0x63, 0x65, 0x52,
+	0x06, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x2a, 0x4f, 0x0a, 0x07, 0x54, 0x72, 0x69, 0x70, 0x4c,
+	0x65, 0x67, 0x12, 0x18, 0x0a, 0x14, 0x54, 0x52, 0x49, 0x50, 0x5f, 0x4c, 0x45, 0x47, 0x5f, 0x55,
+	0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x15, 0x0a, 0x11,
+	0x54, 0x52, 0x49, 0x50, 0x5f, 0x4c, 0x45, 0x47, 0x5f, 0x4f, 0x55, 0x54, 0x42, 0x4f, 0x55, 0x4e,
+	0x44, 0x10, 0x01, 0x12, 0x13, 0x0a, 0x0f, 0x54, 0x52, 0x49, 0x50, 0x5f, 0x4c, 0x45, 0x47, 0x5f,
+	0x52, 0x45, 0x54, 0x55, 0x52, 0x4e, 0x10, 0x02, 0x2a, 0xa3, 0x01, 0x0a, 0x0d, 0x50, 0x61, 0x79,
+	0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1e, 0x0a, 0x1a, 0x50, 0x41,
+	0x59, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53,
+	0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1d, 0x0a, 0x19, 0x50, 0x41,
+	0x59, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x41, 0x55, 0x54,
+	0x48, 0x4f, 0x52, 0x49, 0x5a, 0x45, 0x44, 0x10, 0x01, 0x12, 0x1b, 0x0a, 0x17, 0x50, 0x41, 0x59,
+	0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x43, 0x41, 0x50, 0x54,
+	0x55, 0x52, 0x45, 0x44, 0x10, 0x02, 0x12, 0x19, 0x0a, 0x15, 0x50, 0x41, 0x59, 0x4d, 0x45, 0x4e,
+	0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x56, 0x4f, 0x49, 0x44, 0x45, 0x44, 0x10,
+	0x03, 0x12, 0x1b, 0x0a, 0x17, 0x50, 0x41, 0x59, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x54, 0x41,
+	0x54, 0x55, 0x53, 0x5f, 0x52, 0x45, 0x46, 0x55, 0x4e, 0x44, 0x45, 0x44, 0x10, 0x04, 0x2a, 0x56,
+	0x0a, 0x09, 0x53, 0x65, 0x61, 0x74, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x12, 0x1a, 0x0a, 0x16, 0x53,
+	0x45, 0x41, 0x54, 0x5f, 0x43, 0x4c, 0x41, 0x53, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43,
+	0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x53, 0x45, 0x41, 0x54, 0x5f,
+	0x43, 0x4c, 0x41, 0x53, 0x53, 0x5f, 0x53, 0x54, 0x41, 0x4e, 0x44, 0x41, 0x52, 0x44, 0x10, 0x01,
+	0x12, 0x14, 0x0a, 0x10, 0x53, 0x45, 0x41, 0x54, 0x5f, 0x43, 0x4c, 0x41, 0x53, 0x53, 0x5f, 0x46,
+	0x49, 0x52, 0x53, 0x54, 0x10, 0x02, 0x2a, 0x7e, 0x0a, 0x0d, 0x50, 0x61, 0x73, 0x73, 0x65, 0x6e,
+	0x67, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1e, 0x0a, 0x1a, 0x50, 0x41, 0x53, 0x53, 0x45,
+	0x4e, 0x47, 0x45, 0x52, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43,
+	0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x18, 0x0a, 0x14, 0x50, 0x41, 0x53, 0x53, 0x45,
+	0x4e, 0x47, 0x45, 0x52, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x41, 0x44, 0x55, 0x4c, 0x54, 0x10,
+	0x01, 0x12, 0x18, 0x0a, 0x14, 0x50, 0x41, 0x53, 0x53, 0x45, 0x4e, 0x47, 0x45, 0x52, 0x5f, 0x54,
+	0x59, 0x50, 0x45, 0x5f, 0x43, 0x48, 0x49, 0x4c, 0x44, 0x10, 0x02, 0x12, 0x19, 0x0a, 0x15, 0x50,
+	0x41, 0x53, 0x53, 0x45, 0x4e, 0x47, 0x45, 0x52, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x53, 0x45,
+	0x4e, 0x49, 0x4f, 0x52, 0x10, 0x03, 0x2a, 0x69, 0x0a, 0x0d, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65,
+	0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1e, 0x0a, 0x1a, 0x4a, 0x4f, 0x55, 0x52, 0x4e,
+	0x45, 0x59, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43,
+	0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1a, 0x0a, 0x16, 0x4a, 0x4f, 0x55, 0x52, 0x4e,
+	0x45, 0x59, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x44, 0x45, 0x4c, 0x41, 0x59, 0x45,
+	0x44, 0x10, 0x01, 0x12, 0x1c, 0x0a, 0x18, 0x4a, 0x4f, 0x55, 0x52, 0x4e, 0x45, 0x59, 0x5f, 0x53,
+	0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x4c, 0x45, 0x44, 0x10,
+	0x02, 0x2a, 0x60, 0x0a, 0x0c, 0x53, 0x65, 0x61, 0x74, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x1d, 0x0a, 0x19, 0x53, 0x45, 0x41, 0x54, 0x5f, 0x50, 0x4f, 0x53, 0x49, 0x54, 0x49,
+	0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00,
+	0x12, 0x18, 0x0a, 0x14, 0x53, 0x45, 0x41, 0x54, 0x5f, 0x50, 0x4f, 0x53, 0x49, 0x54, 0x49, 0x4f,
+	0x4e, 0x5f, 0x57, 0x49, 0x4e, 0x44, 0x4f, 0x57, 0x10, 0x01, 0x12, 0x17, 0x0a, 0x13, 0x53, 0x45,
+	0x41, 0x54, 0x5f, 0x50, 0x4f, 0x53, 0x49, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x41, 0x49, 0x53, 0x4c,
+	0x45, 0x10, 0x02, 0x2a, 0x88, 0x01, 0x0a, 0x0c, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x53, 0x65, 0x61,
+	0x74, 0x69, 0x6e, 0x67, 0x12, 0x1d, 0x0a, 0x19, 0x47, 0x52, 0x4f, 0x55, 0x50, 0x5f, 0x53, 0x45,
+	0x41, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45,
+	0x44, 0x10, 0x00, 0x12, 0x1a, 0x0a, 0x16, 0x47, 0x52, 0x4f, 0x55, 0x50, 0x5f, 0x53, 0x45, 0x41,
+	0x54, 0x49, 0x4e, 0x47, 0x5f, 0x41, 0x4e, 0x59, 0x57, 0x48, 0x45, 0x52, 0x45, 0x10, 0x01, 0x12,
+	0x1c, 0x0a, 0x18, 0x47, 0x52, 0x4f, 0x55, 0x50, 0x5f, 0x53, 0x45, 0x41, 0x54, 0x49, 0x4e, 0x47,
+	0x5f, 0x53, 0x41, 0x4d, 0x45, 0x5f, 0x43, 0x4f, 0x41, 0x43, 0x48, 0x10, 0x02, 0x12, 0x1f, 0x0a,
+	0x1b, 0x47, 0x52, 0x4f, 0x55, 0x50, 0x5f, 0x53, 0x45, 0x41, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x41,
+	0x44, 0x4a, 0x41, 0x43, 0x45, 0x4e, 0x54, 0x5f, 0x4f, 0x4e, 0x4c, 0x59, 0x10, 0x03, 0x2a, 0x79,
+	0x0a, 0x0d, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12,
+	0x1e, 0x0a, 0x1a, 0x52, 0x45, 0x43, 0x45, 0x49, 0x50, 0x54, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41,
+	0x54, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12,
+	0x17, 0x0a, 0x13, 0x52, 0x45, 0x43, 0x45, 0x49, 0x50, 0x54, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41,
+	0x54, 0x5f, 0x54, 0x45, 0x58, 0x54, 0x10, 0x01, 0x12, 0x17, 0x0a, 0x13, 0x52, 0x45, 0x43, 0x45,
+	0x49, 0x50, 0x54, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x48, 0x54, 0x4d, 0x4c, 0x10,
+	0x02, 0x12, 0x16, 0x0a, 0x12, 0x52, 0x45, 0x43, 0x45, 0x49, 0x50, 0x54, 0x5f, 0x46, 0x4f, 0x52,
+	0x4d, 0x41, 0x54, 0x5f, 0x50, 0x44, 0x46, 0x10, 0x03, 0x2a, 0x5f, 0x0a, 0x0b, 0x4a, 0x6f, 0x75,
+	0x72, 0x6e, 0x65, 0x79, 0x53, 0x6f, 0x72, 0x74, 0x12, 0x1c, 0x0a, 0x18, 0x4a, 0x4f, 0x55, 0x52,
+	0x4e, 0x45, 0x59, 0x5f, 0x53, 0x4f, 0x52, 0x54, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49,
+	0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1a, 0x0a, 0x16, 0x4a, 0x4f, 0x55, 0x52, 0x4e, 0x45,
+	0x59, 0x5f, 0x53, 0x4f, 0x52, 0x54, 0x5f, 0x44, 0x45, 0x50, 0x41, 0x52, 0x54, 0x55, 0x52, 0x45,
+	0x10, 0x01, 0x12, 0x16, 0x0a, 0x12, 0x4a, 0x4f, 0x55, 0x52, 0x4e, 0x45, 0x59, 0x5f, 0x53, 0x4f,
+	0x52, 0x54, 0x5f, 0x50, 0x52, 0x49, 0x43, 0x45, 0x10, 0x02, 0x2a, 0x51, 0x0a, 0x08, 0x50, 0x61,
+	0x73, 0x73, 0x54, 0x79, 0x70, 0x65, 0x12, 0x19, 0x0a, 0x15, 0x50, 0x41, 0x53, 0x53, 0x5f, 0x54,
+	0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
+	0x00, 0x12, 0x14, 0x0a, 0x10, 0x50, 0x41, 0x53, 0x53, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x53,
+	0x45, 0x41, 0x53, 0x4f, 0x4e, 0x10, 0x01, 0x12, 0x14, 0x0a, 0x10, 0x50, 0x41, 0x53, 0x53, 0x5f,
+	0x54, 0x59, 0x50, 0x45, 0x5f, 0x43, 0x41, 0x52, 0x4e, 0x45, 0x54, 0x10, 0x02, 0x2a, 0x94, 0x01,
+	0x0a, 0x10, 0x44, 0x69, 0x73, 0x72, 0x75, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x1d, 0x44, 0x49, 0x53, 0x52, 0x55, 0x50, 0x54, 0x49, 0x4f, 0x4e,
+	0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46,
+	0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1d, 0x0a, 0x19, 0x44, 0x49, 0x53, 0x52, 0x55, 0x50, 0x54,
+	0x49, 0x4f, 0x4e, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x44, 0x45, 0x4c, 0x41, 0x59,
+	0x45, 0x44, 0x10, 0x01, 0x12, 0x1e, 0x0a, 0x1a, 0x44, 0x49, 0x53, 0x52, 0x55, 0x50, 0x54, 0x49,
+	0x4f, 0x4e, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x42, 0x4f, 0x4f, 0x4b,
+	0x45, 0x44, 0x10, 0x02, 0x12, 0x1e, 0x0a, 0x1a, 0x44, 0x49, 0x53, 0x52, 0x55, 0x50, 0x54, 0x49,
+	0x4f, 0x4e, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x46, 0x55, 0x4e, 0x44,
+	0x45, 0x44, 0x10, 0x03, 0x32, 0xc8, 0x13, 0x0a, 0x0c, 0x54, 0x72, 0x61, 0x69, 0x6e, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x4f, 0x0a, 0x0e, 0x50, 0x75, 0x72, 0x63, 0x68, 0x61, 0x73,
+	0x65, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x1c, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x2e,
+	0x50, 0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x65, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x2e, 0x50, 0x75,
+	0x72, 0x63, 0x68, 0x61, 0x73, 0x65, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x54, 0x69, 0x63,
+	0x6b, 0x65, 0x74, 0x12, 0x17, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x2e, 0x47, 0x65, 0x74, 0x54,
+	0x69, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x74,
+	0x72, 0x61, 0x69, 0x6e, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x58, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x53,
+	0x65, 0x61, 0x74, 0x73, 0x42, 0x79, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x2e,
+	0x74, 0x72, 0x61, 0x69, 0x6e, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x61, 0x74, 0x73, 0x42, 0x79,
+	0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20,
+	0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x61, 0x74, 0x73, 0x42,
+	0x79, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x43, 0x0a, 0x0a, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x55, 0x73, 0x65, 0x72,
+	0x12, 0x18, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x55,
+	0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x74, 0x72, 0x61,
+	0x69, 0x6e, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x0a, 0x4d, 0x6f, 0x64, 0x69, 0x66,
+	0x79, 0x53, 0x65, 0x61, 0x74, 0x12, 0x18, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x2e, 0x4d, 0x6f,
+	0x64, 0x69, 0x66, 0x79, 0x53, 0x65, 0x61, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x19, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x2e, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x79, 0x53, 0x65,
+	0x61, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4c, 0x0a, 0x0d,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x12, 0x1b, 0x2e,
+	0x74, 0x72, 0x61, 0x69, 0x6e, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4a, 0x6f, 0x75, 0x72,
+	0x6e, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x74, 0x72, 0x61,
+	0x69, 0x6e, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x0c, 0x4c, 0x69,
+	0x73, 0x74, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x73, 0x12, 0x1a, 0x2e, 0x74, 0x72, 0x61,
+	0x69, 0x6e, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4c, 0x0a, 0x0d, 0x52, 0x65, 0x74, 0x69, 0x72, 0x65, 0x4a,
+	0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x12, 0x1b, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x2e, 0x52,
+	0x65, 0x74, 0x69, 0x72, 0x65, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x2e, 0x52, 0x65, 0x74, 0x69,
+	0x72, 0x65, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x4c, 0x0a, 0x0d, 0x53, 0x65, 0x74, 0x53, 0x65, 0x61, 0x74, 0x4c, 0x61,
+	0x79, 0x6f, 0x75, 0x74, 0x12, 0x1b, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x2e, 0x53, 0x65, 0x74,
+	0x53, 0x65, 0x61, 0x74, 0x4c, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1c, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x2e, 0x53, 0x65, 0x74, 0x53, 0x65, 0x61,
+	0x74, 0x4c, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x40, 0x0a, 0x09, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x46, 0x61, 0x72, 0x65, 0x12, 0x17,
+	0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x2e, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x46, 0x61, 0x72, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x2e,
+	0x51, 0x75, 0x6f, 0x74, 0x65, 0x46, 0x61, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x58, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x69, 0x63, 0x6b, 0x65,
+	0x74, 0x73, 0x42, 0x79, 0x55, 0x73, 0x65, 0x72, 0x12, 0x1f, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x42, 0x79, 0x55, 0x73,
+	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x74, 0x72, 0x61, 0x69,
+	0x6e, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x42, 0x79, 0x55,
+	0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4c, 0x0a,
+	0x0d, 0x50, 0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x1b,
+	0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x2e, 0x50, 0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x65, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x74, 0x72,
+	0x61, 0x69, 0x6e, 0x2e, 0x50, 0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x65, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x08, 0x48,
+	0x6f, 0x6c, 0x64, 0x53, 0x65, 0x61, 0x74, 0x12, 0x16, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x2e,
+	0x48, 0x6f, 0x6c, 0x64, 0x53, 0x65, 0x61, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x17, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x2e, 0x48, 0x6f, 0x6c, 0x64, 0x53, 0x65, 0x61, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x0b, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x72, 0x6d, 0x48, 0x6f, 0x6c, 0x64, 0x12, 0x19, 0x2e, 0x74, 0x72, 0x61, 0x69,
+	0x6e, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x48, 0x6f, 0x6c, 0x64, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x2e, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x72, 0x6d, 0x48, 0x6f, 0x6c, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x46, 0x0a, 0x0b, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x48, 0x6f, 0x6c,
+	0x64, 0x12, 0x19, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x2e, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73,
+	0x65, 0x48, 0x6f, 0x6c, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x74,
+	0x72, 0x61, 0x69, 0x6e, 0x2e, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x48, 0x6f, 0x6c, 0x64,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5e, 0x0a, 0x13, 0x47, 0x65,
+	0x74, 0x57, 0x61, 0x69, 0x74, 0x6c, 0x69, 0x73, 0x74, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x21, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x2e, 0x47, 0x65, 0x74, 0x57, 0x61, 0x69,
+	0x74, 0x6c, 0x69, 0x73, 0x74, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x2e, 0x47, 0x65, 0x74,
+	0x57, 0x61, 0x69, 0x74, 0x6c, 0x69, 0x73, 0x74, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4c, 0x0a, 0x0d, 0x4c, 0x65,
+	0x61, 0x76, 0x65, 0x57, 0x61, 0x69, 0x74, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x1b, 0x2e, 0x74, 0x72,
+	0x61, 0x69, 0x6e, 0x2e, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x57, 0x61, 0x69, 0x74, 0x6c, 0x69, 0x73,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e,
+	0x2e, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x57, 0x61, 0x69, 0x74, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x09, 0x53, 0x77, 0x61, 0x70,
+	0x53, 0x65, 0x61, 0x74, 0x73, 0x12, 0x17, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x2e, 0x53, 0x77,
+	0x61, 0x70, 0x53, 0x65, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18,
+	0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x2e, 0x53, 0x77, 0x61, 0x70, 0x53, 0x65, 0x61, 0x74, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x0a, 0x41, 0x63,
+	0x63, 0x65, 0x70, 0x74, 0x53, 0x77, 0x61, 0x70, 0x12, 0x18, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e,
+	0x2e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x53, 0x77, 0x61, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x19, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x70,
+	0x74, 0x53, 0x77, 0x61, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x4f, 0x0a, 0x0e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x54, 0x69, 0x63, 0x6b, 0x65,
+	0x74, 0x12, 0x1c, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66,
+	0x65, 0x72, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1d, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72,
+	0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x43, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x12, 0x18,
+	0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e,
+	0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x0b, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65,
+	0x53, 0x65, 0x61, 0x74, 0x12, 0x19, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x2e, 0x55, 0x70, 0x67,
+	0x72, 0x61, 0x64, 0x65, 0x53, 0x65, 0x61, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1a, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x2e, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x53,
+	0x65, 0x61, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x40, 0x0a,
+	0x09, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x65, 0x61, 0x74, 0x12, 0x17, 0x2e, 0x74, 0x72, 0x61,
+	0x69, 0x6e, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x65, 0x61, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x2e, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x53, 0x65, 0x61, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x46, 0x0a, 0x0b, 0x55, 0x6e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x65, 0x61, 0x74, 0x12, 0x19,
+	0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x2e, 0x55, 0x6e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x65,
+	0x61, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x74, 0x72, 0x61, 0x69,
+	0x6e, 0x2e, 0x55, 0x6e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x65, 0x61, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x53,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1a, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x53, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x4f, 0x0a, 0x0e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x53, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1c, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x2e, 0x53, 0x65, 0x61,
+	0x72, 0x63, 0x68, 0x53, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63,
+	0x68, 0x53, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x4f, 0x0a, 0x0e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x4a, 0x6f, 0x75,
+	0x72, 0x6e, 0x65, 0x79, 0x73, 0x12, 0x1c, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x2e, 0x53, 0x65,
+	0x61, 0x72, 0x63, 0x68, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x2e, 0x53, 0x65, 0x61, 0x72,
+	0x63, 0x68, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x4f, 0x0a, 0x0e, 0x50, 0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x65,
+	0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x12, 0x1c, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x2e, 0x50,
+	0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x65, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x2e, 0x50, 0x75, 0x72,
+	0x63, 0x68, 0x61, 0x73, 0x65, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x58, 0x0a, 0x11, 0x50, 0x75, 0x72, 0x63, 0x68, 0x61, 0x73,
+	0x65, 0x49, 0x74, 0x69, 0x6e, 0x65, 0x72, 0x61, 0x72, 0x79, 0x12, 0x1f, 0x2e, 0x74, 0x72, 0x61,
+	0x69, 0x6e, 0x2e, 0x50, 0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x65, 0x49, 0x74, 0x69, 0x6e, 0x65,
+	0x72, 0x61, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x74, 0x72,
+	0x61, 0x69, 0x6e, 0x2e, 0x50, 0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x65, 0x49, 0x74, 0x69, 0x6e,
+	0x65, 0x72, 0x61, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x49, 0x0a, 0x0c, 0x50, 0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x65, 0x50, 0x61, 0x73, 0x73, 0x12,
+	0x1a, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x2e, 0x50, 0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x65,
+	0x50, 0x61, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x74, 0x72,
+	0x61, 0x69, 0x6e, 0x2e, 0x50, 0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x65, 0x50, 0x61, 0x73, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x07, 0x47, 0x65,
+	0x74, 0x50, 0x61, 0x73, 0x73, 0x12, 0x15, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x2e, 0x47, 0x65,
+	0x74, 0x50, 0x61, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x74,
+	0x72, 0x61, 0x69, 0x6e, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x61, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x0c, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x4a,
+	0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x12, 0x1a, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x2e, 0x44,
+	0x65, 0x6c, 0x61, 0x79, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x2e, 0x44, 0x65, 0x6c, 0x61, 0x79,
+	0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x4c, 0x0a, 0x0d, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4a, 0x6f, 0x75, 0x72, 0x6e,
+	0x65, 0x79, 0x12, 0x1b, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65,
+	0x6c, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1c, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4a, 0x6f,
+	0x75, 0x72, 0x6e, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42,
+	0x29, 0x5a, 0x27, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x69,
+	0x6a, 0x6f, 0x79, 0x76, 0x2f, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x3b, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
+}
+
+var (
+	file_proto_train_proto_rawDescOnce sync.Once
+	file_proto_train_proto_rawDescData = file_proto_train_proto_rawDesc
+)
+
+func file_proto_train_proto_rawDescGZIP() []byte {
+	file_proto_train_proto_rawDescOnce.Do(func() {
+		file_proto_train_proto_rawDescData = protoimpl.X.CompressGZIP(file_proto_train_proto_rawDescData)
+	})
+	return file_proto_train_proto_rawDescData
+}
+
+var file_proto_train_proto_enumTypes = make([]protoimpl.EnumInfo, 11)
+var file_proto_train_proto_msgTypes = make([]protoimpl.MessageInfo, 90)
+var file_proto_train_proto_goTypes = []any{
+	(TripLeg)(0),                        // 0: train.TripLeg
+	(PaymentStatus)(0),                  // 1: train.PaymentStatus
+	(SeatClass)(0),                      // 2: train.SeatClass
+	(PassengerType)(0),                  // 3: train.PassengerType
+	(JourneyStatus)(0),                  // 4: train.JourneyStatus
+	(SeatPosition)(0),                   // 5: train.SeatPosition
+	(GroupSeating)(0),                   // 6: train.GroupSeating
+	(ReceiptFormat)(0),                  // 7: train.ReceiptFormat
+	(JourneySort)(0),                    // 8: train.JourneySort
+	(PassType)(0),                       // 9: train.PassType
+	(DisruptionAction)(0),               // 10: train.DisruptionAction
+	(*Ticket)(nil),                      // 11: train.Ticket
+	(*Payment)(nil),                     // 12: train.Payment
+	(*Cancellation)(nil),                // 13: train.Cancellation
+	(*PreviousHolder)(nil),              // 14: train.PreviousHolder
+	(*Money)(nil),                       // 15: train.Money
+	(*FareComponent)(nil),               // 16: train.FareComponent
+	(*Journey)(nil),                     // 17: train.Journey
+	(*Stop)(nil),                        // 18: train.Stop
+	(*SeatLayout)(nil),                  // 19: train.SeatLayout
+	(*Coach)(nil),                       // 20: train.Coach
+	(*SeatDefinition)(nil),              // 21: train.SeatDefinition
+	(*User)(nil),                        // 22: train.User
+	(*Purchaser)(nil),                   // 23: train.Purchaser
+	(*PurchaseTicketRequest)(nil),       // 24: train.PurchaseTicketRequest
+	(*SeatPreferences)(nil),             // 25: train.SeatPreferences
+	(*PurchaseTicketResponse)(nil),      // 26: train.PurchaseTicketResponse
+	(*WaitlistEntry)(nil),               // 27: train.WaitlistEntry
+	(*GetTicketRequest)(nil),            // 28: train.GetTicketRequest
+	(*GetTicketResponse)(nil),           // 29: train.GetTicketResponse
+	(*GetSeatsBySectionRequest)(nil),    // 30: train.GetSeatsBySectionRequest
+	(*GetSeatsBySectionResponse)(nil),   // 31: train.GetSeatsBySectionResponse
+	(*RemoveUserRequest)(nil),           // 32: train.RemoveUserRequest
+	(*RemoveUserResponse)(nil),          // 33: train.RemoveUserResponse
+	(*ModifySeatRequest)(nil),           // 34: train.ModifySeatRequest
+	(*ModifySeatResponse)(nil),          // 35: train.ModifySeatResponse
+	(*CreateJourneyRequest)(nil),        // 36: train.CreateJourneyRequest
+	(*CreateJourneyResponse)(nil),       // 37: train.CreateJourneyResponse
+	(*ListJourneysRequest)(nil),         // 38: train.ListJourneysRequest
+	(*ListJourneysResponse)(nil),        // 39: train.ListJourneysResponse
+	(*RetireJourneyRequest)(nil),        // 40: train.RetireJourneyRequest
+	(*RetireJourneyResponse)(nil),       // 41: train.RetireJourneyResponse
+	(*SetSeatLayoutRequest)(nil),        // 42: train.SetSeatLayoutRequest
+	(*SetSeatLayoutResponse)(nil),       // 43: train.SetSeatLayoutResponse
+	(*QuoteFareRequest)(nil),            // 44: train.QuoteFareRequest
+	(*QuoteFareResponse)(nil),           // 45: train.QuoteFareResponse
+	(*ListTicketsByUserRequest)(nil),    // 46: train.ListTicketsByUserRequest
+	(*ListTicketsByUserResponse)(nil),   // 47: train.ListTicketsByUserResponse
+	(*PurchaseGroupRequest)(nil),        // 48: train.PurchaseGroupRequest
+	(*PurchaseGroupResponse)(nil),       // 49: train.PurchaseGroupResponse
+	(*Hold)(nil),                        // 50: train.Hold
+	(*HoldSeatRequest)(nil),             // 51: train.HoldSeatRequest
+	(*HoldSeatResponse)(nil),            // 52: train.HoldSeatResponse
+	(*ConfirmHoldRequest)(nil),          // 53: train.ConfirmHoldRequest
+	(*ConfirmHoldResponse)(nil),         // 54: train.ConfirmHoldResponse
+	(*ReleaseHoldRequest)(nil),          // 55: train.ReleaseHoldRequest
+	(*ReleaseHoldResponse)(nil),         // 56: train.ReleaseHoldResponse
+	(*GetWaitlistPositionRequest)(nil),  // 57: train.GetWaitlistPositionRequest
+	(*GetWaitlistPositionResponse)(nil), // 58: train.GetWaitlistPositionResponse
+	(*LeaveWaitlistRequest)(nil),        // 59: train.LeaveWaitlistRequest
+	(*LeaveWaitlistResponse)(nil),       // 60: train.LeaveWaitlistResponse
+	(*SwapSeatsRequest)(nil),            // 61: train.SwapSeatsRequest
+	(*SwapSeatsResponse)(nil),           // 62: train.SwapSeatsResponse
+	(*AcceptSwapRequest)(nil),           // 63: train.AcceptSwapRequest
+	(*AcceptSwapResponse)(nil),          // 64: train.AcceptSwapResponse
+	(*TransferTicketRequest)(nil),       // 65: train.TransferTicketRequest
+	(*TransferTicketResponse)(nil),      // 66: train.TransferTicketResponse
+	(*GetReceiptRequest)(nil),           // 67: train.GetReceiptRequest
+	(*GetReceiptResponse)(nil),          // 68: train.GetReceiptResponse
+	(*UpgradeSeatRequest)(nil),          // 69: train.UpgradeSeatRequest
+	(*UpgradeSeatResponse)(nil),         // 70: train.UpgradeSeatResponse
+	(*SeatBlock)(nil),                   // 71: train.SeatBlock
+	(*BlockSeatRequest)(nil),            // 72: train.BlockSeatRequest
+	(*BlockSeatResponse)(nil),           // 73: train.BlockSeatResponse
+	(*UnblockSeatRequest)(nil),          // 74: train.UnblockSeatRequest
+	(*UnblockSeatResponse)(nil),         // 75: train.UnblockSeatResponse
+	(*Station)(nil),                     // 76: train.Station
+	(*ListStationsRequest)(nil),         // 77: train.ListStationsRequest
+	(*ListStationsResponse)(nil),        // 78: train.ListStationsResponse
+	(*SearchStationsRequest)(nil),       // 79: train.SearchStationsRequest
+	(*SearchStationsResponse)(nil),      // 80: train.SearchStationsResponse
+	(*SearchJourneysRequest)(nil),       // 81: train.SearchJourneysRequest
+	(*ClassAvailability)(nil),           // 82: train.ClassAvailability
+	(*JourneyResult)(nil),               // 83: train.JourneyResult
+	(*SearchJourneysResponse)(nil),      // 84: train.SearchJourneysResponse
+	(*PurchaseReturnRequest)(nil),       // 85: train.PurchaseReturnRequest
+	(*PurchaseReturnResponse)(nil),      // 86: train.PurchaseReturnResponse
+	(*ItineraryLeg)(nil),                // 87: train.ItineraryLeg
+	(*PurchaseItineraryRequest)(nil),    // 88: train.PurchaseItineraryRequest
+	(*PurchaseItineraryResponse)(nil),   // 89: train.PurchaseItineraryResponse
+	(*Pass)(nil),                        // 90: train.Pass
+	(*PurchasePassRequest)(nil),         // 91: train.PurchasePassRequest
+	(*PurchasePassResponse)(nil),        // 92: train.PurchasePassResponse
+	(*GetPassRequest)(nil),              // 93: train.GetPassRequest
+	(*GetPassResponse)(nil),             // 94: train.GetPassResponse
+	(*DisruptionNotice)(nil),            // 95: train.DisruptionNotice
+	(*DelayJourneyRequest)(nil),         // 96: train.DelayJourneyRequest
+	(*DelayJourneyResponse)(nil),        // 97: train.DelayJourneyResponse
+	(*CancelJourneyRequest)(nil),        // 98: train.CancelJourneyRequest
+	(*CancelJourneyResponse)(nil),       // 99: train.CancelJourneyResponse
+	nil,                                 // 100: train.GetSeatsBySectionResponse.SeatsEntry
+	(*timestamppb.Timestamp)(nil),       // 101: google.protobuf.Timestamp
+	(*durationpb.Duration)(nil),         // 102: google.protobuf.Duration
+}
+var file_proto_train_proto_depIdxs = []int32{
+	22,  // 0: train.Ticket.user:type_name -> train.User
+	15,  // 1: train.Ticket.price:type_name -> train.Money
+	16,  // 2: train.Ticket.fareBreakdown:type_name -> train.FareComponent
+	2,   // 3: train.Ticket.seatClass:type_name -> train.SeatClass
+	3,   // 4: train.Ticket.passengerType:type_name -> train.PassengerType
+	14,  // 5: train.Ticket.previousHolders:type_name -> train.PreviousHolder
+	13,  // 6: train.Ticket.cancellation:type_name -> train.Cancellation
+	1,   // 7: train.Ticket.paymentStatus:type_name -> train.PaymentStatus
+	101, // 8: train.Ticket.invoicedAt:type_name -> google.protobuf.Timestamp
+	23,  // 9: train.Ticket.purchaser:type_name -> train.Purchaser
+	12,  // 10: train.Ticket.upgradePayments:type_name -> train.Payment
+	0,   // 11: train.Ticket.tripLeg:type_name -> train.TripLeg
+	15,  // 12: train.Payment.amount:type_name -> train.Money
+	1,   // 13: train.Payment.status:type_name -> train.PaymentStatus
+	101, // 14: train.Payment.invoicedAt:type_name -> google.protobuf.Timestamp
+	101, // 15: train.Cancellation.cancelledAt:type_name -> google.protobuf.Timestamp
+	15,  // 16: train.Cancellation.refund:type_name -> train.Money
+	15,  // 17: train.Cancellation.fee:type_name -> train.Money
+	22,  // 18: train.PreviousHolder.user:type_name -> train.User
+	101, // 19: train.PreviousHolder.transferredAt:type_name -> google.protobuf.Timestamp
+	15,  // 20: train.FareComponent.amount:type_name -> train.Money
+	101, // 21: train.Journey.departure:type_name -> google.protobuf.Timestamp
+	18,  // 22: train.Journey.stops:type_name -> train.Stop
+	4,   // 23: train.Journey.status:type_name -> train.JourneyStatus
+	102, // 24: train.Journey.delay:type_name -> google.protobuf.Duration
+	101, // 25: train.Journey.estimatedArrival:type_name -> google.protobuf.Timestamp
+	101, // 26: train.Stop.arrival:type_name -> google.protobuf.Timestamp
+	101, // 27: train.Stop.departure:type_name -> google.protobuf.Timestamp
+	20,  // 28: train.SeatLayout.coaches:type_name -> train.Coach
+	21,  // 29: train.Coach.seats:type_name -> train.SeatDefinition
+	2,   // 30: train.Coach.seatClass:type_name -> train.SeatClass
+	22,  // 31: train.PurchaseTicketRequest.user:type_name -> train.User
+	3,   // 32: train.PurchaseTicketRequest.passengerType:type_name -> train.PassengerType
+	25,  // 33: train.PurchaseTicketRequest.preferences:type_name -> train.SeatPreferences
+	23,  // 34: train.PurchaseTicketRequest.purchaser:type_name -> train.Purchaser
+	2,   // 35: train.PurchaseTicketRequest.seatClass:type_name -> train.SeatClass
+	5,   // 36: train.SeatPreferences.position:type_name -> train.SeatPosition
+	11,  // 37: train.PurchaseTicketResponse.ticket:type_name -> train.Ticket
+	27,  // 38: train.PurchaseTicketResponse.waitlist:type_name -> train.WaitlistEntry
+	22,  // 39: train.WaitlistEntry.user:type_name -> train.User
+	101, // 40: train.WaitlistEntry.joinedAt:type_name -> google.protobuf.Timestamp
+	11,  // 41: train.GetTicketResponse.ticket:type_name -> train.Ticket
+	11,  // 42: train.GetTicketResponse.trip:type_name -> train.Ticket
+	100, // 43: train.GetSeatsBySectionResponse.seats:type_name -> train.GetSeatsBySectionResponse.SeatsEntry
+	13,  // 44: train.RemoveUserResponse.cancellation:type_name -> train.Cancellation
+	101, // 45: train.CreateJourneyRequest.departure:type_name -> google.protobuf.Timestamp
+	19,  // 46: train.CreateJourneyRequest.layout:type_name -> train.SeatLayout
+	18,  // 47: train.CreateJourneyRequest.stops:type_name -> train.Stop
+	17,  // 48: train.CreateJourneyResponse.journey:type_name -> train.Journey
+	17,  // 49: train.ListJourneysResponse.journeys:type_name -> train.Journey
+	19,  // 50: train.SetSeatLayoutRequest.layout:type_name -> train.SeatLayout
+	2,   // 51: train.QuoteFareRequest.seatClass:type_name -> train.SeatClass
+	3,   // 52: train.QuoteFareRequest.passengerType:type_name -> train.PassengerType
+	15,  // 53: train.QuoteFareResponse.total:type_name -> train.Money
+	16,  // 54: train.QuoteFareResponse.breakdown:type_name -> train.FareComponent
+	11,  // 55: train.ListTicketsByUserResponse.tickets:type_name -> train.Ticket
+	22,  // 56: train.PurchaseGroupRequest.passengers:type_name -> train.User
+	6,   // 57: train.PurchaseGroupRequest.fallback:type_name -> train.GroupSeating
+	23,  // 58: train.PurchaseGroupRequest.purchaser:type_name -> train.Purchaser
+	2,   // 59: train.PurchaseGroupRequest.seatClass:type_name -> train.SeatClass
+	3,   // 60: train.PurchaseGroupRequest.passengerTypes:type_name -> train.PassengerType
+	11,  // 61: train.PurchaseGroupResponse.tickets:type_name -> train.Ticket
+	22,  // 62: train.Hold.user:type_name -> train.User
+	101, // 63: train.Hold.expiresAt:type_name -> google.protobuf.Timestamp
+	15,  // 64: train.Hold.price:type_name -> train.Money
+	23,  // 65: train.Hold.purchaser:type_name -> train.Purchaser
+	22,  // 66: train.HoldSeatRequest.user:type_name -> train.User
+	3,   // 67: train.HoldSeatRequest.passengerType:type_name -> train.PassengerType
+	25,  // 68: train.HoldSeatRequest.preferences:type_name -> train.SeatPreferences
+	23,  // 69: train.HoldSeatRequest.purchaser:type_name -> train.Purchaser
+	2,   // 70: train.HoldSeatRequest.seatClass:type_name -> train.SeatClass
+	50,  // 71: train.HoldSeatResponse.hold:type_name -> train.Hold
+	11,  // 72: train.ConfirmHoldResponse.ticket:type_name -> train.Ticket
+	27,  // 73: train.GetWaitlistPositionResponse.entry:type_name -> train.WaitlistEntry
+	11,  // 74: train.SwapSeatsResponse.tickets:type_name -> train.Ticket
+	11,  // 75: train.AcceptSwapResponse.tickets:type_name -> train.Ticket
+	22,  // 76: train.TransferTicketRequest.newUser:type_name -> train.User
+	11,  // 77: train.TransferTicketResponse.ticket:type_name -> train.Ticket
+	11,  // 78: train.TransferTicketResponse.trip:type_name -> train.Ticket
+	7,   // 79: train.GetReceiptRequest.format:type_name -> train.ReceiptFormat
+	2,   // 80: train.UpgradeSeatRequest.seatClass:type_name -> train.SeatClass
+	11,  // 81: train.UpgradeSeatResponse.ticket:type_name -> train.Ticket
+	15,  // 82: train.UpgradeSeatResponse.charged:type_name -> train.Money
+	101, // 83: train.SeatBlock.start:type_name -> google.protobuf.Timestamp
+	101, // 84: train.SeatBlock.until:type_name -> google.protobuf.Timestamp
+	101, // 85: train.BlockSeatRequest.start:type_name -> google.protobuf.Timestamp
+	101, // 86: train.BlockSeatRequest.until:type_name -> google.protobuf.Timestamp
+	71,  // 87: train.BlockSeatResponse.block:type_name -> train.SeatBlock
+	11,  // 88: train.BlockSeatResponse.reseated:type_name -> train.Ticket
+	102, // 89: train.Station.minConnection:type_name -> google.protobuf.Duration
+	76,  // 90: train.ListStationsResponse.stations:type_name -> train.Station
+	76,  // 91: train.SearchStationsResponse.stations:type_name -> train.Station
+	3,   // 92: train.SearchJourneysRequest.passengerType:type_name -> train.PassengerType
+	8,   // 93: train.SearchJourneysRequest.sortBy:type_name -> train.JourneySort
+	2,   // 94: train.ClassAvailability.seatClass:type_name -> train.SeatClass
+	15,  // 95: train.ClassAvailability.fare:type_name -> train.Money
+	101, // 96: train.JourneyResult.departure:type_name -> google.protobuf.Timestamp
+	101, // 97: train.JourneyResult.arrival:type_name -> google.protobuf.Timestamp
+	82,  // 98: train.JourneyResult.classes:type_name -> train.ClassAvailability
+	15,  // 99: train.JourneyResult.lowestFare:type_name -> train.Money
+	83,  // 100: train.SearchJourneysResponse.results:type_name -> train.JourneyResult
+	22,  // 101: train.PurchaseReturnRequest.user:type_name -> train.User
+	23,  // 102: train.PurchaseReturnRequest.purchaser:type_name -> train.Purchaser
+	2,   // 103: train.PurchaseReturnRequest.seatClass:type_name -> train.SeatClass
+	3,   // 104: train.PurchaseReturnRequest.passengerType:type_name -> train.PassengerType
+	25,  // 105: train.PurchaseReturnRequest.preferences:type_name -> train.SeatPreferences
+	11,  // 106: train.PurchaseReturnResponse.tickets:type_name -> train.Ticket
+	87,  // 107: train.PurchaseItineraryRequest.legs:type_name -> train.ItineraryLeg
+	22,  // 108: train.PurchaseItineraryRequest.user:type_name -> train.User
+	23,  // 109: train.PurchaseItineraryRequest.purchaser:type_name -> train.Purchaser
+	2,   // 110: train.PurchaseItineraryRequest.seatClass:type_name -> train.SeatClass
+	3,   // 111: train.PurchaseItineraryRequest.passengerType:type_name -> train.PassengerType
+	25,  // 112: train.PurchaseItineraryRequest.preferences:type_name -> train.SeatPreferences
+	11,  // 113: train.PurchaseItineraryResponse.tickets:type_name -> train.Ticket
+	9,   // 114: train.Pass.type:type_name -> train.PassType
+	22,  // 115: train.Pass.user:type_name -> train.User
+	23,  // 116: train.Pass.purchaser:type_name -> train.Purchaser
+	2,   // 117: train.Pass.seatClass:type_name -> train.SeatClass
+	3,   // 118: train.Pass.passengerType:type_name -> train.PassengerType
+	101, // 119: train.Pass.validFrom:type_name -> google.protobuf.Timestamp
+	101, // 120: train.Pass.validUntil:type_name -> google.protobuf.Timestamp
+	15,  // 121: train.Pass.price:type_name -> train.Money
+	1,   // 122: train.Pass.paymentStatus:type_name -> train.PaymentStatus
+	9,   // 123: train.PurchasePassRequest.type:type_name -> train.PassType
+	22,  // 124: train.PurchasePassRequest.user:type_name -> train.User
+	23,  // 125: train.PurchasePassRequest.purchaser:type_name -> train.Purchaser
+	2,   // 126: train.PurchasePassRequest.seatClass:type_name -> train.SeatClass
+	3,   // 127: train.PurchasePassRequest.passengerType:type_name -> train.PassengerType
+	90,  // 128: train.PurchasePassResponse.pass:type_name -> train.Pass
+	90,  // 129: train.GetPassResponse.pass:type_name -> train.Pass
+	11,  // 130: train.GetPassResponse.tickets:type_name -> train.Ticket
+	22,  // 131: train.DisruptionNotice.user:type_name -> train.User
+	23,  // 132: train.DisruptionNotice.purchaser:type_name -> train.Purchaser
+	10,  // 133: train.DisruptionNotice.action:type_name -> train.DisruptionAction
+	101, // 134: train.DisruptionNotice.estimatedArrival:type_name -> google.protobuf.Timestamp
+	15,  // 135: train.DisruptionNotice.refund:type_name -> train.Money
+	101, // 136: train.DelayJourneyRequest.estimatedArrival:type_name -> google.protobuf.Timestamp
+	17,  // 137: train.DelayJourneyResponse.journey:type_name -> train.Journey
+	95,  // 138: train.DelayJourneyResponse.report:type_name -> train.DisruptionNotice
+	17,  // 139: train.CancelJourneyResponse.journey:type_name -> train.Journey
+	95,  // 140: train.CancelJourneyResponse.report:type_name -> train.DisruptionNotice
+	24,  // 141: train.TrainService.PurchaseTicket:input_type -> train.PurchaseTicketRequest
+	28,  // 142: train.TrainService.GetTicket:input_type -> train.GetTicketRequest
+	30,  // 143: train.TrainService.GetSeatsBySection:input_type -> train.GetSeatsBySectionRequest
+	32,  // 144: train.TrainService.RemoveUser:input_type -> train.RemoveUserRequest
+	34,  // 145: train.TrainService.ModifySeat:input_type -> train.ModifySeatRequest
+	36,  // 146: train.TrainService.CreateJourney:input_type -> train.CreateJourneyRequest
+	38,  // 147: train.TrainService.ListJourneys:input_type -> train.ListJourneysRequest
+	40,  // 148: train.TrainService.RetireJourney:input_type -> train.RetireJourneyRequest
+	42,  // 149: train.TrainService.SetSeatLayout:input_type -> train.SetSeatLayoutRequest
+	44,  // 150: train.TrainService.QuoteFare:input_type -> train.QuoteFareRequest
+	46,  // 151: train.TrainService.ListTicketsByUser:input_type -> train.ListTicketsByUserRequest
+	48,  // 152: train.TrainService.PurchaseGroup:input_type -> train.PurchaseGroupRequest
+	51,  // 153: train.TrainService.HoldSeat:input_type -> train.HoldSeatRequest
+	53,  // 154: train.TrainService.ConfirmHold:input_type -> train.ConfirmHoldRequest
+	55,  // 155: train.TrainService.ReleaseHold:input_type -> train.ReleaseHoldRequest
+	57,  // 156: train.TrainService.GetWaitlistPosition:input_type -> train.GetWaitlistPositionRequest
+	59,  // 157: train.TrainService.LeaveWaitlist:input_type -> train.LeaveWaitlistRequest
+	61,  // 158: train.TrainService.SwapSeats:input_type -> train.SwapSeatsRequest
+	63,  // 159: train.TrainService.AcceptSwap:input_type -> train.AcceptSwapRequest
+	65,  // 160: train.TrainService.TransferTicket:input_type -> train.TransferTicketRequest
+	67,  // 161: train.TrainService.GetReceipt:input_type -> train.GetReceiptRequest
+	69,  // 162: train.TrainService.UpgradeSeat:input_type -> train.UpgradeSeatRequest
+	72,  // 163: train.TrainService.BlockSeat:input_type -> train.BlockSeatRequest
+	74,  // 164: train.TrainService.UnblockSeat:input_type -> train.UnblockSeatRequest
+	77,  // 165: train.TrainService.ListStations:input_type -> train.ListStationsRequest
+	79,  // 166: train.TrainService.SearchStations:input_type -> train.SearchStationsRequest
+	81,  // 167: train.TrainService.SearchJourneys:input_type -> train.SearchJourneysRequest
+	85,  // 168: train.TrainService.PurchaseReturn:input_type -> train.PurchaseReturnRequest
+	88,  // 169: train.TrainService.PurchaseItinerary:input_type -> train.PurchaseItineraryRequest
+	91,  // 170: train.TrainService.PurchasePass:input_type -> train.PurchasePassRequest
+	93,  // 171: train.TrainService.GetPass:input_type -> train.GetPassRequest
+	96,  // 172: train.TrainService.DelayJourney:input_type -> train.DelayJourneyRequest
+	98,  // 173: train.TrainService.CancelJourney:input_type -> train.CancelJourneyRequest
+	26,  // 174: train.TrainService.PurchaseTicket:output_type -> train.PurchaseTicketResponse
+	29,  // 175: train.TrainService.GetTicket:output_type -> train.GetTicketResponse
+	31,  // 176: train.TrainService.GetSeatsBySection:output_type -> train.GetSeatsBySectionResponse
+	33,  // 177: train.TrainService.RemoveUser:output_type -> train.RemoveUserResponse
+	35,  // 178: train.TrainService.ModifySeat:output_type -> train.ModifySeatResponse
+	37,  // 179: train.TrainService.CreateJourney:output_type -> train.CreateJourneyResponse
+	39,  // 180: train.TrainService.ListJourneys:output_type -> train.ListJourneysResponse
+	41,  // 181: train.TrainService.RetireJourney:output_type -> train.RetireJourneyResponse
+	43,  // 182: train.TrainService.SetSeatLayout:output_type -> train.SetSeatLayoutResponse
+	45,  // 183: train.TrainService.QuoteFare:output_type -> train.QuoteFareResponse
+	47,  // 184: train.TrainService.ListTicketsByUser:output_type -> train.ListTicketsByUserResponse
+	49,  // 185: train.TrainService.PurchaseGroup:output_type -> train.PurchaseGroupResponse
+	52,  // 186: train.TrainService.HoldSeat:output_type -> train.HoldSeatResponse
+	54,  // 187: train.TrainService.ConfirmHold:output_type -> train.ConfirmHoldResponse
+	56,  // 188: train.TrainService.ReleaseHold:output_type -> train.ReleaseHoldResponse
+	58,  // 189: train.TrainService.GetWaitlistPosition:output_type -> train.GetWaitlistPositionResponse
+	60,  // 190: train.TrainService.LeaveWaitlist:output_type -> train.LeaveWaitlistResponse
+	62,  // 191: train.TrainService.SwapSeats:output_type -> train.SwapSeatsResponse
+	64,  // 192: train.TrainService.AcceptSwap:output_type -> train.AcceptSwapResponse
+	66,  // 193: train.TrainService.TransferTicket:output_type -> train.TransferTicketResponse
+	68,  // 194: train.TrainService.GetReceipt:output_type -> train.GetReceiptResponse
+	70,  // 195: train.TrainService.UpgradeSeat:output_type -> train.UpgradeSeatResponse
+	73,  // 196: train.TrainService.BlockSeat:output_type -> train.BlockSeatResponse
+	75,  // 197: train.TrainService.UnblockSeat:output_type -> train.UnblockSeatResponse
+	78,  // 198: train.TrainService.ListStations:output_type -> train.ListStationsResponse
+	80,  // 199: train.TrainService.SearchStations:output_type -> train.SearchStationsResponse
+	84,  // 200: train.TrainService.SearchJourneys:output_type -> train.SearchJourneysResponse
+	86,  // 201: train.TrainService.PurchaseReturn:output_type -> train.PurchaseReturnResponse
+	89,  // 202: train.TrainService.PurchaseItinerary:output_type -> train.PurchaseItineraryResponse
+	92,  // 203: train.TrainService.PurchasePass:output_type -> train.PurchasePassResponse
+	94,  // 204: train.TrainService.GetPass:output_type -> train.GetPassResponse
+	97,  // 205: train.TrainService.DelayJourney:output_type -> train.DelayJourneyResponse
+	99,  // 206: train.TrainService.CancelJourney:output_type -> train.CancelJourneyResponse
+	174, // [174:207] is the sub-list for method output_type
+	141, // [141:174] is the sub-list for method input_type
+	141, // [141:141] is the sub-list for extension type_name
+	141, // [141:141] is the sub-list for extension extendee
+	0,   // [0:141] is the sub-list for field type_name
+}
+
+func init() { file_proto_train_proto_init() }
+func file_proto_train_proto_init() {
+	if File_proto_train_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_proto_train_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*Ticket); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_train_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*Payment); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_train_proto_msgTypes[2].Exporter = func(v any, i int) any {
+			switch v := v.(*Cancellation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_train_proto_msgTypes[3].Exporter = func(v any, i int) any {
+			switch v := v.(*PreviousHolder); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_train_proto_msgTypes[4].Exporter = func(v any, i int) any {
+			switch v := v.(*Money); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_train_proto_msgTypes[5].Exporter = func(v any, i int) any {
+			switch v := v.(*FareComponent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_train_proto_msgTypes[6].Exporter = func(v any, i int) any {
+			switch v := v.(*Journey); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_train_proto_msgTypes[7].Exporter = func(v any, i int) any {
+			switch v := v.(*Stop); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_train_proto_msgTypes[8].Exporter = func(v any, i int) any {
+			switch v := v.(*SeatLayout); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_train_proto_msgTypes[9].Exporter = func(v any, i int) any {
+			switch v := v.(*Coach); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_train_proto_msgTypes[10].Exporter = func(v any, i int) any {
+			switch v := v.(*SeatDefinition); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_train_proto_msgTypes[11].Exporter = func(v any, i int) any {
+			switch v := v.(*User); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_train_proto_msgTypes[12].Exporter = func(v any, i int) any {
+			switch v := v.(*Purchaser); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_train_proto_msgTypes[13].Exporter = func(v any, i int) any {
+			switch v := v.(*PurchaseTicketRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_train_proto_msgTypes[14].Exporter = func(v any, i int) any {
+			switch v := v.(*SeatPreferences); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_train_proto_msgTypes[15].Exporter = func(v any, i int) any {
+			switch v := v.(*PurchaseTicketResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_train_proto_msgTypes[16].Exporter = func(v any, i int) any {
+			switch v := v.(*WaitlistEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_train_proto_msgTypes[17].Exporter = func(v any, i int) any {
+			switch v := v.(*GetTicketRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_train_proto_msgTypes[18].Exporter = func(v any, i int) any {
+			switch v := v.(*GetTicketResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_train_proto_msgTypes[19].Exporter = func(v any, i int) any {
+			switch v := v.(*GetSeatsBySectionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_train_proto_msgTypes[20].Exporter = func(v any, i int) any {
+			switch v := v.(*GetSeatsBySectionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_train_proto_msgTypes[21].Exporter = func(v any, i int) any {
+			switch v := v.(*RemoveUserRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_train_proto_msgTypes[22].Exporter = func(v any, i int) any {
+			switch v := v.(*RemoveUserResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_train_proto_msgTypes[23].Exporter = func(v any, i int) any {
+			switch v := v.(*ModifySeatRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_train_proto_msgTypes[24].Exporter = func(v any, i int) any {
+			switch v := v.(*ModifySeatResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_train_proto_msgTypes[25].Exporter = func(v any, i int) any {
+			switch v := v.(*CreateJourneyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_train_proto_msgTypes[26].Exporter = func(v any, i int) any {
+			switch v := v.(*CreateJourneyResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_train_proto_msgTypes[27].Exporter = func(v any, i int) any {
+			switch v := v.(*ListJourneysRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_train_proto_msgTypes[28].Exporter = func(v any, i int) any {
+			switch v := v.(*ListJourneysResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_train_proto_msgTypes[29].Exporter = func(v any, i int) any {
+			switch v := v.(*RetireJourneyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_train_proto_msgTypes[30].Exporter = func(v any, i int) any {
+			switch v := v.(*RetireJourneyResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_train_proto_msgTypes[31].Exporter = func(v any, i int) any {
+			switch v := v.(*SetSeatLayoutRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_train_proto_msgTypes[32].Exporter = func(v any, i int) any {
+			switch v := v.(*SetSeatLayoutResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_train_proto_msgTypes[33].Exporter = func(v any, i int) any {
+			switch v := v.(*QuoteFareRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_train_proto_msgTypes[34].Exporter = func(v any, i int) any {
+			switch v := v.(*QuoteFareResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_train_proto_msgTypes[35].Exporter = func(v any, i int) any {
+			switch v := v.(*ListTicketsByUserRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_train_proto_msgTypes[36].Exporter = func(v any, i int) any {
+			switch v := v.(*ListTicketsByUserResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_train_proto_msgTypes[37].Exporter = func(v any, i int) any {
+			switch v := v.(*PurchaseGroupRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_train_proto_msgTypes[38].Exporter = func(v any, i int) any {
+			switch v := v.(*PurchaseGroupResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_train_proto_msgTypes[39].Exporter = func(v any, i int) any {
+			switch v := v.(*Hold); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_train_proto_msgTypes[40].Exporter = func(v any, i int) any {
+			switch v := v.(*HoldSeatRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_train_proto_msgTypes[41].Exporter = func(v any, i int) any {
+			switch v := v.(*HoldSeatResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_train_proto_msgTypes[42].Exporter = func(v any, i int) any {
+			switch v := v.(*ConfirmHoldRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_train_proto_msgTypes[43].Exporter = func(v any, i int) any {
+			switch v := v.(*ConfirmHoldResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_train_proto_msgTypes[44].Exporter = func(v any, i int) any {
+			switch v := v.(*ReleaseHoldRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_train_proto_msgTypes[45].Exporter = func(v any, i int) any {
+			switch v := v.(*ReleaseHoldResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_train_proto_msgTypes[46].Exporter = func(v any, i int) any {
+			switch v := v.(*GetWaitlistPositionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_train_proto_msgTypes[47].Exporter = func(v any, i int) any {
+			switch v := v.(*GetWaitlistPositionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_train_proto_msgTypes[48].Exporter = func(v any, i int) any {
+			switch v := v.(*LeaveWaitlistRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_train_proto_msgTypes[49].Exporter = func(v any, i int) any {
+			switch v := v.(*LeaveWaitlistResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_train_proto_msgTypes[50].Exporter = func(v any, i int) any {
+			switch v := v.(*SwapSeatsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_train_proto_msgTypes[51].Exporter = func(v any, i int) any {
+			switch v := v.(*SwapSeatsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_train_proto_msgTypes[52].Exporter = func(v any, i int) any {
+			switch v := v.(*AcceptSwapRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_train_proto_msgTypes[53].Exporter = func(v any, i int) any {
+			switch v := v.(*AcceptSwapResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_train_proto_msgTypes[54].Exporter = func(v any, i int) any {
+			switch v := v.(*TransferTicketRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_train_proto_msgTypes[55].Exporter = func(v any, i int) any {
+			switch v := v.(*TransferTicketResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_train_proto_msgTypes[56].Exporter = func(v any, i int) any {
+			switch v := v.(*GetReceiptRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_train_proto_msgTypes[57].Exporter = func(v any, i int) any {
+			switch v := v.(*GetReceiptResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_train_proto_msgTypes[58].Exporter = func(v any, i int) any {
+			switch v := v.(*UpgradeSeatRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_train_proto_msgTypes[59].Exporter = func(v any, i int) any {
+			switch v := v.(*UpgradeSeatResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_train_proto_msgTypes[60].Exporter = func(v any, i int) any {
+			switch v := v.(*SeatBlock); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_train_proto_msgTypes[61].Exporter = func(v any, i int) any {
+			switch v := v.(*BlockSeatRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_train_proto_msgTypes[62].Exporter = func(v any, i int) any {
+			switch v := v.(*BlockSeatResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_train_proto_msgTypes[63].Exporter = func(v any, i int) any {
+			switch v := v.(*UnblockSeatRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_train_proto_msgTypes[64].Exporter = func(v any, i int) any {
+			switch v := v.(*UnblockSeatResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_train_proto_msgTypes[65].Exporter = func(v any, i int) any {
+			switch v := v.(*Station); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_train_proto_msgTypes[66].Exporter = func(v any, i int) any {
+			switch v := v.(*ListStationsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_train_proto_msgTypes[67].Exporter = func(v any, i int) any {
+			switch v := v.(*ListStationsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_train_proto_msgTypes[68].Exporter = func(v any, i int) any {
+			switch v := v.(*SearchStationsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_train_proto_msgTypes[69].Exporter = func(v any, i int) any {
+			switch v := v.(*SearchStationsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_train_proto_msgTypes[70].Exporter = func(v any, i int) any {
+			switch v := v.(*SearchJourneysRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_train_proto_msgTypes[71].Exporter = func(v any, i int) any {
+			switch v := v.(*ClassAvailability); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_train_proto_msgTypes[72].Exporter = func(v any, i int) any {
+			switch v := v.(*JourneyResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_train_proto_msgTypes[73].Exporter = func(v any, i int) any {
+			switch v := v.(*SearchJourneysResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
 		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use RemoveUserRequest.ProtoReflect.Descriptor instead.
-func (*RemoveUserRequest) Descriptor() ([]byte, []int) {
-	return file_proto_train_proto_rawDescGZIP(), []int{8}
-}
-
-func (x *RemoveUserRequest) GetEmail() string {
-	if x != nil {
-		return x.Email
-	}
-	return ""
-}
-
-type RemoveUserResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-}
-
-func (x *RemoveUserResponse) Reset() {
-	*x = RemoveUserResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_train_proto_msgTypes[9]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *RemoveUserResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*RemoveUserResponse) ProtoMessage() {}
-
-func (x *RemoveUserResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_train_proto_msgTypes[9]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
+		file_proto_train_proto_msgTypes[74].Exporter = func(v any, i int) any {
+			switch v := v.(*PurchaseReturnRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
 		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use RemoveUserResponse.ProtoReflect.Descriptor instead.
-func (*RemoveUserResponse) Descriptor() ([]byte, []int) {
-	return file_proto_train_proto_rawDescGZIP(), []int{9}
-}
-
-func (x *RemoveUserResponse) GetSuccess() bool {
-	if x != nil {
-		return x.Success
-	}
-	return false
-}
-
-type ModifySeatRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Email   string `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
-	NewSeat string `protobuf:"bytes,2,opt,name=newSeat,proto3" json:"newSeat,omitempty"`
-}
-
-func (x *ModifySeatRequest) Reset() {
-	*x = ModifySeatRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_train_proto_msgTypes[10]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *ModifySeatRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*ModifySeatRequest) ProtoMessage() {}
-
-func (x *ModifySeatRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_train_proto_msgTypes[10]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
+		file_proto_train_proto_msgTypes[75].Exporter = func(v any, i int) any {
+			switch v := v.(*PurchaseReturnResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
 		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use ModifySeatRequest.ProtoReflect.Descriptor instead.
-func (*ModifySeatRequest) Descriptor() ([]byte, []int) {
-	return file_proto_train_proto_rawDescGZIP(), []int{10}
-}
-
-func (x *ModifySeatRequest) GetEmail() string {
-	if x != nil {
-		return x.Email
-	}
-	return ""
-}
-
-func (x *ModifySeatRequest) GetNewSeat() string {
-	if x != nil {
-		return x.NewSeat
-	}
-	return ""
-}
-
-type ModifySeatResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-}
-
-func (x *ModifySeatResponse) Reset() {
-	*x = ModifySeatResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_train_proto_msgTypes[11]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *ModifySeatResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*ModifySeatResponse) ProtoMessage() {}
-
-func (x *ModifySeatResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_train_proto_msgTypes[11]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
+		file_proto_train_proto_msgTypes[76].Exporter = func(v any, i int) any {
+			switch v := v.(*ItineraryLeg); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
 		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use ModifySeatResponse.ProtoReflect.Descriptor instead.
-func (*ModifySeatResponse) Descriptor() ([]byte, []int) {
-	return file_proto_train_proto_rawDescGZIP(), []int{11}
-}
-
-func (x *ModifySeatResponse) GetSuccess() bool {
-	if x != nil {
-		return x.Success
-	}
-	return false
-}
-
-var File_proto_train_proto protoreflect.FileDescriptor
-
-var file_proto_train_proto_rawDesc = []byte{
-	0x0a, 0x11, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x12, 0x05, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x22, 0x77, 0x0a, 0x06, 0x54, 0x69,
-	0x63, 0x6b, 0x65, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x1f, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x2e, 0x55,
-	0x73, 0x65, 0x72, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69,
-	0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12,
-	0x12, 0x0a, 0x04, 0x73, 0x65, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73,
-	0x65, 0x61, 0x74, 0x22, 0x56, 0x0a, 0x04, 0x55, 0x73, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x66,
-	0x69, 0x72, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
-	0x66, 0x69, 0x72, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x73,
-	0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x61, 0x73,
-	0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x22, 0x5c, 0x0a, 0x15, 0x50,
-	0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x65, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x1f, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x2e, 0x55,
-	0x73, 0x65, 0x72, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x22, 0x3f, 0x0a, 0x16, 0x50, 0x75, 0x72,
-	0x63, 0x68, 0x61, 0x73, 0x65, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x06, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x2e, 0x54, 0x69, 0x63, 0x6b,
-	0x65, 0x74, 0x52, 0x06, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x22, 0x28, 0x0a, 0x10, 0x47, 0x65,
-	0x74, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14,
-	0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65,
-	0x6d, 0x61, 0x69, 0x6c, 0x22, 0x3a, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x54, 0x69, 0x63, 0x6b, 0x65,
-	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x06, 0x74, 0x69, 0x63,
-	0x6b, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x74, 0x72, 0x61, 0x69,
-	0x6e, 0x2e, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x06, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74,
-	0x22, 0x34, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x53, 0x65, 0x61, 0x74, 0x73, 0x42, 0x79, 0x53, 0x65,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07,
-	0x73, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73,
-	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x98, 0x01, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x53, 0x65,
-	0x61, 0x74, 0x73, 0x42, 0x79, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x05, 0x73, 0x65, 0x61, 0x74, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x2e, 0x47, 0x65, 0x74, 0x53,
-	0x65, 0x61, 0x74, 0x73, 0x42, 0x79, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53, 0x65, 0x61, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
-	0x52, 0x05, 0x73, 0x65, 0x61, 0x74, 0x73, 0x1a, 0x38, 0x0a, 0x0a, 0x53, 0x65, 0x61, 0x74, 0x73,
-	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
-	0x01, 0x22, 0x29, 0x0a, 0x11, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x22, 0x2e, 0x0a, 0x12,
-	0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x43, 0x0a, 0x11,
-	0x4d, 0x6f, 0x64, 0x69, 0x66, 0x79, 0x53, 0x65, 0x61, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x6e, 0x65, 0x77, 0x53, 0x65,
-	0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6e, 0x65, 0x77, 0x53, 0x65, 0x61,
-	0x74, 0x22, 0x2e, 0x0a, 0x12, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x79, 0x53, 0x65, 0x61, 0x74, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65,
-	0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
-	0x73, 0x32, 0x85, 0x03, 0x0a, 0x0c, 0x54, 0x72, 0x61, 0x69, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x12, 0x4f, 0x0a, 0x0e, 0x50, 0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x65, 0x54, 0x69,
-	0x63, 0x6b, 0x65, 0x74, 0x12, 0x1c, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x2e, 0x50, 0x75, 0x72,
-	0x63, 0x68, 0x61, 0x73, 0x65, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x2e, 0x50, 0x75, 0x72, 0x63, 0x68,
-	0x61, 0x73, 0x65, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74,
-	0x12, 0x17, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x69, 0x63, 0x6b,
-	0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x74, 0x72, 0x61, 0x69,
-	0x6e, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x58, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x53, 0x65, 0x61, 0x74,
-	0x73, 0x42, 0x79, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x2e, 0x74, 0x72, 0x61,
-	0x69, 0x6e, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x61, 0x74, 0x73, 0x42, 0x79, 0x53, 0x65, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x74, 0x72,
-	0x61, 0x69, 0x6e, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x61, 0x74, 0x73, 0x42, 0x79, 0x53, 0x65,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
-	0x43, 0x0a, 0x0a, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x55, 0x73, 0x65, 0x72, 0x12, 0x18, 0x2e,
-	0x74, 0x72, 0x61, 0x69, 0x6e, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x55, 0x73, 0x65, 0x72,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x2e,
-	0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x0a, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x79, 0x53, 0x65,
-	0x61, 0x74, 0x12, 0x18, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x2e, 0x4d, 0x6f, 0x64, 0x69, 0x66,
-	0x79, 0x53, 0x65, 0x61, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x74,
-	0x72, 0x61, 0x69, 0x6e, 0x2e, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x79, 0x53, 0x65, 0x61, 0x74, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x29, 0x5a, 0x27, 0x67, 0x69, 0x74,
-	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x69, 0x6a, 0x6f, 0x79, 0x76, 0x2f, 0x74,
-	0x72, 0x61, 0x69, 0x6e, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x3b, 0x74,
-	0x72, 0x61, 0x69, 0x6e, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
-}
-
-var (
-	file_proto_train_proto_rawDescOnce sync.Once
-	file_proto_train_proto_rawDescData = file_proto_train_proto_rawDesc
-)
-
-func file_proto_train_proto_rawDescGZIP() []byte {
-	file_proto_train_proto_rawDescOnce.Do(func() {
-		file_proto_train_proto_rawDescData = protoimpl.X.CompressGZIP(file_proto_train_proto_rawDescData)
-	})
-	return file_proto_train_proto_rawDescData
-}
-
-var file_proto_train_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
-var file_proto_train_proto_goTypes = []any{
-	(*Ticket)(nil),                    // 0: train.Ticket
-	(*User)(nil),                      // 1: train.User
-	(*PurchaseTicketRequest)(nil),     // 2: train.PurchaseTicketRequest
-	(*PurchaseTicketResponse)(nil),    // 3: train.PurchaseTicketResponse
-	(*GetTicketRequest)(nil),          // 4: train.GetTicketRequest
-	(*GetTicketResponse)(nil),         // 5: train.GetTicketResponse
-	(*GetSeatsBySectionRequest)(nil),  // 6: train.GetSeatsBySectionRequest
-	(*GetSeatsBySectionResponse)(nil), // 7: train.GetSeatsBySectionResponse
-	(*RemoveUserRequest)(nil),         // 8: train.RemoveUserRequest
-	(*RemoveUserResponse)(nil),        // 9: train.RemoveUserResponse
-	(*ModifySeatRequest)(nil),         // 10: train.ModifySeatRequest
-	(*ModifySeatResponse)(nil),        // 11: train.ModifySeatResponse
-	nil,                               // 12: train.GetSeatsBySectionResponse.SeatsEntry
-}
-var file_proto_train_proto_depIdxs = []int32{
-	1,  // 0: train.Ticket.user:type_name -> train.User
-	1,  // 1: train.PurchaseTicketRequest.user:type_name -> train.User
-	0,  // 2: train.PurchaseTicketResponse.ticket:type_name -> train.Ticket
-	0,  // 3: train.GetTicketResponse.ticket:type_name -> train.Ticket
-	12, // 4: train.GetSeatsBySectionResponse.seats:type_name -> train.GetSeatsBySectionResponse.SeatsEntry
-	2,  // 5: train.TrainService.PurchaseTicket:input_type -> train.PurchaseTicketRequest
-	4,  // 6: train.TrainService.GetTicket:input_type -> train.GetTicketRequest
-	6,  // 7: train.TrainService.GetSeatsBySection:input_type -> train.GetSeatsBySectionRequest
-	8,  // 8: train.TrainService.RemoveUser:input_type -> train.RemoveUserRequest
-	10, // 9: train.TrainService.ModifySeat:input_type -> train.ModifySeatRequest
-	3,  // 10: train.TrainService.PurchaseTicket:output_type -> train.PurchaseTicketResponse
-	5,  // 11: train.TrainService.GetTicket:output_type -> train.GetTicketResponse
-	7,  // 12: train.TrainService.GetSeatsBySection:output_type -> train.GetSeatsBySectionResponse
-	9,  // 13: train.TrainService.RemoveUser:output_type -> train.RemoveUserResponse
-	11, // 14: train.TrainService.ModifySeat:output_type -> train.ModifySeatResponse
-	10, // [10:15] is the sub-list for method output_type
-	5,  // [5:10] is the sub-list for method input_type
-	5,  // [5:5] is the sub-list for extension type_name
-	5,  // [5:5] is the sub-list for extension extendee
-	0,  // [0:5] is the sub-list for field type_name
-}
-
-func init() { file_proto_train_proto_init() }
-func file_proto_train_proto_init() {
-	if File_proto_train_proto != nil {
-		return
-	}
-	if !protoimpl.UnsafeEnabled {
-		file_proto_train_proto_msgTypes[0].Exporter = func(v any, i int) any {
-			switch v := v.(*Ticket); i {
+		file_proto_train_proto_msgTypes[77].Exporter = func(v any, i int) any {
+			switch v := v.(*PurchaseItineraryRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -815,8 +9092,8 @@ func file_proto_train_proto_init() {
 				return nil
 			}
 		}
-		file_proto_train_proto_msgTypes[1].Exporter = func(v any, i int) any {
-			switch v := v.(*User); i {
+		file_proto_train_proto_msgTypes[78].Exporter = func(v any, i int) any {
+			switch v := v.(*PurchaseItineraryResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -827,8 +9104,8 @@ func file_proto_train_proto_init() {
 				return nil
 			}
 		}
-		file_proto_train_proto_msgTypes[2].Exporter = func(v any, i int) any {
-			switch v := v.(*PurchaseTicketRequest); i {
+		file_proto_train_proto_msgTypes[79].Exporter = func(v any, i int) any {
+			switch v := v.(*Pass); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -839,8 +9116,8 @@ func file_proto_train_proto_init() {
 				return nil
 			}
 		}
-		file_proto_train_proto_msgTypes[3].Exporter = func(v any, i int) any {
-			switch v := v.(*PurchaseTicketResponse); i {
+		file_proto_train_proto_msgTypes[80].Exporter = func(v any, i int) any {
+			switch v := v.(*PurchasePassRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -851,8 +9128,8 @@ func file_proto_train_proto_init() {
 				return nil
 			}
 		}
-		file_proto_train_proto_msgTypes[4].Exporter = func(v any, i int) any {
-			switch v := v.(*GetTicketRequest); i {
+		file_proto_train_proto_msgTypes[81].Exporter = func(v any, i int) any {
+			switch v := v.(*PurchasePassResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -863,8 +9140,8 @@ func file_proto_train_proto_init() {
 				return nil
 			}
 		}
-		file_proto_train_proto_msgTypes[5].Exporter = func(v any, i int) any {
-			switch v := v.(*GetTicketResponse); i {
+		file_proto_train_proto_msgTypes[82].Exporter = func(v any, i int) any {
+			switch v := v.(*GetPassRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -875,8 +9152,8 @@ func file_proto_train_proto_init() {
 				return nil
 			}
 		}
-		file_proto_train_proto_msgTypes[6].Exporter = func(v any, i int) any {
-			switch v := v.(*GetSeatsBySectionRequest); i {
+		file_proto_train_proto_msgTypes[83].Exporter = func(v any, i int) any {
+			switch v := v.(*GetPassResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -887,8 +9164,8 @@ func file_proto_train_proto_init() {
 				return nil
 			}
 		}
-		file_proto_train_proto_msgTypes[7].Exporter = func(v any, i int) any {
-			switch v := v.(*GetSeatsBySectionResponse); i {
+		file_proto_train_proto_msgTypes[84].Exporter = func(v any, i int) any {
+			switch v := v.(*DisruptionNotice); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -899,8 +9176,8 @@ func file_proto_train_proto_init() {
 				return nil
 			}
 		}
-		file_proto_train_proto_msgTypes[8].Exporter = func(v any, i int) any {
-			switch v := v.(*RemoveUserRequest); i {
+		file_proto_train_proto_msgTypes[85].Exporter = func(v any, i int) any {
+			switch v := v.(*DelayJourneyRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -911,8 +9188,8 @@ func file_proto_train_proto_init() {
 				return nil
 			}
 		}
-		file_proto_train_proto_msgTypes[9].Exporter = func(v any, i int) any {
-			switch v := v.(*RemoveUserResponse); i {
+		file_proto_train_proto_msgTypes[86].Exporter = func(v any, i int) any {
+			switch v := v.(*DelayJourneyResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -923,8 +9200,8 @@ func file_proto_train_proto_init() {
 				return nil
 			}
 		}
-		file_proto_train_proto_msgTypes[10].Exporter = func(v any, i int) any {
-			switch v := v.(*ModifySeatRequest); i {
+		file_proto_train_proto_msgTypes[87].Exporter = func(v any, i int) any {
+			switch v := v.(*CancelJourneyRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -935,8 +9212,8 @@ func file_proto_train_proto_init() {
 				return nil
 			}
 		}
-		file_proto_train_proto_msgTypes[11].Exporter = func(v any, i int) any {
-			switch v := v.(*ModifySeatResponse); i {
+		file_proto_train_proto_msgTypes[88].Exporter = func(v any, i int) any {
+			switch v := v.(*CancelJourneyResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -953,13 +9230,14 @@ func file_proto_train_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_proto_train_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   13,
+			NumEnums:      11,
+			NumMessages:   90,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_proto_train_proto_goTypes,
 		DependencyIndexes: file_proto_train_proto_depIdxs,
+		EnumInfos:         file_proto_train_proto_enumTypes,
 		MessageInfos:      file_proto_train_proto_msgTypes,
 	}.Build()
 	File_proto_train_proto = out.File
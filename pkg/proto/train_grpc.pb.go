@@ -19,11 +19,39 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	TrainService_PurchaseTicket_FullMethodName    = "/train.TrainService/PurchaseTicket"
-	TrainService_GetTicket_FullMethodName         = "/train.TrainService/GetTicket"
-	TrainService_GetSeatsBySection_FullMethodName = "/train.TrainService/GetSeatsBySection"
-	TrainService_RemoveUser_FullMethodName        = "/train.TrainService/RemoveUser"
-	TrainService_ModifySeat_FullMethodName        = "/train.TrainService/ModifySeat"
+	TrainService_PurchaseTicket_FullMethodName      = "/train.TrainService/PurchaseTicket"
+	TrainService_GetTicket_FullMethodName           = "/train.TrainService/GetTicket"
+	TrainService_GetSeatsBySection_FullMethodName   = "/train.TrainService/GetSeatsBySection"
+	TrainService_RemoveUser_FullMethodName          = "/train.TrainService/RemoveUser"
+	TrainService_ModifySeat_FullMethodName          = "/train.TrainService/ModifySeat"
+	TrainService_CreateJourney_FullMethodName       = "/train.TrainService/CreateJourney"
+	TrainService_ListJourneys_FullMethodName        = "/train.TrainService/ListJourneys"
+	TrainService_RetireJourney_FullMethodName       = "/train.TrainService/RetireJourney"
+	TrainService_SetSeatLayout_FullMethodName       = "/train.TrainService/SetSeatLayout"
+	TrainService_QuoteFare_FullMethodName           = "/train.TrainService/QuoteFare"
+	TrainService_ListTicketsByUser_FullMethodName   = "/train.TrainService/ListTicketsByUser"
+	TrainService_PurchaseGroup_FullMethodName       = "/train.TrainService/PurchaseGroup"
+	TrainService_HoldSeat_FullMethodName            = "/train.TrainService/HoldSeat"
+	TrainService_ConfirmHold_FullMethodName         = "/train.TrainService/ConfirmHold"
+	TrainService_ReleaseHold_FullMethodName         = "/train.TrainService/ReleaseHold"
+	TrainService_GetWaitlistPosition_FullMethodName = "/train.TrainService/GetWaitlistPosition"
+	TrainService_LeaveWaitlist_FullMethodName       = "/train.TrainService/LeaveWaitlist"
+	TrainService_SwapSeats_FullMethodName           = "/train.TrainService/SwapSeats"
+	TrainService_AcceptSwap_FullMethodName          = "/train.TrainService/AcceptSwap"
+	TrainService_TransferTicket_FullMethodName      = "/train.TrainService/TransferTicket"
+	TrainService_GetReceipt_FullMethodName          = "/train.TrainService/GetReceipt"
+	TrainService_UpgradeSeat_FullMethodName         = "/train.TrainService/UpgradeSeat"
+	TrainService_BlockSeat_FullMethodName           = "/train.TrainService/BlockSeat"
+	TrainService_UnblockSeat_FullMethodName         = "/train.TrainService/UnblockSeat"
+	TrainService_ListStations_FullMethodName        = "/train.TrainService/ListStations"
+	TrainService_SearchStations_FullMethodName      = "/train.TrainService/SearchStations"
+	TrainService_SearchJourneys_FullMethodName      = "/train.TrainService/SearchJourneys"
+	TrainService_PurchaseReturn_FullMethodName      = "/train.TrainService/PurchaseReturn"
+	TrainService_PurchaseItinerary_FullMethodName   = "/train.TrainService/PurchaseItinerary"
+	TrainService_PurchasePass_FullMethodName        = "/train.TrainService/PurchasePass"
+	TrainService_GetPass_FullMethodName             = "/train.TrainService/GetPass"
+	TrainService_DelayJourney_FullMethodName        = "/train.TrainService/DelayJourney"
+	TrainService_CancelJourney_FullMethodName       = "/train.TrainService/CancelJourney"
 )
 
 // TrainServiceClient is the client API for TrainService service.
@@ -35,6 +63,34 @@ type TrainServiceClient interface {
 	GetSeatsBySection(ctx context.Context, in *GetSeatsBySectionRequest, opts ...grpc.CallOption) (*GetSeatsBySectionResponse, error)
 	RemoveUser(ctx context.Context, in *RemoveUserRequest, opts ...grpc.CallOption) (*RemoveUserResponse, error)
 	ModifySeat(ctx context.Context, in *ModifySeatRequest, opts ...grpc.CallOption) (*ModifySeatResponse, error)
+	CreateJourney(ctx context.Context, in *CreateJourneyRequest, opts ...grpc.CallOption) (*CreateJourneyResponse, error)
+	ListJourneys(ctx context.Context, in *ListJourneysRequest, opts ...grpc.CallOption) (*ListJourneysResponse, error)
+	RetireJourney(ctx context.Context, in *RetireJourneyRequest, opts ...grpc.CallOption) (*RetireJourneyResponse, error)
+	SetSeatLayout(ctx context.Context, in *SetSeatLayoutRequest, opts ...grpc.CallOption) (*SetSeatLayoutResponse, error)
+	QuoteFare(ctx context.Context, in *QuoteFareRequest, opts ...grpc.CallOption) (*QuoteFareResponse, error)
+	ListTicketsByUser(ctx context.Context, in *ListTicketsByUserRequest, opts ...grpc.CallOption) (*ListTicketsByUserResponse, error)
+	PurchaseGroup(ctx context.Context, in *PurchaseGroupRequest, opts ...grpc.CallOption) (*PurchaseGroupResponse, error)
+	HoldSeat(ctx context.Context, in *HoldSeatRequest, opts ...grpc.CallOption) (*HoldSeatResponse, error)
+	ConfirmHold(ctx context.Context, in *ConfirmHoldRequest, opts ...grpc.CallOption) (*ConfirmHoldResponse, error)
+	ReleaseHold(ctx context.Context, in *ReleaseHoldRequest, opts ...grpc.CallOption) (*ReleaseHoldResponse, error)
+	GetWaitlistPosition(ctx context.Context, in *GetWaitlistPositionRequest, opts ...grpc.CallOption) (*GetWaitlistPositionResponse, error)
+	LeaveWaitlist(ctx context.Context, in *LeaveWaitlistRequest, opts ...grpc.CallOption) (*LeaveWaitlistResponse, error)
+	SwapSeats(ctx context.Context, in *SwapSeatsRequest, opts ...grpc.CallOption) (*SwapSeatsResponse, error)
+	AcceptSwap(ctx context.Context, in *AcceptSwapRequest, opts ...grpc.CallOption) (*AcceptSwapResponse, error)
+	TransferTicket(ctx context.Context, in *TransferTicketRequest, opts ...grpc.CallOption) (*TransferTicketResponse, error)
+	GetReceipt(ctx context.Context, in *GetReceiptRequest, opts ...grpc.CallOption) (*GetReceiptResponse, error)
+	UpgradeSeat(ctx context.Context, in *UpgradeSeatRequest, opts ...grpc.CallOption) (*UpgradeSeatResponse, error)
+	BlockSeat(ctx context.Context, in *BlockSeatRequest, opts ...grpc.CallOption) (*BlockSeatResponse, error)
+	UnblockSeat(ctx context.Context, in *UnblockSeatRequest, opts ...grpc.CallOption) (*UnblockSeatResponse, error)
+	ListStations(ctx context.Context, in *ListStationsRequest, opts ...grpc.CallOption) (*ListStationsResponse, error)
+	SearchStations(ctx context.Context, in *SearchStationsRequest, opts ...grpc.CallOption) (*SearchStationsResponse, error)
+	SearchJourneys(ctx context.Context, in *SearchJourneysRequest, opts ...grpc.CallOption) (*SearchJourneysResponse, error)
+	PurchaseReturn(ctx context.Context, in *PurchaseReturnRequest, opts ...grpc.CallOption) (*PurchaseReturnResponse, error)
+	PurchaseItinerary(ctx context.Context, in *PurchaseItineraryRequest, opts ...grpc.CallOption) (*PurchaseItineraryResponse, error)
+	PurchasePass(ctx context.Context, in *PurchasePassRequest, opts ...grpc.CallOption) (*PurchasePassResponse, error)
+	GetPass(ctx context.Context, in *GetPassRequest, opts ...grpc.CallOption) (*GetPassResponse, error)
+	DelayJourney(ctx context.Context, in *DelayJourneyRequest, opts ...grpc.CallOption) (*DelayJourneyResponse, error)
+	CancelJourney(ctx context.Context, in *CancelJourneyRequest, opts ...grpc.CallOption) (*CancelJourneyResponse, error)
 }
 
 type trainServiceClient struct {
@@ -95,6 +151,286 @@ func (c *trainServiceClient) ModifySeat(ctx context.Context, in *ModifySeatReque
 	return out, nil
 }
 
+func (c *trainServiceClient) CreateJourney(ctx context.Context, in *CreateJourneyRequest, opts ...grpc.CallOption) (*CreateJourneyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateJourneyResponse)
+	err := c.cc.Invoke(ctx, TrainService_CreateJourney_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trainServiceClient) ListJourneys(ctx context.Context, in *ListJourneysRequest, opts ...grpc.CallOption) (*ListJourneysResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListJourneysResponse)
+	err := c.cc.Invoke(ctx, TrainService_ListJourneys_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trainServiceClient) RetireJourney(ctx context.Context, in *RetireJourneyRequest, opts ...grpc.CallOption) (*RetireJourneyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RetireJourneyResponse)
+	err := c.cc.Invoke(ctx, TrainService_RetireJourney_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trainServiceClient) SetSeatLayout(ctx context.Context, in *SetSeatLayoutRequest, opts ...grpc.CallOption) (*SetSeatLayoutResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetSeatLayoutResponse)
+	err := c.cc.Invoke(ctx, TrainService_SetSeatLayout_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trainServiceClient) QuoteFare(ctx context.Context, in *QuoteFareRequest, opts ...grpc.CallOption) (*QuoteFareResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QuoteFareResponse)
+	err := c.cc.Invoke(ctx, TrainService_QuoteFare_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trainServiceClient) ListTicketsByUser(ctx context.Context, in *ListTicketsByUserRequest, opts ...grpc.CallOption) (*ListTicketsByUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTicketsByUserResponse)
+	err := c.cc.Invoke(ctx, TrainService_ListTicketsByUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trainServiceClient) PurchaseGroup(ctx context.Context, in *PurchaseGroupRequest, opts ...grpc.CallOption) (*PurchaseGroupResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PurchaseGroupResponse)
+	err := c.cc.Invoke(ctx, TrainService_PurchaseGroup_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trainServiceClient) HoldSeat(ctx context.Context, in *HoldSeatRequest, opts ...grpc.CallOption) (*HoldSeatResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(HoldSeatResponse)
+	err := c.cc.Invoke(ctx, TrainService_HoldSeat_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trainServiceClient) ConfirmHold(ctx context.Context, in *ConfirmHoldRequest, opts ...grpc.CallOption) (*ConfirmHoldResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ConfirmHoldResponse)
+	err := c.cc.Invoke(ctx, TrainService_ConfirmHold_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trainServiceClient) ReleaseHold(ctx context.Context, in *ReleaseHoldRequest, opts ...grpc.CallOption) (*ReleaseHoldResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReleaseHoldResponse)
+	err := c.cc.Invoke(ctx, TrainService_ReleaseHold_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trainServiceClient) GetWaitlistPosition(ctx context.Context, in *GetWaitlistPositionRequest, opts ...grpc.CallOption) (*GetWaitlistPositionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetWaitlistPositionResponse)
+	err := c.cc.Invoke(ctx, TrainService_GetWaitlistPosition_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trainServiceClient) LeaveWaitlist(ctx context.Context, in *LeaveWaitlistRequest, opts ...grpc.CallOption) (*LeaveWaitlistResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LeaveWaitlistResponse)
+	err := c.cc.Invoke(ctx, TrainService_LeaveWaitlist_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trainServiceClient) SwapSeats(ctx context.Context, in *SwapSeatsRequest, opts ...grpc.CallOption) (*SwapSeatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SwapSeatsResponse)
+	err := c.cc.Invoke(ctx, TrainService_SwapSeats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trainServiceClient) AcceptSwap(ctx context.Context, in *AcceptSwapRequest, opts ...grpc.CallOption) (*AcceptSwapResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AcceptSwapResponse)
+	err := c.cc.Invoke(ctx, TrainService_AcceptSwap_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trainServiceClient) TransferTicket(ctx context.Context, in *TransferTicketRequest, opts ...grpc.CallOption) (*TransferTicketResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TransferTicketResponse)
+	err := c.cc.Invoke(ctx, TrainService_TransferTicket_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trainServiceClient) GetReceipt(ctx context.Context, in *GetReceiptRequest, opts ...grpc.CallOption) (*GetReceiptResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetReceiptResponse)
+	err := c.cc.Invoke(ctx, TrainService_GetReceipt_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trainServiceClient) UpgradeSeat(ctx context.Context, in *UpgradeSeatRequest, opts ...grpc.CallOption) (*UpgradeSeatResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpgradeSeatResponse)
+	err := c.cc.Invoke(ctx, TrainService_UpgradeSeat_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trainServiceClient) BlockSeat(ctx context.Context, in *BlockSeatRequest, opts ...grpc.CallOption) (*BlockSeatResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BlockSeatResponse)
+	err := c.cc.Invoke(ctx, TrainService_BlockSeat_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trainServiceClient) UnblockSeat(ctx context.Context, in *UnblockSeatRequest, opts ...grpc.CallOption) (*UnblockSeatResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnblockSeatResponse)
+	err := c.cc.Invoke(ctx, TrainService_UnblockSeat_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trainServiceClient) ListStations(ctx context.Context, in *ListStationsRequest, opts ...grpc.CallOption) (*ListStationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListStationsResponse)
+	err := c.cc.Invoke(ctx, TrainService_ListStations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trainServiceClient) SearchStations(ctx context.Context, in *SearchStationsRequest, opts ...grpc.CallOption) (*SearchStationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchStationsResponse)
+	err := c.cc.Invoke(ctx, TrainService_SearchStations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trainServiceClient) SearchJourneys(ctx context.Context, in *SearchJourneysRequest, opts ...grpc.CallOption) (*SearchJourneysResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchJourneysResponse)
+	err := c.cc.Invoke(ctx, TrainService_SearchJourneys_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trainServiceClient) PurchaseReturn(ctx context.Context, in *PurchaseReturnRequest, opts ...grpc.CallOption) (*PurchaseReturnResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PurchaseReturnResponse)
+	err := c.cc.Invoke(ctx, TrainService_PurchaseReturn_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trainServiceClient) PurchaseItinerary(ctx context.Context, in *PurchaseItineraryRequest, opts ...grpc.CallOption) (*PurchaseItineraryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PurchaseItineraryResponse)
+	err := c.cc.Invoke(ctx, TrainService_PurchaseItinerary_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trainServiceClient) PurchasePass(ctx context.Context, in *PurchasePassRequest, opts ...grpc.CallOption) (*PurchasePassResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PurchasePassResponse)
+	err := c.cc.Invoke(ctx, TrainService_PurchasePass_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trainServiceClient) GetPass(ctx context.Context, in *GetPassRequest, opts ...grpc.CallOption) (*GetPassResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPassResponse)
+	err := c.cc.Invoke(ctx, TrainService_GetPass_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trainServiceClient) DelayJourney(ctx context.Context, in *DelayJourneyRequest, opts ...grpc.CallOption) (*DelayJourneyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DelayJourneyResponse)
+	err := c.cc.Invoke(ctx, TrainService_DelayJourney_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trainServiceClient) CancelJourney(ctx context.Context, in *CancelJourneyRequest, opts ...grpc.CallOption) (*CancelJourneyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CancelJourneyResponse)
+	err := c.cc.Invoke(ctx, TrainService_CancelJourney_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // TrainServiceServer is the server API for TrainService service.
 // All implementations must embed UnimplementedTrainServiceServer
 // for forward compatibility.
@@ -104,6 +440,34 @@ type TrainServiceServer interface {
 	GetSeatsBySection(context.Context, *GetSeatsBySectionRequest) (*GetSeatsBySectionResponse, error)
 	RemoveUser(context.Context, *RemoveUserRequest) (*RemoveUserResponse, error)
 	ModifySeat(context.Context, *ModifySeatRequest) (*ModifySeatResponse, error)
+	CreateJourney(context.Context, *CreateJourneyRequest) (*CreateJourneyResponse, error)
+	ListJourneys(context.Context, *ListJourneysRequest) (*ListJourneysResponse, error)
+	RetireJourney(context.Context, *RetireJourneyRequest) (*RetireJourneyResponse, error)
+	SetSeatLayout(context.Context, *SetSeatLayoutRequest) (*SetSeatLayoutResponse, error)
+	QuoteFare(context.Context, *QuoteFareRequest) (*QuoteFareResponse, error)
+	ListTicketsByUser(context.Context, *ListTicketsByUserRequest) (*ListTicketsByUserResponse, error)
+	PurchaseGroup(context.Context, *PurchaseGroupRequest) (*PurchaseGroupResponse, error)
+	HoldSeat(context.Context, *HoldSeatRequest) (*HoldSeatResponse, error)
+	ConfirmHold(context.Context, *ConfirmHoldRequest) (*ConfirmHoldResponse, error)
+	ReleaseHold(context.Context, *ReleaseHoldRequest) (*ReleaseHoldResponse, error)
+	GetWaitlistPosition(context.Context, *GetWaitlistPositionRequest) (*GetWaitlistPositionResponse, error)
+	LeaveWaitlist(context.Context, *LeaveWaitlistRequest) (*LeaveWaitlistResponse, error)
+	SwapSeats(context.Context, *SwapSeatsRequest) (*SwapSeatsResponse, error)
+	AcceptSwap(context.Context, *AcceptSwapRequest) (*AcceptSwapResponse, error)
+	TransferTicket(context.Context, *TransferTicketRequest) (*TransferTicketResponse, error)
+	GetReceipt(context.Context, *GetReceiptRequest) (*GetReceiptResponse, error)
+	UpgradeSeat(context.Context, *UpgradeSeatRequest) (*UpgradeSeatResponse, error)
+	BlockSeat(context.Context, *BlockSeatRequest) (*BlockSeatResponse, error)
+	UnblockSeat(context.Context, *UnblockSeatRequest) (*UnblockSeatResponse, error)
+	ListStations(context.Context, *ListStationsRequest) (*ListStationsResponse, error)
+	SearchStations(context.Context, *SearchStationsRequest) (*SearchStationsResponse, error)
+	SearchJourneys(context.Context, *SearchJourneysRequest) (*SearchJourneysResponse, error)
+	PurchaseReturn(context.Context, *PurchaseReturnRequest) (*PurchaseReturnResponse, error)
+	PurchaseItinerary(context.Context, *PurchaseItineraryRequest) (*PurchaseItineraryResponse, error)
+	PurchasePass(context.Context, *PurchasePassRequest) (*PurchasePassResponse, error)
+	GetPass(context.Context, *GetPassRequest) (*GetPassResponse, error)
+	DelayJourney(context.Context, *DelayJourneyRequest) (*DelayJourneyResponse, error)
+	CancelJourney(context.Context, *CancelJourneyRequest) (*CancelJourneyResponse, error)
 	mustEmbedUnimplementedTrainServiceServer()
 }
 
@@ -129,6 +493,90 @@ func (UnimplementedTrainServiceServer) RemoveUser(context.Context, *RemoveUserRe
 func (UnimplementedTrainServiceServer) ModifySeat(context.Context, *ModifySeatRequest) (*ModifySeatResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ModifySeat not implemented")
 }
+func (UnimplementedTrainServiceServer) CreateJourney(context.Context, *CreateJourneyRequest) (*CreateJourneyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateJourney not implemented")
+}
+func (UnimplementedTrainServiceServer) ListJourneys(context.Context, *ListJourneysRequest) (*ListJourneysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListJourneys not implemented")
+}
+func (UnimplementedTrainServiceServer) RetireJourney(context.Context, *RetireJourneyRequest) (*RetireJourneyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetireJourney not implemented")
+}
+func (UnimplementedTrainServiceServer) SetSeatLayout(context.Context, *SetSeatLayoutRequest) (*SetSeatLayoutResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetSeatLayout not implemented")
+}
+func (UnimplementedTrainServiceServer) QuoteFare(context.Context, *QuoteFareRequest) (*QuoteFareResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QuoteFare not implemented")
+}
+func (UnimplementedTrainServiceServer) ListTicketsByUser(context.Context, *ListTicketsByUserRequest) (*ListTicketsByUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTicketsByUser not implemented")
+}
+func (UnimplementedTrainServiceServer) PurchaseGroup(context.Context, *PurchaseGroupRequest) (*PurchaseGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurchaseGroup not implemented")
+}
+func (UnimplementedTrainServiceServer) HoldSeat(context.Context, *HoldSeatRequest) (*HoldSeatResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HoldSeat not implemented")
+}
+func (UnimplementedTrainServiceServer) ConfirmHold(context.Context, *ConfirmHoldRequest) (*ConfirmHoldResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmHold not implemented")
+}
+func (UnimplementedTrainServiceServer) ReleaseHold(context.Context, *ReleaseHoldRequest) (*ReleaseHoldResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReleaseHold not implemented")
+}
+func (UnimplementedTrainServiceServer) GetWaitlistPosition(context.Context, *GetWaitlistPositionRequest) (*GetWaitlistPositionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWaitlistPosition not implemented")
+}
+func (UnimplementedTrainServiceServer) LeaveWaitlist(context.Context, *LeaveWaitlistRequest) (*LeaveWaitlistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LeaveWaitlist not implemented")
+}
+func (UnimplementedTrainServiceServer) SwapSeats(context.Context, *SwapSeatsRequest) (*SwapSeatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SwapSeats not implemented")
+}
+func (UnimplementedTrainServiceServer) AcceptSwap(context.Context, *AcceptSwapRequest) (*AcceptSwapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptSwap not implemented")
+}
+func (UnimplementedTrainServiceServer) TransferTicket(context.Context, *TransferTicketRequest) (*TransferTicketResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferTicket not implemented")
+}
+func (UnimplementedTrainServiceServer) GetReceipt(context.Context, *GetReceiptRequest) (*GetReceiptResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReceipt not implemented")
+}
+func (UnimplementedTrainServiceServer) UpgradeSeat(context.Context, *UpgradeSeatRequest) (*UpgradeSeatResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpgradeSeat not implemented")
+}
+func (UnimplementedTrainServiceServer) BlockSeat(context.Context, *BlockSeatRequest) (*BlockSeatResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlockSeat not implemented")
+}
+func (UnimplementedTrainServiceServer) UnblockSeat(context.Context, *UnblockSeatRequest) (*UnblockSeatResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnblockSeat not implemented")
+}
+func (UnimplementedTrainServiceServer) ListStations(context.Context, *ListStationsRequest) (*ListStationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListStations not implemented")
+}
+func (UnimplementedTrainServiceServer) SearchStations(context.Context, *SearchStationsRequest) (*SearchStationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchStations not implemented")
+}
+func (UnimplementedTrainServiceServer) SearchJourneys(context.Context, *SearchJourneysRequest) (*SearchJourneysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchJourneys not implemented")
+}
+func (UnimplementedTrainServiceServer) PurchaseReturn(context.Context, *PurchaseReturnRequest) (*PurchaseReturnResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurchaseReturn not implemented")
+}
+func (UnimplementedTrainServiceServer) PurchaseItinerary(context.Context, *PurchaseItineraryRequest) (*PurchaseItineraryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurchaseItinerary not implemented")
+}
+func (UnimplementedTrainServiceServer) PurchasePass(context.Context, *PurchasePassRequest) (*PurchasePassResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurchasePass not implemented")
+}
+func (UnimplementedTrainServiceServer) GetPass(context.Context, *GetPassRequest) (*GetPassResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPass not implemented")
+}
+func (UnimplementedTrainServiceServer) DelayJourney(context.Context, *DelayJourneyRequest) (*DelayJourneyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelayJourney not implemented")
+}
+func (UnimplementedTrainServiceServer) CancelJourney(context.Context, *CancelJourneyRequest) (*CancelJourneyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelJourney not implemented")
+}
 func (UnimplementedTrainServiceServer) mustEmbedUnimplementedTrainServiceServer() {}
 func (UnimplementedTrainServiceServer) testEmbeddedByValue()                      {}
 
@@ -240,32 +688,648 @@ func _TrainService_ModifySeat_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
-// TrainService_ServiceDesc is the grpc.ServiceDesc for TrainService service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var TrainService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "train.TrainService",
-	HandlerType: (*TrainServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "PurchaseTicket",
-			Handler:    _TrainService_PurchaseTicket_Handler,
-		},
-		{
-			MethodName: "GetTicket",
-			Handler:    _TrainService_GetTicket_Handler,
-		},
-		{
-			MethodName: "GetSeatsBySection",
-			Handler:    _TrainService_GetSeatsBySection_Handler,
-		},
-		{
-			MethodName: "RemoveUser",
-			Handler:    _TrainService_RemoveUser_Handler,
-		},
-		{
-			MethodName: "ModifySeat",
-			Handler:    _TrainService_ModifySeat_Handler,
+func _TrainService_CreateJourney_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateJourneyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrainServiceServer).CreateJourney(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TrainService_CreateJourney_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrainServiceServer).CreateJourney(ctx, req.(*CreateJourneyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrainService_ListJourneys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListJourneysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrainServiceServer).ListJourneys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TrainService_ListJourneys_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrainServiceServer).ListJourneys(ctx, req.(*ListJourneysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrainService_RetireJourney_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RetireJourneyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrainServiceServer).RetireJourney(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TrainService_RetireJourney_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrainServiceServer).RetireJourney(ctx, req.(*RetireJourneyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrainService_SetSeatLayout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetSeatLayoutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrainServiceServer).SetSeatLayout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TrainService_SetSeatLayout_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrainServiceServer).SetSeatLayout(ctx, req.(*SetSeatLayoutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrainService_QuoteFare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuoteFareRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrainServiceServer).QuoteFare(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TrainService_QuoteFare_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrainServiceServer).QuoteFare(ctx, req.(*QuoteFareRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrainService_ListTicketsByUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTicketsByUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrainServiceServer).ListTicketsByUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TrainService_ListTicketsByUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrainServiceServer).ListTicketsByUser(ctx, req.(*ListTicketsByUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrainService_PurchaseGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurchaseGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrainServiceServer).PurchaseGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TrainService_PurchaseGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrainServiceServer).PurchaseGroup(ctx, req.(*PurchaseGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrainService_HoldSeat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HoldSeatRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrainServiceServer).HoldSeat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TrainService_HoldSeat_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrainServiceServer).HoldSeat(ctx, req.(*HoldSeatRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrainService_ConfirmHold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfirmHoldRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrainServiceServer).ConfirmHold(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TrainService_ConfirmHold_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrainServiceServer).ConfirmHold(ctx, req.(*ConfirmHoldRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrainService_ReleaseHold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReleaseHoldRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrainServiceServer).ReleaseHold(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TrainService_ReleaseHold_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrainServiceServer).ReleaseHold(ctx, req.(*ReleaseHoldRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrainService_GetWaitlistPosition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWaitlistPositionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrainServiceServer).GetWaitlistPosition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TrainService_GetWaitlistPosition_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrainServiceServer).GetWaitlistPosition(ctx, req.(*GetWaitlistPositionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrainService_LeaveWaitlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LeaveWaitlistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrainServiceServer).LeaveWaitlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TrainService_LeaveWaitlist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrainServiceServer).LeaveWaitlist(ctx, req.(*LeaveWaitlistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrainService_SwapSeats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SwapSeatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrainServiceServer).SwapSeats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TrainService_SwapSeats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrainServiceServer).SwapSeats(ctx, req.(*SwapSeatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrainService_AcceptSwap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcceptSwapRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrainServiceServer).AcceptSwap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TrainService_AcceptSwap_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrainServiceServer).AcceptSwap(ctx, req.(*AcceptSwapRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrainService_TransferTicket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransferTicketRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrainServiceServer).TransferTicket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TrainService_TransferTicket_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrainServiceServer).TransferTicket(ctx, req.(*TransferTicketRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrainService_GetReceipt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReceiptRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrainServiceServer).GetReceipt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TrainService_GetReceipt_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrainServiceServer).GetReceipt(ctx, req.(*GetReceiptRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrainService_UpgradeSeat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpgradeSeatRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrainServiceServer).UpgradeSeat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TrainService_UpgradeSeat_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrainServiceServer).UpgradeSeat(ctx, req.(*UpgradeSeatRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrainService_BlockSeat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockSeatRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrainServiceServer).BlockSeat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TrainService_BlockSeat_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrainServiceServer).BlockSeat(ctx, req.(*BlockSeatRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrainService_UnblockSeat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnblockSeatRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrainServiceServer).UnblockSeat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TrainService_UnblockSeat_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrainServiceServer).UnblockSeat(ctx, req.(*UnblockSeatRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrainService_ListStations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListStationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrainServiceServer).ListStations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TrainService_ListStations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrainServiceServer).ListStations(ctx, req.(*ListStationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrainService_SearchStations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchStationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrainServiceServer).SearchStations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TrainService_SearchStations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrainServiceServer).SearchStations(ctx, req.(*SearchStationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrainService_SearchJourneys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchJourneysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrainServiceServer).SearchJourneys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TrainService_SearchJourneys_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrainServiceServer).SearchJourneys(ctx, req.(*SearchJourneysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrainService_PurchaseReturn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurchaseReturnRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrainServiceServer).PurchaseReturn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TrainService_PurchaseReturn_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrainServiceServer).PurchaseReturn(ctx, req.(*PurchaseReturnRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrainService_PurchaseItinerary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurchaseItineraryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrainServiceServer).PurchaseItinerary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TrainService_PurchaseItinerary_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrainServiceServer).PurchaseItinerary(ctx, req.(*PurchaseItineraryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrainService_PurchasePass_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurchasePassRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrainServiceServer).PurchasePass(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TrainService_PurchasePass_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrainServiceServer).PurchasePass(ctx, req.(*PurchasePassRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrainService_GetPass_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPassRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrainServiceServer).GetPass(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TrainService_GetPass_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrainServiceServer).GetPass(ctx, req.(*GetPassRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrainService_DelayJourney_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DelayJourneyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrainServiceServer).DelayJourney(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TrainService_DelayJourney_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrainServiceServer).DelayJourney(ctx, req.(*DelayJourneyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrainService_CancelJourney_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelJourneyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrainServiceServer).CancelJourney(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TrainService_CancelJourney_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrainServiceServer).CancelJourney(ctx, req.(*CancelJourneyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// TrainService_ServiceDesc is the grpc.ServiceDesc for TrainService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var TrainService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "train.TrainService",
+	HandlerType: (*TrainServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "PurchaseTicket",
+			Handler:    _TrainService_PurchaseTicket_Handler,
+		},
+		{
+			MethodName: "GetTicket",
+			Handler:    _TrainService_GetTicket_Handler,
+		},
+		{
+			MethodName: "GetSeatsBySection",
+			Handler:    _TrainService_GetSeatsBySection_Handler,
+		},
+		{
+			MethodName: "RemoveUser",
+			Handler:    _TrainService_RemoveUser_Handler,
+		},
+		{
+			MethodName: "ModifySeat",
+			Handler:    _TrainService_ModifySeat_Handler,
+		},
+		{
+			MethodName: "CreateJourney",
+			Handler:    _TrainService_CreateJourney_Handler,
+		},
+		{
+			MethodName: "ListJourneys",
+			Handler:    _TrainService_ListJourneys_Handler,
+		},
+		{
+			MethodName: "RetireJourney",
+			Handler:    _TrainService_RetireJourney_Handler,
+		},
+		{
+			MethodName: "SetSeatLayout",
+			Handler:    _TrainService_SetSeatLayout_Handler,
+		},
+		{
+			MethodName: "QuoteFare",
+			Handler:    _TrainService_QuoteFare_Handler,
+		},
+		{
+			MethodName: "ListTicketsByUser",
+			Handler:    _TrainService_ListTicketsByUser_Handler,
+		},
+		{
+			MethodName: "PurchaseGroup",
+			Handler:    _TrainService_PurchaseGroup_Handler,
+		},
+		{
+			MethodName: "HoldSeat",
+			Handler:    _TrainService_HoldSeat_Handler,
+		},
+		{
+			MethodName: "ConfirmHold",
+			Handler:    _TrainService_ConfirmHold_Handler,
+		},
+		{
+			MethodName: "ReleaseHold",
+			Handler:    _TrainService_ReleaseHold_Handler,
+		},
+		{
+			MethodName: "GetWaitlistPosition",
+			Handler:    _TrainService_GetWaitlistPosition_Handler,
+		},
+		{
+			MethodName: "LeaveWaitlist",
+			Handler:    _TrainService_LeaveWaitlist_Handler,
+		},
+		{
+			MethodName: "SwapSeats",
+			Handler:    _TrainService_SwapSeats_Handler,
+		},
+		{
+			MethodName: "AcceptSwap",
+			Handler:    _TrainService_AcceptSwap_Handler,
+		},
+		{
+			MethodName: "TransferTicket",
+			Handler:    _TrainService_TransferTicket_Handler,
+		},
+		{
+			MethodName: "GetReceipt",
+			Handler:    _TrainService_GetReceipt_Handler,
+		},
+		{
+			MethodName: "UpgradeSeat",
+			Handler:    _TrainService_UpgradeSeat_Handler,
+		},
+		{
+			MethodName: "BlockSeat",
+			Handler:    _TrainService_BlockSeat_Handler,
+		},
+		{
+			MethodName: "UnblockSeat",
+			Handler:    _TrainService_UnblockSeat_Handler,
+		},
+		{
+			MethodName: "ListStations",
+			Handler:    _TrainService_ListStations_Handler,
+		},
+		{
+			MethodName: "SearchStations",
+			Handler:    _TrainService_SearchStations_Handler,
+		},
+		{
+			MethodName: "SearchJourneys",
+			Handler:    _TrainService_SearchJourneys_Handler,
+		},
+		{
+			MethodName: "PurchaseReturn",
+			Handler:    _TrainService_PurchaseReturn_Handler,
+		},
+		{
+			MethodName: "PurchaseItinerary",
+			Handler:    _TrainService_PurchaseItinerary_Handler,
+		},
+		{
+			MethodName: "PurchasePass",
+			Handler:    _TrainService_PurchasePass_Handler,
+		},
+		{
+			MethodName: "GetPass",
+			Handler:    _TrainService_GetPass_Handler,
+		},
+		{
+			MethodName: "DelayJourney",
+			Handler:    _TrainService_DelayJourney_Handler,
+		},
+		{
+			MethodName: "CancelJourney",
+			Handler:    _TrainService_CancelJourney_Handler,
 		},
 	},
 	Streams:  []grpc.StreamDesc{},
@@ -0,0 +1,91 @@
+package receipt
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+)
+
+// A4 in points, receipts are set in 10pt Courier so the text layout lines
+// up the same way it does in plain text.
+const (
+	pageWidth    = 595
+	pageHeight   = 842
+	margin       = 56
+	fontSize     = 10
+	leading      = 14
+	linesPerPage = (pageHeight - 2*margin) / leading
+)
+
+// PDF renders a receipt as a PDF document.
+func PDF(r *Receipt) ([]byte, error) {
+	lines := textLines(r)
+	var pages [][]string
+	for len(lines) > linesPerPage {
+		pages = append(pages, lines[:linesPerPage])
+		lines = lines[linesPerPage:]
+	}
+	pages = append(pages, lines)
+
+	// objects are numbered from 1: catalog, page tree, font, then a page
+	// and its content stream for every page
+	objects := []string{
+		"<< /Type /Catalog /Pages 2 0 R >>",
+		"", // page tree, filled in once the page objects are numbered
+		"<< /Type /Font /Subtype /Type1 /BaseFont /Courier /Encoding /WinAnsiEncoding >>",
+	}
+	var kids []string
+	for _, page := range pages {
+		pageObject := len(objects) + 1
+		kids = append(kids, fmt.Sprintf("%d 0 R", pageObject))
+		objects = append(objects,
+			fmt.Sprintf("<< /Type /Page /Parent 2 0 R /MediaBox [0 0 %d %d] /Resources << /Font << /F1 3 0 R >> >> /Contents %d 0 R >>", pageWidth, pageHeight, pageObject+1),
+			contentStream(page),
+		)
+	}
+	objects[1] = fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>", strings.Join(kids, " "), len(kids))
+
+	var buf bytes.Buffer
+	buf.WriteString("%PDF-1.4\n")
+	offsets := make([]int, len(objects))
+	for i, object := range objects {
+		offsets[i] = buf.Len()
+		fmt.Fprintf(&buf, "%d 0 obj\n%s\nendobj\n", i+1, object)
+	}
+	xref := buf.Len()
+	fmt.Fprintf(&buf, "xref\n0 %d\n0000000000 65535 f \n", len(objects)+1)
+	for _, offset := range offsets {
+		fmt.Fprintf(&buf, "%010d 00000 n \n", offset)
+	}
+	fmt.Fprintf(&buf, "trailer\n<< /Size %d /Root 1 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(objects)+1, xref)
+	return buf.Bytes(), nil
+}
+
+// contentStream writes lines top down from the upper margin.
+func contentStream(lines []string) string {
+	var text strings.Builder
+	fmt.Fprintf(&text, "BT\n/F1 %d Tf\n%d TL\n%d %d Td\n", fontSize, leading, margin, pageHeight-margin)
+	for _, line := range lines {
+		fmt.Fprintf(&text, "(%s) '\n", escape(line))
+	}
+	text.WriteString("ET")
+	return fmt.Sprintf("<< /Length %d >>\nstream\n%s\nendstream", text.Len(), text.String())
+}
+
+// escape quotes a PDF string, characters outside ASCII are replaced since
+// the standard fonts only cover WinAnsi.
+func escape(s string) string {
+	var b strings.Builder
+	for _, r := range s {
+		switch {
+		case r == '\\' || r == '(' || r == ')':
+			b.WriteByte('\\')
+			b.WriteRune(r)
+		case r < ' ' || r > '~':
+			b.WriteByte('?')
+		default:
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
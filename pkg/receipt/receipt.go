@@ -0,0 +1,214 @@
+// Package receipt renders the sale documents of tickets as plain text, HTML
+// and PDF.
+package receipt
+
+import (
+	"bytes"
+	"fmt"
+	htmltemplate "html/template"
+	"strings"
+	"text/template"
+	"time"
+
+	train "github.com/bijoyv/train/pkg/proto"
+)
+
+// Receipt is the sale document of one ticket.
+type Receipt struct {
+	InvoiceNumber string
+	Issued        time.Time
+	Ticket        *train.Ticket
+}
+
+// Line is an amount on the receipt.
+type Line struct {
+	Description string
+	Amount      string
+}
+
+// Passenger names the passenger travelling on the ticket.
+func (r *Receipt) Passenger() string {
+	u := r.Ticket.GetUser()
+	return contact(u.GetFirstName(), u.GetLastName(), u.GetEmail())
+}
+
+// Purchaser names who the ticket was sold to.
+func (r *Receipt) Purchaser() string {
+	p := r.Ticket.GetPurchaser()
+	return contact(p.GetFirstName(), p.GetLastName(), p.GetEmail())
+}
+
+// contact formats a name and email, leaving out whichever is missing.
+func contact(firstName, lastName, email string) string {
+	name := strings.TrimSpace(firstName + " " + lastName)
+	switch {
+	case name == "":
+		return email
+	case email == "":
+		return name
+	default:
+		return fmt.Sprintf("%s <%s>", name, email)
+	}
+}
+
+// Details are the labelled facts printed above the fare lines.
+func (r *Receipt) Details() []Line {
+	t := r.Ticket
+	details := []Line{
+		{"Invoice", r.InvoiceNumber},
+		{"Issued", r.Issued.UTC().Format("2006-01-02 15:04 MST")},
+		{"Reference", t.Reference},
+		{"Sold to", r.Purchaser()},
+	}
+	if address := t.GetPurchaser().GetBillingAddress(); address != "" {
+		details = append(details, Line{"Billing", address})
+	}
+	details = append(details, []Line{
+		{"Passenger", r.Passenger()},
+		{"Journey", fmt.Sprintf("%s, %s to %s", t.JourneyId, t.From, t.To)},
+		{"Seat", fmt.Sprintf("%s, %s", t.Seat, enumName(t.SeatClass.String(), "SEAT_CLASS_"))},
+	}...)
+	if t.PaymentId != "" {
+		details = append(details, Line{"Payment", fmt.Sprintf("%s, %s", t.PaymentId, enumName(t.PaymentStatus.String(), "PAYMENT_STATUS_"))})
+	}
+	return details
+}
+
+// Lines are the fare breakdown, the total of the sale, the upgrades paid
+// for later under their own invoices and any refund.
+func (r *Receipt) Lines() []Line {
+	t := r.Ticket
+	var lines []Line
+	total := t.Price
+	if len(t.FareBreakdown) > 0 {
+		total = &train.Money{Currency: t.Price.GetCurrency()}
+	}
+	for _, c := range t.FareBreakdown {
+		lines = append(lines, Line{c.Description, Amount(c.Amount)})
+		total.MinorUnits += c.Amount.GetMinorUnits()
+	}
+	lines = append(lines, Line{"Total", Amount(total)})
+	for _, p := range t.UpgradePayments {
+		if p.InvoiceNumber != "" {
+			issued := p.InvoicedAt.AsTime().UTC().Format("2006-01-02")
+			lines = append(lines, Line{fmt.Sprintf("%s, %s of %s", p.Description, p.InvoiceNumber, issued), Amount(p.Amount)})
+		}
+	}
+	if c := t.Cancellation; c != nil {
+		lines = append(lines,
+			Line{"Cancellation fee", Amount(c.Fee)},
+			Line{"Refunded", Amount(c.Refund)},
+		)
+	}
+	return lines
+}
+
+// Amount formats money in major units, 2000 GBP minor units is "20.00 GBP".
+func Amount(m *train.Money) string {
+	units := m.GetMinorUnits()
+	sign := ""
+	if units < 0 {
+		sign, units = "-", -units
+	}
+	return fmt.Sprintf("%s%d.%02d %s", sign, units/100, units%100, m.GetCurrency())
+}
+
+// enumName turns PAYMENT_STATUS_CAPTURED into captured.
+func enumName(name, prefix string) string {
+	return strings.ToLower(strings.TrimPrefix(name, prefix))
+}
+
+// Render renders a receipt, an unspecified format is plain text.
+func Render(r *Receipt, format train.ReceiptFormat) ([]byte, error) {
+	switch format {
+	case train.ReceiptFormat_RECEIPT_FORMAT_UNSPECIFIED, train.ReceiptFormat_RECEIPT_FORMAT_TEXT:
+		return Text(r)
+	case train.ReceiptFormat_RECEIPT_FORMAT_HTML:
+		return HTML(r)
+	case train.ReceiptFormat_RECEIPT_FORMAT_PDF:
+		return PDF(r)
+	default:
+		return nil, fmt.Errorf("unknown receipt format %s", format)
+	}
+}
+
+// ContentType is the MIME type of a format.
+func ContentType(format train.ReceiptFormat) string {
+	switch format {
+	case train.ReceiptFormat_RECEIPT_FORMAT_HTML:
+		return "text/html; charset=utf-8"
+	case train.ReceiptFormat_RECEIPT_FORMAT_PDF:
+		return "application/pdf"
+	default:
+		return "text/plain; charset=utf-8"
+	}
+}
+
+// FileName names the receipt file, INV-000001.pdf.
+func FileName(r *Receipt, format train.ReceiptFormat) string {
+	switch format {
+	case train.ReceiptFormat_RECEIPT_FORMAT_HTML:
+		return r.InvoiceNumber + ".html"
+	case train.ReceiptFormat_RECEIPT_FORMAT_PDF:
+		return r.InvoiceNumber + ".pdf"
+	default:
+		return r.InvoiceNumber + ".txt"
+	}
+}
+
+// textLines lays the receipt out in fixed width columns, they are shared by
+// the text and PDF renderers.
+func textLines(r *Receipt) []string {
+	lines := []string{"RECEIPT", ""}
+	for _, d := range r.Details() {
+		lines = append(lines, fmt.Sprintf("%-12s %s", d.Description, d.Amount))
+	}
+	lines = append(lines, "")
+	for _, l := range r.Lines() {
+		lines = append(lines, fmt.Sprintf("%-44s %16s", l.Description, l.Amount))
+	}
+	return lines
+}
+
+var textTemplate = template.Must(template.New("receipt").Parse(`{{range .}}{{.}}
+{{end}}`))
+
+// Text renders a receipt as plain text.
+func Text(r *Receipt) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := textTemplate.Execute(&buf, textLines(r)); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+var htmlTemplate = htmltemplate.Must(htmltemplate.New("receipt").Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>Receipt {{.InvoiceNumber}}</title>
+</head>
+<body>
+<h1>Receipt {{.InvoiceNumber}}</h1>
+<table>
+{{- range .Details}}
+<tr><th align="left">{{.Description}}</th><td>{{.Amount}}</td></tr>
+{{- end}}
+</table>
+<table>
+{{- range .Lines}}
+<tr><td>{{.Description}}</td><td align="right">{{.Amount}}</td></tr>
+{{- end}}
+</table>
+</body>
+</html>
+`))
+
+// HTML renders a receipt as an HTML page.
+func HTML(r *Receipt) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := htmlTemplate.Execute(&buf, r); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,102 @@
+package receipt
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	train "github.com/bijoyv/train/pkg/proto"
+)
+
+func testReceipt() *Receipt {
+	gbp := func(units int64) *train.Money {
+		return &train.Money{Currency: "GBP", MinorUnits: units}
+	}
+	return &Receipt{
+		InvoiceNumber: "INV-000042",
+		Issued:        time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC),
+		Ticket: &train.Ticket{
+			From:      "London",
+			To:        "Paris",
+			User:      &train.User{FirstName: "Sam", LastName: "O'Hara (Jr)", Email: "sam.ohara@example.com"},
+			Seat:      "A1",
+			JourneyId: "default",
+			Price:     gbp(1500),
+			FareBreakdown: []*train.FareComponent{
+				{Description: "Base fare London to Paris", Amount: gbp(2000)},
+				{Description: "child discount", Amount: gbp(-500)},
+			},
+			SeatClass:     train.SeatClass_SEAT_CLASS_STANDARD,
+			Reference:     "ABC234",
+			PaymentId:     "PAY000007",
+			PaymentStatus: train.PaymentStatus_PAYMENT_STATUS_CAPTURED,
+			Purchaser:     &train.Purchaser{LastName: "Travel Office", Email: "travel@example.com", BillingAddress: "1 Rail Street, London"},
+		},
+	}
+}
+
+func TestAmount(t *testing.T) {
+	tests := []struct {
+		units int64
+		want  string
+	}{
+		{2000, "20.00 GBP"},
+		{5, "0.05 GBP"},
+		{-550, "-5.50 GBP"},
+	}
+	for _, tt := range tests {
+		if got := Amount(&train.Money{Currency: "GBP", MinorUnits: tt.units}); got != tt.want {
+			t.Errorf("Expected %s, got %s", tt.want, got)
+		}
+	}
+}
+
+func TestText(t *testing.T) {
+	content, err := Text(testReceipt())
+	if err != nil {
+		t.Fatalf("Text failed: %v", err)
+	}
+	for _, want := range []string{"INV-000042", "Sam O'Hara (Jr) <sam.ohara@example.com>", "child discount", "-5.00 GBP", "15.00 GBP", "PAY000007, captured", "Sold to      Travel Office <travel@example.com>", "1 Rail Street, London"} {
+		if !strings.Contains(string(content), want) {
+			t.Errorf("Expected the receipt to contain %q, got\n%s", want, content)
+		}
+	}
+}
+
+func TestHTML(t *testing.T) {
+	content, err := HTML(testReceipt())
+	if err != nil {
+		t.Fatalf("HTML failed: %v", err)
+	}
+	if !strings.Contains(string(content), "Sam O&#39;Hara (Jr) &lt;sam.ohara@example.com&gt;") {
+		t.Errorf("Expected the passenger to be escaped, got\n%s", content)
+	}
+}
+
+func TestPDF(t *testing.T) {
+	content, err := PDF(testReceipt())
+	if err != nil {
+		t.Fatalf("PDF failed: %v", err)
+	}
+	if !bytes.HasPrefix(content, []byte("%PDF-1.4\n")) || !bytes.HasSuffix(content, []byte("%%EOF\n")) {
+		t.Fatalf("Expected a PDF document, got\n%s", content)
+	}
+	if !bytes.Contains(content, []byte(`(Passenger    Sam O'Hara \(Jr\) <sam.ohara@example.com>) '`)) {
+		t.Errorf("Expected the passenger line with escaped parentheses, got\n%s", content)
+	}
+
+	// every object must sit at the offset the cross reference table gives
+	xref := bytes.Index(content, []byte("\nxref\n")) + 1
+	table := strings.Split(string(content[xref:]), "\n")
+	for i := 1; i <= 5; i++ {
+		var offset int
+		if _, err := fmt.Sscanf(table[2+i], "%010d 00000 n", &offset); err != nil {
+			t.Fatalf("Expected an xref entry for object %d, got %q", i, table[2+i])
+		}
+		if want := fmt.Sprintf("%d 0 obj", i); !bytes.HasPrefix(content[offset:], []byte(want)) {
+			t.Errorf("Expected %q at offset %d", want, offset)
+		}
+	}
+}
@@ -0,0 +1,212 @@
+package reservation
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	train "github.com/bijoyv/train/pkg/proto"
+)
+
+// SeatState is a seat as an allocator sees it, Free is whether the seat is
+// available on the segment being sold.
+type SeatState struct {
+	ID         string
+	Coach      string
+	Row        int
+	Column     string
+	Attributes []string
+	Free       bool
+}
+
+// HasAttribute reports whether the seat carries attribute.
+func (s SeatState) HasAttribute(attribute string) bool {
+	for _, a := range s.Attributes {
+		if a == attribute {
+			return true
+		}
+	}
+	return false
+}
+
+// Allocation is the seat an allocator picked, Notes explain preferences that
+// could not be honoured.
+type Allocation struct {
+	SeatID string
+	Notes  []string
+}
+
+// ErrNoSeats is returned by allocators when no seat is free.
+var ErrNoSeats = errors.New("no seats available")
+
+// SeatAllocator picks a free seat for one passenger. Seats are passed in
+// layout order, prefs may be nil. Allocators return ErrNoSeats when the
+// journey is full.
+type SeatAllocator interface {
+	Allocate(seats []SeatState, prefs *train.SeatPreferences) (Allocation, error)
+}
+
+// SequentialAllocator fills the train front to back in layout order.
+type SequentialAllocator struct{}
+
+func (SequentialAllocator) Allocate(seats []SeatState, prefs *train.SeatPreferences) (Allocation, error) {
+	for _, s := range seats {
+		if s.Free {
+			return Allocation{SeatID: s.ID}, nil
+		}
+	}
+	return Allocation{}, ErrNoSeats
+}
+
+// SpreadAllocator keeps passengers apart, it picks the free seat furthest
+// from any occupied seat in the same coach.
+type SpreadAllocator struct{}
+
+func (SpreadAllocator) Allocate(seats []SeatState, prefs *train.SeatPreferences) (Allocation, error) {
+	best, bestDistance := -1, -1.0
+	for i, s := range seats {
+		if !s.Free {
+			continue
+		}
+		distance := math.Inf(1)
+		for _, o := range seats {
+			if o.Free || o.Coach != s.Coach {
+				continue
+			}
+			distance = math.Min(distance, seatDistance(s, o))
+		}
+		if distance > bestDistance {
+			best, bestDistance = i, distance
+		}
+	}
+	if best < 0 {
+		return Allocation{}, ErrNoSeats
+	}
+	return Allocation{SeatID: seats[best].ID}, nil
+}
+
+// seatDistance is the distance between two seats of a coach counted in rows
+// and columns.
+func seatDistance(a, b SeatState) float64 {
+	rows := float64(a.Row - b.Row)
+	var cols float64
+	if a.Column != "" && b.Column != "" {
+		cols = float64(int([]rune(a.Column)[0]) - int([]rune(b.Column)[0]))
+	}
+	return math.Hypot(rows, cols)
+}
+
+// PreferenceAllocator narrows the free seats down by the passenger's
+// preferences, dropping any preference that cannot be met, and lets
+// Fallback choose among what is left.
+type PreferenceAllocator struct {
+	Fallback SeatAllocator
+}
+
+func (p PreferenceAllocator) Allocate(seats []SeatState, prefs *train.SeatPreferences) (Allocation, error) {
+	var notes []string
+	if prefs == nil {
+		prefs = &train.SeatPreferences{}
+	}
+	if prefs.SeatId != "" {
+		for _, s := range seats {
+			if s.ID == prefs.SeatId && s.Free {
+				return Allocation{SeatID: s.ID}, nil
+			}
+		}
+		notes = append(notes, fmt.Sprintf("seat %s is not available", prefs.SeatId))
+	}
+
+	candidates := seats
+	narrow := func(wanted bool, keep func(SeatState) bool, note string) {
+		if !wanted {
+			return
+		}
+		var narrowed []SeatState
+		for _, s := range candidates {
+			if keep(s) {
+				narrowed = append(narrowed, s)
+			}
+		}
+		if !anyFree(narrowed) {
+			notes = append(notes, note)
+			return
+		}
+		candidates = narrowed
+	}
+	narrow(prefs.Section != "", func(s SeatState) bool { return s.Coach == prefs.Section },
+		fmt.Sprintf("no seat available in section %s", prefs.Section))
+	narrow(prefs.Position == train.SeatPosition_SEAT_POSITION_WINDOW, func(s SeatState) bool { return s.HasAttribute(AttrWindow) },
+		"no window seat available")
+	narrow(prefs.Position == train.SeatPosition_SEAT_POSITION_AISLE, func(s SeatState) bool { return s.HasAttribute(AttrAisle) },
+		"no aisle seat available")
+	narrow(prefs.ForwardFacing, func(s SeatState) bool { return s.HasAttribute(AttrForward) },
+		"no forward facing seat available")
+
+	fallback := p.Fallback
+	if fallback == nil {
+		fallback = SequentialAllocator{}
+	}
+	allocation, err := fallback.Allocate(candidates, prefs)
+	if err != nil {
+		return Allocation{}, err
+	}
+	allocation.Notes = append(notes, allocation.Notes...)
+	return allocation, nil
+}
+
+func anyFree(seats []SeatState) bool {
+	for _, s := range seats {
+		if s.Free {
+			return true
+		}
+	}
+	return false
+}
+
+// AllocatorByName returns one of the built in allocators: sequential,
+// spread or preference.
+func AllocatorByName(name string) (SeatAllocator, error) {
+	switch name {
+	case "sequential":
+		return SequentialAllocator{}, nil
+	case "spread":
+		return SpreadAllocator{}, nil
+	case "preference":
+		return PreferenceAllocator{Fallback: SequentialAllocator{}}, nil
+	default:
+		return nil, fmt.Errorf("unknown seat allocator %q", name)
+	}
+}
+
+// seatStates describes the journey's seats of one class for an allocator
+// selling [start, end).
+func (j *journey) seatStates(start, end int, class train.SeatClass) []SeatState {
+	seats := j.classSeats(class)
+	states := make([]SeatState, 0, len(seats))
+	for _, s := range seats {
+		states = append(states, SeatState{
+			ID:         s.id,
+			Coach:      s.coach,
+			Row:        s.row,
+			Column:     s.column,
+			Attributes: s.attributes,
+			Free:       s.free(start, end),
+		})
+	}
+	return states
+}
+
+// assignSeat asks the service's allocator for a seat of class free on
+// [start, end), an unspecified class is standard.
+func (s *TrainService) assignSeat(j *journey, start, end int, class train.SeatClass, prefs *train.SeatPreferences) (*seat, []string, error) {
+	allocation, err := s.allocator.Allocate(j.seatStates(start, end, seatClass(class)), prefs)
+	if err != nil {
+		return nil, nil, err
+	}
+	seat, ok := j.seats[allocation.SeatID]
+	if !ok || !seat.free(start, end) {
+		return nil, nil, fmt.Errorf("allocator picked unavailable seat %s", allocation.SeatID)
+	}
+	return seat, allocation.Notes, nil
+}
@@ -0,0 +1,84 @@
+package reservation
+
+import (
+	"context"
+	"testing"
+
+	train "github.com/bijoyv/train/pkg/proto"
+)
+
+func TestSeatAllocators(t *testing.T) {
+	j := &journey{info: &train.Journey{Stops: []*train.Stop{{Station: "London"}, {Station: "Paris"}}}}
+	seats, err := buildSeats(&train.SeatLayout{Coaches: []*train.Coach{
+		{Code: "A", Rows: 4, Columns: "ABCD", BackwardRows: []int32{1, 2}},
+		{Code: "B", Rows: 1, Columns: "ABCD"},
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	j.setSeats(seats)
+	for _, id := range []string{"A1", "A4", "A16"} {
+		j.seats[id].occupy(0, 1, "X")
+	}
+	states := j.seatStates(0, 1, train.SeatClass_SEAT_CLASS_STANDARD)
+
+	tests := []struct {
+		name      string
+		allocator SeatAllocator
+		prefs     *train.SeatPreferences
+		want      string
+		notes     int
+	}{
+		{"sequential", SequentialAllocator{}, nil, "A2", 0},
+		{"spread", SpreadAllocator{}, nil, "B1", 0},
+		{"specific seat", PreferenceAllocator{}, &train.SeatPreferences{SeatId: "A7"}, "A7", 0},
+		{"taken seat", PreferenceAllocator{}, &train.SeatPreferences{SeatId: "A4"}, "A2", 1},
+		{"window", PreferenceAllocator{}, &train.SeatPreferences{Position: train.SeatPosition_SEAT_POSITION_WINDOW}, "A5", 0},
+		{"forward aisle", PreferenceAllocator{}, &train.SeatPreferences{Position: train.SeatPosition_SEAT_POSITION_AISLE, ForwardFacing: true}, "A10", 0},
+		{"section", PreferenceAllocator{}, &train.SeatPreferences{Section: "B", Position: train.SeatPosition_SEAT_POSITION_AISLE}, "B2", 0},
+		{"unknown section", PreferenceAllocator{}, &train.SeatPreferences{Section: "Z"}, "A2", 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			allocation, err := tt.allocator.Allocate(states, tt.prefs)
+			if err != nil {
+				t.Fatalf("Allocate failed: %v", err)
+			}
+			if allocation.SeatID != tt.want {
+				t.Errorf("Expected seat %s, got %s", tt.want, allocation.SeatID)
+			}
+			if len(allocation.Notes) != tt.notes {
+				t.Errorf("Expected %d notes, got %v", tt.notes, allocation.Notes)
+			}
+		})
+	}
+}
+
+func TestPurchaseTicketPreferences(t *testing.T) {
+	trainService := NewTrainReservationService()
+	res, err := trainService.PurchaseTicket(context.Background(), &train.PurchaseTicketRequest{
+		From:        "London",
+		To:          "Paris",
+		User:        &train.User{Email: "yara.cole@example.com"},
+		Preferences: &train.SeatPreferences{Section: "B", Position: train.SeatPosition_SEAT_POSITION_WINDOW},
+	})
+	if err != nil {
+		t.Fatalf("PurchaseTicket failed: %v", err)
+	}
+	if res.Ticket.Seat != "B1" {
+		t.Errorf("Expected window seat B1, got %s", res.Ticket.Seat)
+	}
+
+	res, err = trainService.PurchaseTicket(context.Background(), &train.PurchaseTicketRequest{
+		From:        "London",
+		To:          "Paris",
+		User:        &train.User{Email: "zane.cole@example.com"},
+		Preferences: &train.SeatPreferences{SeatId: "B1"},
+	})
+	if err != nil {
+		t.Fatalf("PurchaseTicket failed: %v", err)
+	}
+	if res.Ticket.Seat == "B1" || len(res.AllocationNotes) != 1 {
+		t.Errorf("Expected another seat with a note, got %s %v", res.Ticket.Seat, res.AllocationNotes)
+	}
+}
@@ -0,0 +1,195 @@
+package reservation
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	train "github.com/bijoyv/train/pkg/proto"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// blockedOccupant is what GetSeatsBySection shows for an empty blocked seat.
+const blockedOccupant = "blocked"
+
+// seatBlock keeps a seat out of service on [start, until), a zero until
+// never ends.
+type seatBlock struct {
+	info         *train.SeatBlock
+	start, until time.Time
+}
+
+func (b *seatBlock) active(now time.Time) bool {
+	return !now.Before(b.start) && !b.over(now)
+}
+
+func (b *seatBlock) over(now time.Time) bool {
+	return !b.until.IsZero() && !now.Before(b.until)
+}
+
+// applyBlocks marks the seats under an active block as blocked and clears
+// the ones whose blocks are over or gone. Passengers on a seat that just got
+// blocked are moved to another seat of their class, the tickets moved and
+// the bookings that could not be are returned.
+func (s *TrainService) applyBlocks(st *state) ([]*train.Ticket, []string) {
+	now := s.clock.Now()
+	want := make(map[*seat]*journey)
+	for id, b := range st.blocks {
+		if b.over(now) {
+			delete(st.blocks, id)
+			continue
+		}
+		if !b.active(now) {
+			continue
+		}
+		if j, ok := st.journeys[b.info.JourneyId]; ok {
+			if seat, ok := j.seats[b.info.Seat]; ok {
+				want[seat] = j
+			}
+		}
+	}
+	for seat, j := range st.blocked {
+		if _, still := want[seat]; !still {
+			seat.blocked = false
+			delete(st.blocked, seat)
+			s.seatsFreed(st, j)
+		}
+	}
+	var moved []*train.Ticket
+	var unplaced []string
+	for seat, j := range want {
+		if seat.blocked {
+			continue
+		}
+		seat.blocked = true
+		st.blocked[seat] = j
+		m, u := s.reseat(st, j, seat)
+		moved = append(moved, m...)
+		unplaced = append(unplaced, u...)
+	}
+	return moved, unplaced
+}
+
+// reseat moves the tickets and holds off a blocked seat, preferably within
+// the same coach. Bookings that find no free seat of their class stay where
+// they are.
+func (s *TrainService) reseat(st *state, j *journey, blocked *seat) ([]*train.Ticket, []string) {
+	var moved []*train.Ticket
+	var unplaced []string
+	for _, ref := range blocked.occupants(0, len(blocked.legs)) {
+		var start, end int
+		var class train.SeatClass
+		var move func(*seat)
+		if h, ok := st.holds[ref]; ok {
+			start, end, class = h.start, h.end, h.class
+			move = func(to *seat) { h.info.Seat = to.id }
+		} else if ticket, ok := st.tickets[ref]; ok && ticket.Seat == blocked.id {
+			var err error
+			if start, end, err = j.segment(ticket.From, ticket.To); err != nil {
+				unplaced = append(unplaced, ref)
+				continue
+			}
+			class = ticket.SeatClass
+			move = func(to *seat) {
+				ticket.Seat = to.id
+				moved = append(moved, snapshot(ticket))
+				s.emit(EventSeatReassigned, ticket)
+			}
+		} else {
+			// gone, or the old seat kept for an upgrade being paid
+			continue
+		}
+		blocked.release(ref)
+		to, _, err := s.assignSeat(j, start, end, class, &train.SeatPreferences{Section: blocked.coach})
+		if err != nil {
+			blocked.occupy(start, end, ref)
+			log.Printf("seat %s on %s is blocked but %s could not be moved: %v", blocked.id, j.info.Id, ref, err)
+			unplaced = append(unplaced, ref)
+			continue
+		}
+		to.occupy(start, end, ref)
+		move(to)
+	}
+	return moved, unplaced
+}
+
+// BlockSeat takes a seat out of service. When the block starts at once the
+// passengers booked on the seat are reseated straight away, otherwise when
+// the block starts.
+func (s *TrainService) BlockSeat(ctx context.Context, req *train.BlockSeatRequest) (*train.BlockSeatResponse, error) {
+	if req.Reason == "" {
+		return nil, fmt.Errorf("a seat block needs a reason")
+	}
+	er := make(chan error, 1)
+	result := make(chan *train.BlockSeatResponse, 1)
+
+	s.ops <- func(st *state) {
+		j, err := st.journey(req.JourneyId)
+		if err != nil {
+			er <- err
+			return
+		}
+		if _, exists := j.seats[req.Seat]; !exists {
+			er <- fmt.Errorf("seat %s does not exist on journey %s", req.Seat, j.info.Id)
+			return
+		}
+		now := s.clock.Now()
+		b := &seatBlock{start: now}
+		if req.Start != nil {
+			b.start = req.Start.AsTime()
+		}
+		if req.Until != nil {
+			b.until = req.Until.AsTime()
+			if !b.until.After(b.start) || !b.until.After(now) {
+				er <- fmt.Errorf("seat block must end after it starts and in the future")
+				return
+			}
+		}
+		b.info = &train.SeatBlock{
+			Id:        st.newReference(),
+			JourneyId: j.info.Id,
+			Seat:      req.Seat,
+			Reason:    req.Reason,
+			Start:     timestamppb.New(b.start),
+			Until:     req.Until,
+		}
+		st.blocks[b.info.Id] = b
+		reseated, unplaced := s.applyBlocks(st)
+		result <- &train.BlockSeatResponse{
+			Block:    proto.Clone(b.info).(*train.SeatBlock),
+			Reseated: reseated,
+			Unplaced: unplaced,
+		}
+	}
+	select {
+	case e := <-er:
+		return nil, e
+	case res := <-result:
+		return res, nil
+	}
+}
+
+// UnblockSeat ends a seat block, the seat goes back on sale unless another
+// block still covers it.
+func (s *TrainService) UnblockSeat(ctx context.Context, req *train.UnblockSeatRequest) (*train.UnblockSeatResponse, error) {
+	er := make(chan error, 1)
+	result := make(chan bool, 1)
+
+	s.ops <- func(st *state) {
+		if _, exists := st.blocks[req.BlockId]; !exists {
+			er <- fmt.Errorf("seat block %s not found", req.BlockId)
+			return
+		}
+		delete(st.blocks, req.BlockId)
+		s.applyBlocks(st)
+		result <- true
+	}
+	select {
+	case e := <-er:
+		return nil, e
+	case <-result:
+		return &train.UnblockSeatResponse{Success: true}, nil
+	}
+}
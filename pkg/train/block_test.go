@@ -0,0 +1,168 @@
+package reservation
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	train "github.com/bijoyv/train/pkg/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestBlockSeat(t *testing.T) {
+	events := make(chan Event, 10)
+	trainService := NewTrainReservationService(
+		WithSeatLayout(&train.SeatLayout{Coaches: []*train.Coach{
+			{Code: "A", Rows: 1, Columns: "AB"},
+			{Code: "B", Rows: 1, Columns: "AB"},
+		}}),
+		WithEventListener(func(e Event) {
+			if e.Type == EventSeatReassigned {
+				events <- e
+			}
+		}),
+	)
+	ctx := context.Background()
+	purchase := func(email string) *train.Ticket {
+		t.Helper()
+		res, err := trainService.PurchaseTicket(ctx, &train.PurchaseTicketRequest{From: "London", To: "Paris", User: &train.User{Email: email}})
+		if err != nil {
+			t.Fatalf("PurchaseTicket failed: %v", err)
+		}
+		return res.Ticket
+	}
+	ticket := purchase("eli.moss@example.com")
+	if ticket.Seat != "A1" {
+		t.Fatalf("Expected A1, got %s", ticket.Seat)
+	}
+
+	blocked, err := trainService.BlockSeat(ctx, &train.BlockSeatRequest{Seat: "A1", Reason: "broken recliner"})
+	if err != nil {
+		t.Fatalf("BlockSeat failed: %v", err)
+	}
+	if len(blocked.Reseated) != 1 || blocked.Reseated[0].Seat != "A2" {
+		t.Fatalf("Expected the passenger to be moved to A2 in the same coach, got %v", blocked.Reseated)
+	}
+	select {
+	case e := <-events:
+		if e.Type != EventSeatReassigned || e.Ticket.Reference != ticket.Reference {
+			t.Errorf("Expected a seat.reassigned event for %s, got %s for %s", ticket.Reference, e.Type, e.Ticket.Reference)
+		}
+	case <-time.After(time.Second):
+		t.Error("Expected a seat.reassigned event")
+	}
+
+	t.Run("SeatsBySection", func(t *testing.T) {
+		seats, err := trainService.GetSeatsBySection(ctx, &train.GetSeatsBySectionRequest{Section: "A"})
+		if err != nil {
+			t.Fatalf("GetSeatsBySection failed: %v", err)
+		}
+		if seats.Seats["A1"] != blockedOccupant || seats.Seats["A2"] != "eli.moss@example.com" {
+			t.Errorf("Expected A1 blocked and A2 taken, got %v", seats.Seats)
+		}
+	})
+
+	t.Run("ModifySeat", func(t *testing.T) {
+		for _, seat := range []string{"A1", "Z9"} {
+			_, err := trainService.ModifySeat(ctx, &train.ModifySeatRequest{Reference: ticket.Reference, NewSeat: seat})
+			if err == nil {
+				t.Errorf("Expected moving into %s to fail", seat)
+			}
+		}
+	})
+
+	t.Run("SkippedBySales", func(t *testing.T) {
+		if got := purchase("fay.moss@example.com"); got.Seat != "B1" {
+			t.Errorf("Expected B1, got %s", got.Seat)
+		}
+	})
+
+	t.Run("Unblock", func(t *testing.T) {
+		if _, err := trainService.UnblockSeat(ctx, &train.UnblockSeatRequest{BlockId: blocked.Block.Id}); err != nil {
+			t.Fatalf("UnblockSeat failed: %v", err)
+		}
+		if got := purchase("gus.moss@example.com"); got.Seat != "A1" {
+			t.Errorf("Expected A1 back on sale, got %s", got.Seat)
+		}
+		if _, err := trainService.UnblockSeat(ctx, &train.UnblockSeatRequest{BlockId: blocked.Block.Id}); err == nil {
+			t.Error("Expected unblocking twice to fail")
+		}
+	})
+
+	t.Run("Validation", func(t *testing.T) {
+		tests := []struct {
+			name string
+			req  *train.BlockSeatRequest
+		}{
+			{"no reason", &train.BlockSeatRequest{Seat: "B2"}},
+			{"unknown seat", &train.BlockSeatRequest{Seat: "Z9", Reason: "broken"}},
+			{"window in the past", &train.BlockSeatRequest{Seat: "B2", Reason: "broken", Until: timestamppb.New(time.Now().Add(-time.Hour))}},
+		}
+		for _, tt := range tests {
+			if _, err := trainService.BlockSeat(ctx, tt.req); err == nil {
+				t.Errorf("Expected a block with %s to fail", tt.name)
+			}
+		}
+	})
+}
+
+func TestSeatBlockWindow(t *testing.T) {
+	clock := newFakeClock()
+	trainService := NewTrainReservationService(
+		WithClock(clock),
+		WithSeatLayout(&train.SeatLayout{Coaches: []*train.Coach{{Code: "A", Rows: 1, Columns: "AB"}}}),
+	)
+	ctx := context.Background()
+	_, err := trainService.BlockSeat(ctx, &train.BlockSeatRequest{
+		Seat:   "A1",
+		Reason: "cleaning",
+		Start:  timestamppb.New(clock.Now().Add(time.Hour)),
+		Until:  timestamppb.New(clock.Now().Add(2 * time.Hour)),
+	})
+	if err != nil {
+		t.Fatalf("BlockSeat failed: %v", err)
+	}
+	res, err := trainService.PurchaseTicket(ctx, &train.PurchaseTicketRequest{From: "London", To: "Paris", User: &train.User{Email: "hal.moss@example.com"}})
+	if err != nil {
+		t.Fatalf("PurchaseTicket failed: %v", err)
+	}
+	if res.Ticket.Seat != "A1" {
+		t.Fatalf("Expected A1 on sale before the block starts, got %s", res.Ticket.Seat)
+	}
+
+	clock.Advance(time.Hour)
+	got, err := trainService.GetTicket(ctx, &train.GetTicketRequest{Reference: res.Ticket.Reference})
+	if err != nil {
+		t.Fatalf("GetTicket failed: %v", err)
+	}
+	if got.Ticket.Seat != "A2" {
+		t.Errorf("Expected the passenger moved to A2 once the block started, got %s", got.Ticket.Seat)
+	}
+
+	clock.Advance(time.Hour)
+	seats, err := trainService.GetSeatsBySection(ctx, &train.GetSeatsBySectionRequest{Section: "A"})
+	if err != nil {
+		t.Fatalf("GetSeatsBySection failed: %v", err)
+	}
+	if seats.Seats["A1"] != "" {
+		t.Errorf("Expected A1 free once the block ended, got %s", seats.Seats["A1"])
+	}
+}
+
+func TestBlockSeatUnplaced(t *testing.T) {
+	trainService := NewTrainReservationService(
+		WithSeatLayout(&train.SeatLayout{Coaches: []*train.Coach{{Code: "A", Seats: []*train.SeatDefinition{{Id: "A1"}}}}}),
+	)
+	ctx := context.Background()
+	res, err := trainService.PurchaseTicket(ctx, &train.PurchaseTicketRequest{From: "London", To: "Paris", User: &train.User{Email: "ivy.moss@example.com"}})
+	if err != nil {
+		t.Fatalf("PurchaseTicket failed: %v", err)
+	}
+	blocked, err := trainService.BlockSeat(ctx, &train.BlockSeatRequest{Seat: "A1", Reason: "broken window"})
+	if err != nil {
+		t.Fatalf("BlockSeat failed: %v", err)
+	}
+	if len(blocked.Unplaced) != 1 || blocked.Unplaced[0] != res.Ticket.Reference {
+		t.Errorf("Expected %s to be left unplaced, got %v", res.Ticket.Reference, blocked.Unplaced)
+	}
+}
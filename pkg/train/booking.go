@@ -0,0 +1,53 @@
+package reservation
+
+import (
+	"fmt"
+	"strings"
+
+	train "github.com/bijoyv/train/pkg/proto"
+)
+
+// purchaserFor returns who pays for a passenger's ticket, passengers booking
+// without a purchaser are their own purchaser.
+func purchaserFor(purchaser *train.Purchaser, passenger *train.User) *train.Purchaser {
+	if purchaser != nil {
+		return purchaser
+	}
+	return &train.Purchaser{
+		FirstName: passenger.FirstName,
+		LastName:  passenger.LastName,
+		Email:     passenger.Email,
+	}
+}
+
+// validBooking checks a purchaser and the passengers they book for. Without
+// a purchaser every passenger books for themselves and needs an email, a
+// purchaser can book passengers known only by name.
+func validBooking(purchaser *train.Purchaser, passengers ...*train.User) error {
+	if purchaser != nil && purchaser.Email == "" {
+		return fmt.Errorf("purchaser email is required")
+	}
+	for _, p := range passengers {
+		if p == nil || (p.Email == "" && (purchaser == nil || passengerName(p) == "")) {
+			return fmt.Errorf("Invalid User Information")
+		}
+	}
+	return nil
+}
+
+// passengerName is the full name of a passenger, empty when unknown.
+func passengerName(u *train.User) string {
+	return strings.TrimSpace(u.GetFirstName() + " " + u.GetLastName())
+}
+
+// purchasedBy reports whether email bought the ticket. Purchasers manage a
+// booking: they cancel it and get its receipts.
+func purchasedBy(ticket *train.Ticket, email string) bool {
+	return ticket.Purchaser.GetEmail() == email
+}
+
+// heldBy reports whether email bought or travels on the ticket, either may
+// look the ticket up and change its seat.
+func heldBy(ticket *train.Ticket, email string) bool {
+	return purchasedBy(ticket, email) || ticket.User.GetEmail() == email
+}
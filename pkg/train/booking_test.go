@@ -0,0 +1,121 @@
+package reservation
+
+import (
+	"context"
+	"testing"
+
+	train "github.com/bijoyv/train/pkg/proto"
+)
+
+func TestPurchaserAndPassengers(t *testing.T) {
+	payments := NewFakePaymentProvider()
+	trainService := NewTrainReservationService(WithPaymentProvider(payments))
+	ctx := context.Background()
+	office := &train.Purchaser{LastName: "Travel Office", Email: "travel@example.com", BillingAddress: "1 Rail Street, London"}
+
+	purchase := func(passenger *train.User) *train.Ticket {
+		t.Helper()
+		res, err := trainService.PurchaseTicket(ctx, &train.PurchaseTicketRequest{
+			From:      "London",
+			To:        "Paris",
+			User:      passenger,
+			Purchaser: office,
+		})
+		if err != nil {
+			t.Fatalf("PurchaseTicket failed: %v", err)
+		}
+		return res.Ticket
+	}
+	vic := purchase(&train.User{FirstName: "Vic", LastName: "Tran", Email: "vic.tran@example.com"})
+	wes := purchase(&train.User{FirstName: "Wes", LastName: "Tran"})
+
+	if wes.Purchaser.GetEmail() != "travel@example.com" || wes.User.Email != "" {
+		t.Errorf("Expected a ticket bought by the travel office for a passenger without email, got %v", wes)
+	}
+	if payment, _ := payments.Payment(wes.PaymentId); payment.Email != "travel@example.com" {
+		t.Errorf("Expected the travel office to pay, got %s", payment.Email)
+	}
+
+	t.Run("Lookups", func(t *testing.T) {
+		list, err := trainService.ListTicketsByUser(ctx, &train.ListTicketsByUserRequest{Email: "travel@example.com"})
+		if err != nil {
+			t.Fatalf("ListTicketsByUser failed: %v", err)
+		}
+		if len(list.Tickets) != 2 {
+			t.Errorf("Expected the purchaser to see 2 tickets, got %d", len(list.Tickets))
+		}
+		got, err := trainService.GetTicket(ctx, &train.GetTicketRequest{Email: "vic.tran@example.com"})
+		if err != nil {
+			t.Fatalf("GetTicket failed: %v", err)
+		}
+		if got.Ticket.Reference != vic.Reference {
+			t.Errorf("Expected the passenger to find %s, got %s", vic.Reference, got.Ticket.Reference)
+		}
+		if _, err := trainService.GetTicket(ctx, &train.GetTicketRequest{Reference: wes.Reference, Email: "vic.tran@example.com"}); err == nil {
+			t.Error("Expected a passenger not to see another passenger's ticket")
+		}
+	})
+
+	t.Run("PassengerModifiesSeat", func(t *testing.T) {
+		if _, err := trainService.ModifySeat(ctx, &train.ModifySeatRequest{Email: "vic.tran@example.com", NewSeat: "B5"}); err != nil {
+			t.Errorf("Expected the passenger to change seat, got %v", err)
+		}
+	})
+
+	t.Run("PurchaserOnly", func(t *testing.T) {
+		if _, err := trainService.GetReceipt(ctx, &train.GetReceiptRequest{Reference: vic.Reference, Email: "vic.tran@example.com"}); err == nil {
+			t.Error("Expected a passenger not to get the receipt")
+		}
+		if _, err := trainService.RemoveUser(ctx, &train.RemoveUserRequest{Reference: vic.Reference, Email: "vic.tran@example.com"}); err == nil {
+			t.Error("Expected a passenger not to cancel the purchaser's booking")
+		}
+		// a reference alone does not prove who is asking
+		if _, err := trainService.GetReceipt(ctx, &train.GetReceiptRequest{Reference: vic.Reference}); err == nil {
+			t.Error("Expected a receipt without the purchaser's email to fail")
+		}
+		if _, err := trainService.UpgradeSeat(ctx, &train.UpgradeSeatRequest{Reference: vic.Reference, SeatClass: train.SeatClass_SEAT_CLASS_FIRST}); err == nil {
+			t.Error("Expected an upgrade without the purchaser's email to fail")
+		}
+		if _, err := trainService.RemoveUser(ctx, &train.RemoveUserRequest{Reference: vic.Reference}); err == nil {
+			t.Error("Expected a cancellation without the purchaser's email to fail")
+		}
+		if _, err := trainService.GetTicket(ctx, &train.GetTicketRequest{Reference: vic.Reference}); err != nil {
+			t.Errorf("Expected the reference alone to show the ticket, got %v", err)
+		}
+		if _, err := trainService.RemoveUser(ctx, &train.RemoveUserRequest{Reference: vic.Reference, Email: "travel@example.com"}); err != nil {
+			t.Errorf("Expected the purchaser to cancel, got %v", err)
+		}
+	})
+
+	t.Run("Validation", func(t *testing.T) {
+		tests := []struct {
+			name      string
+			user      *train.User
+			purchaser *train.Purchaser
+		}{
+			{"passenger without email books alone", &train.User{FirstName: "Xia"}, nil},
+			{"purchaser without email", &train.User{FirstName: "Xia"}, &train.Purchaser{LastName: "Travel Office"}},
+			{"passenger without name or email", &train.User{}, office},
+		}
+		for _, tt := range tests {
+			_, err := trainService.PurchaseTicket(ctx, &train.PurchaseTicketRequest{From: "London", To: "Paris", User: tt.user, Purchaser: tt.purchaser})
+			if err == nil {
+				t.Errorf("Expected %s to fail", tt.name)
+			}
+		}
+	})
+
+	t.Run("SelfBooked", func(t *testing.T) {
+		res, err := trainService.PurchaseTicket(ctx, &train.PurchaseTicketRequest{
+			From: "London",
+			To:   "Paris",
+			User: &train.User{FirstName: "Yan", Email: "yan.tran@example.com"},
+		})
+		if err != nil {
+			t.Fatalf("PurchaseTicket failed: %v", err)
+		}
+		if res.Ticket.Purchaser.GetEmail() != "yan.tran@example.com" {
+			t.Errorf("Expected the passenger to be their own purchaser, got %v", res.Ticket.Purchaser)
+		}
+	})
+}
@@ -0,0 +1,14 @@
+package reservation
+
+import "time"
+
+// Clock tells the service the time, tests swap it for one they control.
+type Clock interface {
+	Now() time.Time
+}
+
+type systemClock struct{}
+
+func (systemClock) Now() time.Time {
+	return time.Now()
+}
@@ -0,0 +1,317 @@
+package reservation
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"time"
+
+	train "github.com/bijoyv/train/pkg/proto"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ticketsOn lists the tickets booked on a journey in reference order.
+func (st *state) ticketsOn(j *journey) []*train.Ticket {
+	var tickets []*train.Ticket
+	for _, t := range st.tickets {
+		if t.JourneyId == j.info.Id {
+			tickets = append(tickets, t)
+		}
+	}
+	sort.Slice(tickets, func(a, b int) bool {
+		return tickets[a].Reference < tickets[b].Reference
+	})
+	return tickets
+}
+
+// estimatedArrival is when the journey now gets to station, its timetable
+// plus any delay. It is nil when the journey has no time at station.
+func (j *journey) estimatedArrival(station string) *timestamppb.Timestamp {
+	arrives := j.arrivalAt(station)
+	if arrives.IsZero() {
+		return nil
+	}
+	return timestamppb.New(arrives.Add(j.info.Delay.AsDuration()))
+}
+
+// localTime shows t in the time zone of station.
+func (s *TrainService) localTime(station string, t time.Time) string {
+	if st, err := s.stations.Resolve(station); err == nil {
+		if zone, err := time.LoadLocation(st.TimeZone); err == nil {
+			t = t.In(zone)
+		}
+	}
+	return t.Format("Mon 2 Jan 15:04")
+}
+
+// disruptionNotice starts the notice for a ticket on the disrupted journey j.
+func disruptionNotice(j *journey, ticket *train.Ticket, action train.DisruptionAction) *train.DisruptionNotice {
+	return &train.DisruptionNotice{
+		Reference: ticket.Reference,
+		User:      ticket.User,
+		Purchaser: ticket.Purchaser,
+		Action:    action,
+		JourneyId: j.info.Id,
+	}
+}
+
+// DelayJourney records that a journey runs late. Tickets stay as they are,
+// every passenger gets a notice with their new arrival time.
+func (s *TrainService) DelayJourney(ctx context.Context, req *train.DelayJourneyRequest) (*train.DelayJourneyResponse, error) {
+	if req.EstimatedArrival == nil {
+		return nil, fmt.Errorf("a delay requires the estimated arrival")
+	}
+	er := make(chan error, 1)
+	result := make(chan *train.DelayJourneyResponse, 1)
+
+	s.ops <- func(st *state) {
+		j, err := st.journey(req.JourneyId)
+		if err != nil {
+			er <- err
+			return
+		}
+		if j.info.Status == train.JourneyStatus_JOURNEY_STATUS_CANCELLED {
+			er <- fmt.Errorf("journey %s is cancelled", j.info.Id)
+			return
+		}
+		last := j.info.Stops[len(j.info.Stops)-1].Station
+		arrival := j.arrival(last)
+		if arrival == nil {
+			er <- fmt.Errorf("journey %s has no timetabled arrival to run late against", j.info.Id)
+			return
+		}
+		scheduled := arrival.AsTime()
+		delay := req.EstimatedArrival.AsTime().Sub(scheduled)
+		if delay < 0 {
+			er <- fmt.Errorf("journey %s cannot arrive before its scheduled %s", j.info.Id, s.localTime(last, scheduled))
+			return
+		}
+		j.info.Status = train.JourneyStatus_JOURNEY_STATUS_DELAYED
+		j.info.Delay = durationpb.New(delay)
+		j.info.EstimatedArrival = timestamppb.New(req.EstimatedArrival.AsTime())
+		j.info.DisruptionReason = req.Reason
+		if delay == 0 {
+			j.info.Status = train.JourneyStatus_JOURNEY_STATUS_UNSPECIFIED
+			j.info.Delay, j.info.EstimatedArrival, j.info.DisruptionReason = nil, nil, ""
+		}
+
+		res := &train.DelayJourneyResponse{Journey: proto.Clone(j.info).(*train.Journey)}
+		for _, ticket := range st.ticketsOn(j) {
+			notice := disruptionNotice(j, ticket, train.DisruptionAction_DISRUPTION_ACTION_DELAYED)
+			notice.EstimatedArrival = j.estimatedArrival(ticket.To)
+			switch {
+			case delay == 0:
+				notice.Message = fmt.Sprintf("Train %s is running on time again", j.info.TrainNumber)
+			case notice.EstimatedArrival != nil:
+				notice.Message = fmt.Sprintf("Train %s is running %s late, now expected in %s at %s", j.info.TrainNumber, delay, ticket.To, s.localTime(ticket.To, notice.EstimatedArrival.AsTime()))
+			default:
+				notice.Message = fmt.Sprintf("Train %s is running %s late", j.info.TrainNumber, delay)
+			}
+			if req.Reason != "" && delay != 0 {
+				notice.Message += ": " + req.Reason
+			}
+			res.Report = append(res.Report, notice)
+			s.emitNotice(EventJourneyDelayed, ticket, notice)
+		}
+		result <- res
+	}
+	select {
+	case e := <-er:
+		return nil, e
+	case res := <-result:
+		return res, nil
+	}
+}
+
+// fitsTrip checks ticket can move to journey alt without breaking the round
+// trip or itinerary it belongs to: a return still leaves after the outbound
+// arrives and every change keeps its minimum connection time.
+func (s *TrainService) fitsTrip(st *state, ticket *train.Ticket, alt *journey) error {
+	for _, leg := range st.tripLegs(ticket) {
+		if leg == ticket || !st.active(leg) {
+			continue
+		}
+		other, err := st.journey(leg.JourneyId)
+		if err != nil {
+			return err
+		}
+		switch {
+		case ticket.TripLeg == train.TripLeg_TRIP_LEG_OUTBOUND:
+			err = returnAfterOutbound(alt, other, ticket.To)
+		case ticket.TripLeg == train.TripLeg_TRIP_LEG_RETURN:
+			err = returnAfterOutbound(other, alt, ticket.From)
+		case leg.ItineraryLeg == ticket.ItineraryLeg-1:
+			err = s.connection(other, alt, ticket.From)
+		case leg.ItineraryLeg == ticket.ItineraryLeg+1:
+			err = s.connection(alt, other, ticket.To)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// rebook moves a ticket off the cancelled journey j onto the first journey
+// between the same stations that leaves no earlier, still fits the ticket's
+// round trip or itinerary, and has a free seat in the ticket's class.
+// Journeys without a timetable are not considered.
+func (s *TrainService) rebook(st *state, j *journey, ticket *train.Ticket, now time.Time) (*journey, *seat) {
+	after := j.departure(ticket.From)
+	if after.Before(now) {
+		after = now
+	}
+	var options []*journey
+	for _, alt := range st.journeys {
+		if alt == j || alt.info.Retired {
+			continue
+		}
+		if _, _, err := alt.segment(ticket.From, ticket.To); err != nil {
+			continue
+		}
+		if d := alt.departure(ticket.From); d.IsZero() || d.Before(after) {
+			continue
+		}
+		if err := s.fitsTrip(st, ticket, alt); err != nil {
+			continue
+		}
+		options = append(options, alt)
+	}
+	sort.Slice(options, func(a, b int) bool {
+		da, db := options[a].departure(ticket.From), options[b].departure(ticket.From)
+		if !da.Equal(db) {
+			return da.Before(db)
+		}
+		return options[a].info.Id < options[b].info.Id
+	})
+	for _, alt := range options {
+		start, end, _ := alt.segment(ticket.From, ticket.To)
+		to, _, err := s.assignSeat(alt, start, end, ticket.SeatClass, nil)
+		if err != nil {
+			continue
+		}
+		if old, ok := j.seats[ticket.Seat]; ok {
+			old.release(ticket.Reference)
+		}
+		to.occupy(start, end, ticket.Reference)
+		ticket.JourneyId = alt.info.Id
+		ticket.Seat = to.id
+		return alt, to
+	}
+	return nil, nil
+}
+
+// CancelJourney takes a journey out of service. Every ticket on it moves to
+// the next journey with a free seat, or is cancelled and refunded in full
+// when there is none, together with the other legs of its trip. Holds and the waitlist of the journey are dropped, the
+// payments of waitlisted passengers are voided.
+func (s *TrainService) CancelJourney(ctx context.Context, req *train.CancelJourneyRequest) (*train.CancelJourneyResponse, error) {
+	type cancelled struct {
+		res     *train.CancelJourneyResponse
+		refunds []*train.Ticket
+		voids   []string
+	}
+	er := make(chan error, 1)
+	result := make(chan cancelled, 1)
+
+	s.ops <- func(st *state) {
+		j, err := st.journey(req.JourneyId)
+		if err != nil {
+			er <- err
+			return
+		}
+		if j.info.Status == train.JourneyStatus_JOURNEY_STATUS_CANCELLED {
+			er <- fmt.Errorf("journey %s is already cancelled", j.info.Id)
+			return
+		}
+		j.info.Status = train.JourneyStatus_JOURNEY_STATUS_CANCELLED
+		j.info.Retired = true
+		j.info.DisruptionReason = req.Reason
+		// nobody may take the journey's seats any more
+		var c cancelled
+		for _, entry := range j.waitlist {
+			delete(st.waiting, entry.info.Id)
+			c.voids = append(c.voids, entry.payment)
+		}
+		j.waitlist = nil
+		for id, h := range st.holds {
+			if h.info.JourneyId == j.info.Id {
+				st.releaseHold(h)
+				delete(st.holds, id)
+			}
+		}
+
+		now := s.clock.Now()
+		c.res = &train.CancelJourneyResponse{}
+		for _, ticket := range st.ticketsOn(j) {
+			if !st.active(ticket) {
+				// another leg of its trip could not be rebooked
+				continue
+			}
+			if alt, seat := s.rebook(st, j, ticket, now); alt != nil {
+				notice := disruptionNotice(j, ticket, train.DisruptionAction_DISRUPTION_ACTION_REBOOKED)
+				notice.NewJourneyId = alt.info.Id
+				notice.NewSeat = seat.id
+				notice.EstimatedArrival = alt.estimatedArrival(ticket.To)
+				notice.Message = fmt.Sprintf("Train %s is cancelled, you are moved to train %s seat %s", j.info.TrainNumber, alt.info.TrainNumber, seat.id)
+				if d := alt.departure(ticket.From); !d.IsZero() {
+					notice.Message += fmt.Sprintf(" leaving %s at %s", ticket.From, s.localTime(ticket.From, d))
+				}
+				if req.Reason != "" {
+					notice.Message += ": " + req.Reason
+				}
+				c.res.Report = append(c.res.Report, notice)
+				s.emitNotice(EventTicketRebooked, ticket, notice)
+				continue
+			}
+			// a trip is no use with a leg missing, every leg still booked is
+			// cancelled and refunded in full
+			for _, leg := range st.tripLegs(ticket) {
+				if !st.active(leg) {
+					continue
+				}
+				on, err := st.journey(leg.JourneyId)
+				if err != nil {
+					continue
+				}
+				cancellation := s.cancelWith(st, on, leg, &Refund{
+					Currency: leg.Price.GetCurrency(),
+					Amount:   leg.Price.GetMinorUnits(),
+					Rule:     "journey cancelled",
+				})
+				notice := disruptionNotice(j, leg, train.DisruptionAction_DISRUPTION_ACTION_REFUNDED)
+				notice.Refund = cancellation.Refund
+				notice.Message = fmt.Sprintf("Train %s is cancelled and no later train has a seat, your ticket is refunded in full", j.info.TrainNumber)
+				if leg != ticket {
+					notice.Message = fmt.Sprintf("Train %s is cancelled and no later train has a seat on another leg of your trip, this ticket is refunded in full", j.info.TrainNumber)
+				}
+				if req.Reason != "" {
+					notice.Message += ": " + req.Reason
+				}
+				c.refunds = append(c.refunds, snapshot(leg))
+				c.res.Report = append(c.res.Report, notice)
+				s.emitNotice(EventJourneyCancelled, leg, notice)
+			}
+		}
+		c.res.Journey = proto.Clone(j.info).(*train.Journey)
+		result <- c
+	}
+	var c cancelled
+	select {
+	case e := <-er:
+		return nil, e
+	case c = <-result:
+	}
+	for _, payment := range c.voids {
+		s.void(ctx, payment)
+	}
+	for _, t := range c.refunds {
+		if err := s.refund(ctx, t); err != nil {
+			log.Printf("ticket %s cancelled with journey %s but not refunded: %v", t.Reference, req.JourneyId, err)
+		}
+	}
+	return c.res, nil
+}
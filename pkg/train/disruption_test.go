@@ -0,0 +1,216 @@
+package reservation
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	train "github.com/bijoyv/train/pkg/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestCancelJourney(t *testing.T) {
+	payments := NewFakePaymentProvider()
+	events := make(chan Event, 10)
+	trainService := NewTrainReservationService(
+		WithClock(newFakeClock()),
+		WithPaymentProvider(payments),
+		WithSeatLayout(&train.SeatLayout{Coaches: []*train.Coach{{Code: "A", Rows: 1, Columns: "AB"}}}),
+		WithEventListener(func(e Event) {
+			if e.Notice != nil {
+				events <- e
+			}
+		}),
+	)
+	ctx := context.Background()
+	createTimetabledJourney(t, trainService, "9O10", "London", "Paris", "2026-10-19T07:00:00Z", nil)
+	cancelled := createTimetabledJourney(t, trainService, "9O12", "London", "Paris", "2026-10-19T09:00:00Z", nil)
+	next := createTimetabledJourney(t, trainService, "9O14", "London", "Paris", "2026-10-19T13:00:00Z", nil)
+	purchase := func(journey, email string) *train.Ticket {
+		t.Helper()
+		res, err := trainService.PurchaseTicket(ctx, &train.PurchaseTicketRequest{JourneyId: journey, From: "London", To: "Paris", User: &train.User{Email: email}})
+		if err != nil {
+			t.Fatalf("PurchaseTicket failed: %v", err)
+		}
+		return res.Ticket
+	}
+	booked := map[string]*train.Ticket{}
+	for _, email := range []string{"ann.ives@example.com", "ben.ives@example.com"} {
+		ticket := purchase(cancelled, email)
+		booked[ticket.Reference] = ticket
+	}
+	purchase(next, "cal.ives@example.com")
+
+	res, err := trainService.CancelJourney(ctx, &train.CancelJourneyRequest{JourneyId: cancelled, Reason: "signal failure"})
+	if err != nil {
+		t.Fatalf("CancelJourney failed: %v", err)
+	}
+	if res.Journey.Status != train.JourneyStatus_JOURNEY_STATUS_CANCELLED || !res.Journey.Retired {
+		t.Errorf("Expected the journey cancelled and retired, got %v", res.Journey)
+	}
+	if len(res.Report) != 2 {
+		t.Fatalf("Expected a notice for both passengers, got %v", res.Report)
+	}
+	// the first ticket takes the last seat on 9O14, not the earlier 9O10
+	rebooked, refunded := res.Report[0], res.Report[1]
+	if rebooked.Action != train.DisruptionAction_DISRUPTION_ACTION_REBOOKED || rebooked.NewJourneyId != next || rebooked.NewSeat != "A2" {
+		t.Errorf("Expected a move to %s seat A2, got %v", next, rebooked)
+	}
+	if rebooked.EstimatedArrival.AsTime().Format("15:04") != "15:20" || !strings.Contains(rebooked.Message, "signal failure") {
+		t.Errorf("Expected arrival at 15:20 and the reason, got %v", rebooked)
+	}
+	if refunded.Action != train.DisruptionAction_DISRUPTION_ACTION_REFUNDED || refunded.Refund.MinorUnits != 2000 {
+		t.Errorf("Expected a full refund of 2000, got %v", refunded)
+	}
+	if payment, _ := payments.Payment(booked[refunded.Reference].PaymentId); payment.Refunded != 2000 {
+		t.Errorf("Expected 2000 back on the payment, got %d", payment.Refunded)
+	}
+	for _, want := range []EventType{EventTicketRebooked, EventJourneyCancelled} {
+		select {
+		case e := <-events:
+			if e.Type != want || e.Notice == nil {
+				t.Errorf("Expected a %s event with a notice, got %s", want, e.Type)
+			}
+		case <-time.After(time.Second):
+			t.Errorf("Expected a %s event", want)
+		}
+	}
+
+	got, err := trainService.GetTicket(ctx, &train.GetTicketRequest{Reference: rebooked.Reference})
+	if err != nil {
+		t.Fatalf("GetTicket failed: %v", err)
+	}
+	if got.Ticket.JourneyId != next || got.Ticket.Seat != "A2" {
+		t.Errorf("Expected the ticket on %s seat A2, got %s seat %s", next, got.Ticket.JourneyId, got.Ticket.Seat)
+	}
+	if _, err := trainService.PurchaseTicket(ctx, &train.PurchaseTicketRequest{JourneyId: cancelled, From: "London", To: "Paris", User: &train.User{Email: "dee.ives@example.com"}}); err == nil {
+		t.Error("Expected a cancelled journey to be off sale")
+	}
+	if _, err := trainService.CancelJourney(ctx, &train.CancelJourneyRequest{JourneyId: cancelled}); err == nil {
+		t.Error("Expected cancelling a journey twice to fail")
+	}
+	if _, err := trainService.DelayJourney(ctx, &train.DelayJourneyRequest{JourneyId: cancelled, EstimatedArrival: timestamppb.New(time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC))}); err == nil {
+		t.Error("Expected delaying a cancelled journey to fail")
+	}
+}
+
+func TestCancelJourneyKeepsTrips(t *testing.T) {
+	payments := NewFakePaymentProvider()
+	trainService := NewTrainReservationService(WithClock(newFakeClock()), WithPaymentProvider(payments))
+	ctx := context.Background()
+	outbound := createTimetabledJourney(t, trainService, "9O12", "London", "Paris", "2026-10-19T09:00:00Z", nil)
+	back := createTimetabledJourney(t, trainService, "9O51", "Paris", "London", "2026-10-19T12:00:00Z", nil)
+	// gets to Paris after the return leaves
+	createTimetabledJourney(t, trainService, "9O14", "London", "Paris", "2026-10-19T10:00:00Z", nil)
+	laterBack := createTimetabledJourney(t, trainService, "9O53", "Paris", "London", "2026-10-19T12:10:00Z", nil)
+	first := createTimetabledJourney(t, trainService, "9O32", "London", "Lille", "2026-10-19T09:00:00Z", nil)
+	second := createTimetabledJourney(t, trainService, "9B31", "Lille", "Brussels", "2026-10-19T11:45:00Z", nil)
+	// 5 minutes at Lille, which needs 20
+	createTimetabledJourney(t, trainService, "9O34", "London", "Lille", "2026-10-19T09:30:00Z", nil)
+
+	trip, err := trainService.PurchaseReturn(ctx, &train.PurchaseReturnRequest{From: "London", To: "Paris", OutboundJourneyId: outbound, ReturnJourneyId: back, User: &train.User{Email: "gil.ives@example.com"}})
+	if err != nil {
+		t.Fatalf("PurchaseReturn failed: %v", err)
+	}
+	itinerary, err := trainService.PurchaseItinerary(ctx, &train.PurchaseItineraryRequest{
+		Legs: []*train.ItineraryLeg{{JourneyId: first, From: "London", To: "Lille"}, {JourneyId: second, From: "Lille", To: "Brussels"}},
+		User: &train.User{Email: "hal.ives@example.com"},
+	})
+	if err != nil {
+		t.Fatalf("PurchaseItinerary failed: %v", err)
+	}
+	cancel := func(journey string) []*train.DisruptionNotice {
+		t.Helper()
+		res, err := trainService.CancelJourney(ctx, &train.CancelJourneyRequest{JourneyId: journey})
+		if err != nil {
+			t.Fatalf("CancelJourney failed: %v", err)
+		}
+		return res.Report
+	}
+	// refunded checks that every leg of a trip is cancelled and refunded in
+	// full, each with its own notice
+	refunded := func(report []*train.DisruptionNotice, legs []*train.Ticket) {
+		t.Helper()
+		if len(report) != len(legs) {
+			t.Fatalf("Expected a notice for each of %d legs, got %v", len(legs), report)
+		}
+		for i, leg := range legs {
+			if notice := report[i]; notice.Reference != leg.Reference || notice.Action != train.DisruptionAction_DISRUPTION_ACTION_REFUNDED || notice.Refund.MinorUnits != leg.Price.MinorUnits {
+				t.Errorf("Expected %s refunded %d, got %v", leg.Reference, leg.Price.MinorUnits, notice)
+			}
+			got, err := trainService.GetTicket(ctx, &train.GetTicketRequest{Reference: leg.Reference})
+			if err != nil {
+				t.Fatalf("GetTicket failed: %v", err)
+			}
+			if got.Ticket.Cancellation == nil {
+				t.Errorf("Expected %s cancelled", leg.Reference)
+			}
+		}
+	}
+
+	if report := cancel(back); len(report) != 1 || report[0].Action != train.DisruptionAction_DISRUPTION_ACTION_REBOOKED || report[0].NewJourneyId != laterBack {
+		t.Errorf("Expected the return moved to %s, got %v", laterBack, report)
+	}
+	// the outbound would arrive after the return leaves, so the return is
+	// refunded along with it
+	refunded(cancel(outbound), trip.Tickets)
+	if payment, _ := payments.Payment(trip.Tickets[0].PaymentId); payment.Refunded != 3600 {
+		t.Errorf("Expected the trip's 3600 refunded, got %d", payment.Refunded)
+	}
+	// the first leg would miss the connection
+	refunded(cancel(first), itinerary.Tickets)
+}
+
+func TestDelayJourney(t *testing.T) {
+	trainService := NewTrainReservationService(WithClock(newFakeClock()))
+	ctx := context.Background()
+	journey := createTimetabledJourney(t, trainService, "9O12", "London", "Paris", "2026-10-19T09:00:00Z", nil)
+	if _, err := trainService.PurchaseTicket(ctx, &train.PurchaseTicketRequest{JourneyId: journey, From: "London", To: "Paris", User: &train.User{Email: "eve.ives@example.com"}}); err != nil {
+		t.Fatalf("PurchaseTicket failed: %v", err)
+	}
+	delay := func(eta time.Time) (*train.DelayJourneyResponse, error) {
+		return trainService.DelayJourney(ctx, &train.DelayJourneyRequest{JourneyId: journey, EstimatedArrival: timestamppb.New(eta), Reason: "late running"})
+	}
+
+	res, err := delay(time.Date(2026, 10, 19, 11, 50, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatalf("DelayJourney failed: %v", err)
+	}
+	if res.Journey.Status != train.JourneyStatus_JOURNEY_STATUS_DELAYED || res.Journey.Delay.AsDuration() != 30*time.Minute {
+		t.Errorf("Expected a 30 minute delay, got %v", res.Journey)
+	}
+	if len(res.Report) != 1 || res.Report[0].EstimatedArrival.AsTime().Format("15:04") != "11:50" {
+		t.Fatalf("Expected the passenger told of arrival at 11:50, got %v", res.Report)
+	}
+	// Paris is on CEST
+	if msg := res.Report[0].Message; !strings.Contains(msg, "30m0s late") || !strings.Contains(msg, "13:50") {
+		t.Errorf("Expected the delay and local arrival time in the notice, got %q", msg)
+	}
+
+	if res, err = delay(time.Date(2026, 10, 19, 11, 20, 0, 0, time.UTC)); err != nil {
+		t.Fatalf("DelayJourney failed: %v", err)
+	}
+	if res.Journey.Status != train.JourneyStatus_JOURNEY_STATUS_UNSPECIFIED || res.Journey.Delay != nil {
+		t.Errorf("Expected the journey back on time, got %v", res.Journey)
+	}
+
+	if _, err := delay(time.Date(2026, 10, 19, 11, 0, 0, 0, time.UTC)); err == nil {
+		t.Error("Expected an arrival before the timetable to fail")
+	}
+	if _, err := trainService.DelayJourney(ctx, &train.DelayJourneyRequest{EstimatedArrival: timestamppb.Now()}); err == nil {
+		t.Error("Expected delaying a journey without a timetable to fail")
+	}
+	departure := timestamppb.New(time.Date(2026, 10, 19, 13, 0, 0, 0, time.UTC))
+	untimed, err := trainService.CreateJourney(ctx, &train.CreateJourneyRequest{
+		TrainNumber: "9O14",
+		Departure:   departure,
+		Stops:       []*train.Stop{{Station: "London", Departure: departure}, {Station: "Paris"}},
+	})
+	if err != nil {
+		t.Fatalf("CreateJourney failed: %v", err)
+	}
+	if _, err := trainService.DelayJourney(ctx, &train.DelayJourneyRequest{JourneyId: untimed.Journey.Id, EstimatedArrival: timestamppb.New(time.Date(2026, 10, 19, 16, 0, 0, 0, time.UTC))}); err == nil {
+		t.Error("Expected delaying a journey without an arrival time to fail")
+	}
+}
@@ -0,0 +1,72 @@
+package reservation
+
+import (
+	"log"
+	"time"
+
+	train "github.com/bijoyv/train/pkg/proto"
+	"google.golang.org/protobuf/proto"
+)
+
+// EventType names something that happened to a booking.
+type EventType string
+
+const (
+	EventTicketPurchased  EventType = "ticket.purchased"
+	EventSeatModified     EventType = "seat.modified"
+	EventTicketCancelled  EventType = "ticket.cancelled"
+	EventWaitlistPromoted EventType = "waitlist.promoted"
+	EventSeatReassigned   EventType = "seat.reassigned"
+	EventJourneyDelayed   EventType = "journey.delayed"
+	EventTicketRebooked   EventType = "ticket.rebooked"
+	EventJourneyCancelled EventType = "journey.cancelled"
+)
+
+// Event is published to listeners after the actor changed a booking, Ticket
+// is a copy so listeners can keep it. Disruption events carry the notice
+// sent to the passenger.
+type Event struct {
+	Type   EventType
+	Time   time.Time
+	Ticket *train.Ticket
+	Notice *train.DisruptionNotice
+}
+
+// EventListener receives events on the service's dispatch go routine.
+type EventListener func(Event)
+
+// eventBuffer is how many events can wait for listeners before new ones are
+// dropped, the actor never blocks on a slow listener.
+const eventBuffer = 256
+
+// emit queues an event for the listeners.
+func (s *TrainService) emit(eventType EventType, ticket *train.Ticket) {
+	s.publish(Event{Type: eventType, Time: s.clock.Now(), Ticket: proto.Clone(ticket).(*train.Ticket)})
+}
+
+// emitNotice queues a disruption event for the listeners.
+func (s *TrainService) emitNotice(eventType EventType, ticket *train.Ticket, notice *train.DisruptionNotice) {
+	s.publish(Event{
+		Type:   eventType,
+		Time:   s.clock.Now(),
+		Ticket: proto.Clone(ticket).(*train.Ticket),
+		Notice: proto.Clone(notice).(*train.DisruptionNotice),
+	})
+}
+
+func (s *TrainService) publish(e Event) {
+	select {
+	case s.events <- e:
+	default:
+		log.Printf("event queue full, dropping %s for %s", e.Type, e.Ticket.Reference)
+	}
+}
+
+// dispatch hands queued events to every listener in order.
+func (s *TrainService) dispatch() {
+	for e := range s.events {
+		for _, listener := range s.listeners {
+			listener(e)
+		}
+	}
+}
@@ -0,0 +1,249 @@
+package reservation
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
+	train "github.com/bijoyv/train/pkg/proto"
+)
+
+// FareQuery is what a fare is priced on. Sold and Capacity describe the
+// occupancy of the journey segment at the time of pricing, Return is set
+// for the legs of a round trip.
+type FareQuery struct {
+	From      string
+	To        string
+	Class     train.SeatClass
+	Passenger train.PassengerType
+	Sold      int
+	Capacity  int
+	Return    bool
+}
+
+// FareComponent is one line of a fare breakdown, discounts are negative.
+type FareComponent struct {
+	Description string
+	Amount      int64
+}
+
+// Fare is a priced fare in the minor units of Currency. NonRefundable fares
+// get nothing back when cancelled.
+type Fare struct {
+	Currency      string
+	Components    []FareComponent
+	NonRefundable bool
+}
+
+// Total sums the components of the fare.
+func (f *Fare) Total() int64 {
+	var total int64
+	for _, c := range f.Components {
+		total += c.Amount
+	}
+	return total
+}
+
+func (f *Fare) money(amount int64) *train.Money {
+	return &train.Money{Currency: f.Currency, MinorUnits: amount}
+}
+
+// Price returns the total of the fare as Money.
+func (f *Fare) Price() *train.Money {
+	return f.money(f.Total())
+}
+
+// Breakdown returns the fare components as proto messages.
+func (f *Fare) Breakdown() []*train.FareComponent {
+	breakdown := make([]*train.FareComponent, 0, len(f.Components))
+	for _, c := range f.Components {
+		breakdown = append(breakdown, &train.FareComponent{Description: c.Description, Amount: f.money(c.Amount)})
+	}
+	return breakdown
+}
+
+// FarePolicy prices a trip.
+type FarePolicy interface {
+	Price(q FareQuery) (*Fare, error)
+}
+
+// RouteFare is the base fare between two stations, it applies both ways.
+type RouteFare struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+	Base int64  `json:"base"`
+}
+
+// TableFarePolicy prices from a table of route base fares, adjusted by a
+// percentage supplement per seat class and a percentage discount per
+// passenger type. Classes and passenger types are keyed by lower case
+// name, "first" or "child". Fares in the NonRefundable classes cannot be
+// refunded. Each leg of a round trip is ReturnDiscount percent cheaper.
+type TableFarePolicy struct {
+	Currency           string           `json:"currency"`
+	DefaultBase        int64            `json:"defaultBase"`
+	Routes             []RouteFare      `json:"routes"`
+	ClassSupplements   map[string]int64 `json:"classSupplements"`
+	PassengerDiscounts map[string]int64 `json:"passengerDiscounts"`
+	NonRefundable      []string         `json:"nonRefundable"`
+	ReturnDiscount     int64            `json:"returnDiscount"`
+}
+
+// DefaultFarePolicy charges the same 20.00 GBP for every trip, 10% less
+// for each leg of a round trip.
+func DefaultFarePolicy() *TableFarePolicy {
+	return &TableFarePolicy{Currency: "GBP", DefaultBase: 2000, ReturnDiscount: 10}
+}
+
+// fareConfig is the file format of LoadFarePolicy.
+type fareConfig struct {
+	TableFarePolicy
+	LoadFactorBuckets []FareBucket `json:"loadFactorBuckets"`
+}
+
+// LoadFarePolicy reads a TableFarePolicy from a JSON file, when the file
+// lists loadFactorBuckets the table is wrapped in a DynamicFarePolicy.
+func LoadFarePolicy(path string) (FarePolicy, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read fare table: %w", err)
+	}
+	config := &fareConfig{}
+	if err := json.Unmarshal(data, config); err != nil {
+		return nil, fmt.Errorf("parse fare table %s: %w", path, err)
+	}
+	if config.Currency == "" {
+		return nil, fmt.Errorf("fare table %s has no currency", path)
+	}
+	table := config.TableFarePolicy
+	if len(config.LoadFactorBuckets) == 0 {
+		return &table, nil
+	}
+	return NewDynamicFarePolicy(&table, config.LoadFactorBuckets), nil
+}
+
+func (p *TableFarePolicy) Price(q FareQuery) (*Fare, error) {
+	base, ok := p.base(q.From, q.To)
+	if !ok {
+		return nil, fmt.Errorf("no fare from %s to %s", q.From, q.To)
+	}
+	class := enumName(q.Class.String(), "SEAT_CLASS_")
+	fare := &Fare{Currency: p.Currency, NonRefundable: slices.Contains(p.NonRefundable, class)}
+	fare.Components = append(fare.Components, FareComponent{
+		Description: fmt.Sprintf("Base fare %s to %s", q.From, q.To),
+		Amount:      base,
+	})
+	amount := base
+	if pct := p.ClassSupplements[class]; pct != 0 {
+		supplement := amount * pct / 100
+		fare.Components = append(fare.Components, FareComponent{
+			Description: fmt.Sprintf("%s class supplement", class),
+			Amount:      supplement,
+		})
+		amount += supplement
+	}
+	passenger := enumName(q.Passenger.String(), "PASSENGER_TYPE_")
+	if pct := p.PassengerDiscounts[passenger]; pct != 0 {
+		discount := amount * pct / 100
+		fare.Components = append(fare.Components, FareComponent{
+			Description: fmt.Sprintf("%s discount", passenger),
+			Amount:      -discount,
+		})
+		amount -= discount
+	}
+	if q.Return && p.ReturnDiscount != 0 {
+		fare.Components = append(fare.Components, FareComponent{
+			Description: "return discount",
+			Amount:      -amount * p.ReturnDiscount / 100,
+		})
+	}
+	return fare, nil
+}
+
+func (p *TableFarePolicy) base(from, to string) (int64, bool) {
+	for _, r := range p.Routes {
+		if (r.From == from && r.To == to) || (r.From == to && r.To == from) {
+			return r.Base, true
+		}
+	}
+	return p.DefaultBase, p.DefaultBase > 0
+}
+
+// enumName turns SEAT_CLASS_FIRST into first.
+func enumName(name, prefix string) string {
+	return strings.ToLower(strings.TrimPrefix(name, prefix))
+}
+
+// seatClass defaults an unspecified class to standard.
+func seatClass(class train.SeatClass) train.SeatClass {
+	if class == train.SeatClass_SEAT_CLASS_UNSPECIFIED {
+		return train.SeatClass_SEAT_CLASS_STANDARD
+	}
+	return class
+}
+
+// passengerType defaults an unspecified passenger to an adult.
+func passengerType(passenger train.PassengerType) train.PassengerType {
+	if passenger == train.PassengerType_PASSENGER_TYPE_UNSPECIFIED {
+		return train.PassengerType_PASSENGER_TYPE_ADULT
+	}
+	return passenger
+}
+
+// priceTicket prices a ticket on the legs [start, end) of a journey, it
+// must run on the actor so the occupancy it sees is current.
+func (s *TrainService) priceTicket(j *journey, start, end int, class train.SeatClass, passenger train.PassengerType) (*Fare, error) {
+	return s.priceLeg(j, start, end, class, passenger, false)
+}
+
+// priceLeg is priceTicket for a ticket that may be one leg of a round trip.
+func (s *TrainService) priceLeg(j *journey, start, end int, class train.SeatClass, passenger train.PassengerType, roundTrip bool) (*Fare, error) {
+	sold, capacity := j.load(start, end, seatClass(class))
+	return s.fares.Price(FareQuery{
+		From:      j.info.Stops[start].Station,
+		To:        j.info.Stops[end].Station,
+		Class:     seatClass(class),
+		Passenger: passengerType(passenger),
+		Sold:      sold,
+		Capacity:  capacity,
+		Return:    roundTrip,
+	})
+}
+
+// QuoteFare prices a trip without booking it.
+func (s *TrainService) QuoteFare(ctx context.Context, req *train.QuoteFareRequest) (*train.QuoteFareResponse, error) {
+	from, to, err := s.route(req.From, req.To)
+	if err != nil {
+		return nil, err
+	}
+	er := make(chan error, 1)
+	result := make(chan *Fare, 1)
+
+	s.ops <- func(st *state) {
+		j, err := st.journey(req.JourneyId)
+		if err != nil {
+			er <- err
+			return
+		}
+		start, end, err := j.segment(from, to)
+		if err != nil {
+			er <- err
+			return
+		}
+		fare, err := s.priceTicket(j, start, end, req.SeatClass, req.PassengerType)
+		if err != nil {
+			er <- err
+			return
+		}
+		result <- fare
+	}
+	select {
+	case e := <-er:
+		return nil, e
+	case fare := <-result:
+		return &train.QuoteFareResponse{Total: fare.Price(), Breakdown: fare.Breakdown()}, nil
+	}
+}
@@ -0,0 +1,234 @@
+package reservation
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	train "github.com/bijoyv/train/pkg/proto"
+)
+
+func TestTableFarePolicy(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "fares.json")
+	data := `{
+		"currency": "EUR",
+		"routes": [{"from": "London", "to": "Paris", "base": 8000}],
+		"classSupplements": {"first": 50},
+		"passengerDiscounts": {"child": 50},
+		"nonRefundable": ["standard"],
+		"returnDiscount": 20
+	}`
+	if err := os.WriteFile(path, []byte(data), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	policy, err := LoadFarePolicy(path)
+	if err != nil {
+		t.Fatalf("LoadFarePolicy failed: %v", err)
+	}
+
+	tests := []struct {
+		name      string
+		query     FareQuery
+		want      int64
+		wantParts int
+	}{
+		{"standard adult", FareQuery{From: "London", To: "Paris", Class: train.SeatClass_SEAT_CLASS_STANDARD, Passenger: train.PassengerType_PASSENGER_TYPE_ADULT}, 8000, 1},
+		{"reverse route", FareQuery{From: "Paris", To: "London", Class: train.SeatClass_SEAT_CLASS_STANDARD, Passenger: train.PassengerType_PASSENGER_TYPE_ADULT}, 8000, 1},
+		{"first adult", FareQuery{From: "London", To: "Paris", Class: train.SeatClass_SEAT_CLASS_FIRST, Passenger: train.PassengerType_PASSENGER_TYPE_ADULT}, 12000, 2},
+		{"first child", FareQuery{From: "London", To: "Paris", Class: train.SeatClass_SEAT_CLASS_FIRST, Passenger: train.PassengerType_PASSENGER_TYPE_CHILD}, 6000, 3},
+		{"first child return", FareQuery{From: "London", To: "Paris", Class: train.SeatClass_SEAT_CLASS_FIRST, Passenger: train.PassengerType_PASSENGER_TYPE_CHILD, Return: true}, 4800, 4},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fare, err := policy.Price(tt.query)
+			if err != nil {
+				t.Fatalf("Price failed: %v", err)
+			}
+			if fare.Total() != tt.want {
+				t.Errorf("Expected total %d, got %d", tt.want, fare.Total())
+			}
+			if len(fare.Components) != tt.wantParts {
+				t.Errorf("Expected %d components, got %d", tt.wantParts, len(fare.Components))
+			}
+			if want := tt.query.Class == train.SeatClass_SEAT_CLASS_STANDARD; fare.NonRefundable != want {
+				t.Errorf("Expected non-refundable %v, got %v", want, fare.NonRefundable)
+			}
+		})
+	}
+
+	if _, err := policy.Price(FareQuery{From: "London", To: "Brussels"}); err == nil {
+		t.Error("Expected error for a route without a fare, got nil")
+	}
+}
+
+func TestQuoteFare(t *testing.T) {
+	trainService := NewTrainReservationService()
+	quote, err := trainService.QuoteFare(context.Background(), &train.QuoteFareRequest{From: "London", To: "Paris"})
+	if err != nil {
+		t.Fatalf("QuoteFare failed: %v", err)
+	}
+	if quote.Total.Currency != "GBP" || quote.Total.MinorUnits != 2000 {
+		t.Errorf("Expected 2000 GBP, got %v", quote.Total)
+	}
+
+	res, err := trainService.PurchaseTicket(context.Background(), &train.PurchaseTicketRequest{
+		From: "London",
+		To:   "Paris",
+		User: &train.User{Email: "kate.lee@example.com"},
+	})
+	if err != nil {
+		t.Fatalf("PurchaseTicket failed: %v", err)
+	}
+	if res.Ticket.Price.MinorUnits != quote.Total.MinorUnits {
+		t.Errorf("Expected ticket price %d, got %d", quote.Total.MinorUnits, res.Ticket.Price.MinorUnits)
+	}
+}
+
+func TestDynamicFarePolicy(t *testing.T) {
+	policy := NewDynamicFarePolicy(DefaultFarePolicy(), []FareBucket{
+		{UpTo: 100, Markup: 60},
+		{UpTo: 50, Markup: 0},
+		{UpTo: 80, Markup: 25},
+	})
+	tests := []struct {
+		sold int
+		want int64
+	}{
+		{0, 2000},
+		{4, 2000},
+		{5, 2500},
+		{7, 2500},
+		{8, 3200},
+		{9, 3200},
+		{10, 3200},
+	}
+	for _, tt := range tests {
+		fare, err := policy.Price(FareQuery{From: "London", To: "Paris", Sold: tt.sold, Capacity: 10})
+		if err != nil {
+			t.Fatalf("Price with %d sold failed: %v", tt.sold, err)
+		}
+		if fare.Total() != tt.want {
+			t.Errorf("Expected %d with %d of 10 sold, got %d", tt.want, tt.sold, fare.Total())
+		}
+	}
+
+	trainService := NewTrainReservationService(
+		WithFarePolicy(policy),
+		WithSeatLayout(&train.SeatLayout{Coaches: []*train.Coach{{Code: "A", Rows: 1, Columns: "ABCD"}}}),
+	)
+	var prices []int64
+	for _, email := range []string{"liam.ng@example.com", "mia.ng@example.com", "noah.ng@example.com", "olivia.ng@example.com"} {
+		res, err := trainService.PurchaseTicket(context.Background(), &train.PurchaseTicketRequest{
+			From: "London",
+			To:   "Paris",
+			User: &train.User{Email: email},
+		})
+		if err != nil {
+			t.Fatalf("PurchaseTicket failed: %v", err)
+		}
+		prices = append(prices, res.Ticket.Price.MinorUnits)
+	}
+	want := []int64{2000, 2000, 2500, 2500}
+	for i := range want {
+		if prices[i] != want[i] {
+			t.Errorf("Expected prices %v, got %v", want, prices)
+			break
+		}
+	}
+
+	// a full train is still priced, so it can be quoted and waitlisted
+	quote, err := trainService.QuoteFare(context.Background(), &train.QuoteFareRequest{From: "London", To: "Paris"})
+	if err != nil {
+		t.Fatalf("QuoteFare on a full train failed: %v", err)
+	}
+	if quote.Total.MinorUnits != 3200 {
+		t.Errorf("Expected 3200 on a full train, got %d", quote.Total.MinorUnits)
+	}
+	res, err := trainService.PurchaseTicket(context.Background(), &train.PurchaseTicketRequest{From: "London", To: "Paris", User: &train.User{Email: "pia.ng@example.com"}, JoinWaitlist: true})
+	if err != nil {
+		t.Fatalf("PurchaseTicket with JoinWaitlist on a full train failed: %v", err)
+	}
+	if res.Waitlist == nil || res.Waitlist.Position != 1 {
+		t.Errorf("Expected first place on the waitlist, got %v", res.Waitlist)
+	}
+}
+
+// gatedAuthorize holds the authorization of one payer until released, so
+// other bookings can go through meanwhile.
+type gatedAuthorize struct {
+	*FakePaymentProvider
+	payer      string
+	authorized chan struct{}
+	release    chan struct{}
+}
+
+func (p gatedAuthorize) Authorize(ctx context.Context, payer *train.Purchaser, amount *train.Money) (string, error) {
+	id, err := p.FakePaymentProvider.Authorize(ctx, payer, amount)
+	if payer.Email == p.payer {
+		p.authorized <- struct{}{}
+		<-p.release
+	}
+	return id, err
+}
+
+func TestPriceChangedWhileAuthorizing(t *testing.T) {
+	payments := gatedAuthorize{NewFakePaymentProvider(), "ria.ng@example.com", make(chan struct{}, 1), make(chan struct{})}
+	trainService := NewTrainReservationService(
+		WithPaymentProvider(payments),
+		WithFarePolicy(NewDynamicFarePolicy(DefaultFarePolicy(), []FareBucket{{UpTo: 50, Markup: 0}, {UpTo: 100, Markup: 100}})),
+		WithSeatLayout(&train.SeatLayout{Coaches: []*train.Coach{{Code: "A", Rows: 1, Columns: "AB"}}}),
+	)
+	ctx := context.Background()
+	purchase := func(email string) (*train.PurchaseTicketResponse, error) {
+		return trainService.PurchaseTicket(ctx, &train.PurchaseTicketRequest{From: "London", To: "Paris", User: &train.User{Email: email}})
+	}
+	done := make(chan error, 1)
+	go func() {
+		_, err := purchase("ria.ng@example.com")
+		done <- err
+	}()
+	// ria is quoted 2000 on an empty train, sam books while her payment is
+	// authorized and fills half of it
+	<-payments.authorized
+	if _, err := purchase("sam.ng@example.com"); err != nil {
+		t.Fatalf("PurchaseTicket failed: %v", err)
+	}
+	close(payments.release)
+	if err := <-done; err == nil || !strings.Contains(err.Error(), "price changed") {
+		t.Fatalf("Expected the purchase to fail as the price changed, got %v", err)
+	}
+	if payment, _ := payments.Payment("PAY000001"); payment.Status != train.PaymentStatus_PAYMENT_STATUS_VOIDED {
+		t.Errorf("Expected the stale authorization voided, got %s", payment.Status)
+	}
+	// bought again at the new price
+	res, err := purchase("ria.ng@example.com")
+	if err != nil {
+		t.Fatalf("PurchaseTicket failed: %v", err)
+	}
+	if res.Ticket.Price.MinorUnits != 4000 {
+		t.Errorf("Expected 4000 once half the train is sold, got %d", res.Ticket.Price.MinorUnits)
+	}
+}
+
+func TestDynamicFareIgnoresBlockedSeats(t *testing.T) {
+	trainService := NewTrainReservationService(
+		WithFarePolicy(NewDynamicFarePolicy(DefaultFarePolicy(), []FareBucket{{UpTo: 50, Markup: 0}, {UpTo: 100, Markup: 100}})),
+		WithSeatLayout(&train.SeatLayout{Coaches: []*train.Coach{{Code: "A", Rows: 1, Columns: "ABCD"}}}),
+	)
+	ctx := context.Background()
+	for _, seat := range []string{"A1", "A2"} {
+		if _, err := trainService.BlockSeat(ctx, &train.BlockSeatRequest{Seat: seat, Reason: "broken recliner"}); err != nil {
+			t.Fatalf("BlockSeat failed: %v", err)
+		}
+	}
+	// nothing is sold on the two seats left in service
+	quote, err := trainService.QuoteFare(ctx, &train.QuoteFareRequest{From: "London", To: "Paris"})
+	if err != nil {
+		t.Fatalf("QuoteFare failed: %v", err)
+	}
+	if quote.Total.MinorUnits != 2000 {
+		t.Errorf("Expected 2000 with blocked seats left out of the load, got %d", quote.Total.MinorUnits)
+	}
+}
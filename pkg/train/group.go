@@ -0,0 +1,207 @@
+package reservation
+
+import (
+	"context"
+	"fmt"
+
+	train "github.com/bijoyv/train/pkg/proto"
+)
+
+// groupSeats picks n seats free on [start, end). It looks for n adjacent
+// seats in one row first, then n consecutive seats in one coach running
+// over into the next rows, and only then applies the fallback.
+func groupSeats(seats []*seat, start, end, n int, fallback train.GroupSeating) ([]*seat, error) {
+	sameRow := func(a, b *seat) bool { return a.coach == b.coach && a.row == b.row }
+	sameCoach := func(a, b *seat) bool { return a.coach == b.coach }
+
+	if run := freeRun(seats, start, end, n, sameRow); run != nil {
+		return run, nil
+	}
+	if run := freeRun(seats, start, end, n, sameCoach); run != nil {
+		return run, nil
+	}
+
+	switch fallback {
+	case train.GroupSeating_GROUP_SEATING_ADJACENT_ONLY:
+		return nil, fmt.Errorf("no %d adjacent seats available", n)
+	case train.GroupSeating_GROUP_SEATING_SAME_COACH:
+		byCoach := make(map[string][]*seat)
+		for _, seat := range seats {
+			if !seat.free(start, end) {
+				continue
+			}
+			byCoach[seat.coach] = append(byCoach[seat.coach], seat)
+			if len(byCoach[seat.coach]) == n {
+				return byCoach[seat.coach], nil
+			}
+		}
+		return nil, fmt.Errorf("no coach has %d seats available", n)
+	default:
+		var picked []*seat
+		for _, seat := range seats {
+			if seat.free(start, end) {
+				picked = append(picked, seat)
+				if len(picked) == n {
+					return picked, nil
+				}
+			}
+		}
+		return nil, fmt.Errorf("only %d seats available for a group of %d", len(picked), n)
+	}
+}
+
+// freeRun finds n free seats next to each other in layout order where every
+// seat is together with the first one.
+func freeRun(seats []*seat, start, end, n int, together func(a, b *seat) bool) []*seat {
+	var run []*seat
+	for _, seat := range seats {
+		if !seat.free(start, end) || (len(run) > 0 && !together(run[0], seat)) {
+			run = run[:0]
+		}
+		if seat.free(start, end) {
+			run = append(run, seat)
+		}
+		if len(run) == n {
+			return run
+		}
+	}
+	return nil
+}
+
+// groupPassengerType is the type of the i-th passenger of a group.
+func groupPassengerType(req *train.PurchaseGroupRequest, i int) train.PassengerType {
+	if i < len(req.PassengerTypes) {
+		return req.PassengerTypes[i]
+	}
+	return train.PassengerType_PASSENGER_TYPE_UNSPECIFIED
+}
+
+// quoteGroup prices the ticket of every passenger of a group.
+func (s *TrainService) quoteGroup(req *train.PurchaseGroupRequest, from, to string) ([]*Fare, error) {
+	er := make(chan error, 1)
+	result := make(chan []*Fare, 1)
+
+	s.ops <- func(st *state) {
+		j, err := st.openJourney(req.JourneyId)
+		if err != nil {
+			er <- err
+			return
+		}
+		start, end, err := j.segment(from, to)
+		if err != nil {
+			er <- err
+			return
+		}
+		var fares []*Fare
+		for i := range req.Passengers {
+			fare, err := s.priceTicket(j, start, end, req.SeatClass, groupPassengerType(req, i))
+			if err != nil {
+				er <- err
+				return
+			}
+			fares = append(fares, fare)
+		}
+		result <- fares
+	}
+	select {
+	case e := <-er:
+		return nil, e
+	case fares := <-result:
+		return fares, nil
+	}
+}
+
+// PurchaseGroup books seats for every passenger in a single actor operation,
+// either all passengers get a ticket or none does. The group is paid with
+// one payment by the purchaser, or the first passenger without one.
+func (s *TrainService) PurchaseGroup(ctx context.Context, req *train.PurchaseGroupRequest) (*train.PurchaseGroupResponse, error) {
+	if len(req.Passengers) == 0 {
+		return nil, fmt.Errorf("group requires at least one passenger")
+	}
+	if err := validBooking(req.Purchaser, req.Passengers...); err != nil {
+		return nil, err
+	}
+	if len(req.PassengerTypes) > len(req.Passengers) {
+		return nil, fmt.Errorf("%d passenger types for %d passengers", len(req.PassengerTypes), len(req.Passengers))
+	}
+	from, to, err := s.route(req.From, req.To)
+	if err != nil {
+		return nil, err
+	}
+	fallback := req.Fallback
+	if fallback == train.GroupSeating_GROUP_SEATING_UNSPECIFIED {
+		fallback = s.group
+	}
+	fares, err := s.quoteGroup(req, from, to)
+	if err != nil {
+		return nil, err
+	}
+	total := int64(0)
+	for _, fare := range fares {
+		total += fare.Total()
+	}
+	payment, err := s.payments.Authorize(ctx, purchaserFor(req.Purchaser, req.Passengers[0]), fares[0].money(total))
+	if err != nil {
+		return nil, err
+	}
+	er := make(chan error, 1)
+	result := make(chan *train.PurchaseGroupResponse, 1)
+
+	s.ops <- func(st *state) {
+		j, err := st.openJourney(req.JourneyId)
+		if err != nil {
+			er <- err
+			return
+		}
+		start, end, err := j.segment(from, to)
+		if err != nil {
+			er <- err
+			return
+		}
+		for i := range req.Passengers {
+			current, err := s.priceTicket(j, start, end, req.SeatClass, groupPassengerType(req, i))
+			if err == nil {
+				err = priceChanged(fares[i], current)
+			}
+			if err != nil {
+				er <- err
+				return
+			}
+		}
+		seats, err := groupSeats(j.classSeats(seatClass(req.SeatClass)), start, end, len(req.Passengers), fallback)
+		if err != nil {
+			er <- err
+			return
+		}
+
+		res := &train.PurchaseGroupResponse{GroupReference: st.newReference()}
+		for i, p := range req.Passengers {
+			ticket, err := s.issue(st, sale{j: j, start: start, end: end, user: p, purchaser: req.Purchaser, class: req.SeatClass, passenger: groupPassengerType(req, i), fare: fares[i], payment: payment}, seats[i])
+			if err != nil {
+				for _, t := range res.Tickets {
+					st.drop(t)
+				}
+				er <- err
+				return
+			}
+			ticket.GroupReference = res.GroupReference
+			res.Tickets = append(res.Tickets, ticket)
+		}
+		res.Tickets = snapshots(res.Tickets)
+		result <- res
+	}
+	var res *train.PurchaseGroupResponse
+	select {
+	case e := <-er:
+		s.void(ctx, payment)
+		return nil, e
+	case res = <-result:
+	}
+	if res.Tickets, err = s.settleAll(ctx, payment, res.Tickets); err != nil {
+		return nil, err
+	}
+	for _, t := range res.Tickets {
+		s.emit(EventTicketPurchased, t)
+	}
+	return res, nil
+}
@@ -0,0 +1,168 @@
+package reservation
+
+import (
+	"context"
+	"testing"
+
+	train "github.com/bijoyv/train/pkg/proto"
+)
+
+func TestGroupSeats(t *testing.T) {
+	layout := &train.SeatLayout{Coaches: []*train.Coach{
+		{Code: "A", Rows: 2, Columns: "ABCD"},
+		{Code: "B", Rows: 1, Columns: "ABCD"},
+	}}
+	newSeats := func(taken ...string) []*seat {
+		j := &journey{info: &train.Journey{Stops: []*train.Stop{{Station: "London"}, {Station: "Paris"}}}}
+		seats, err := buildSeats(layout)
+		if err != nil {
+			t.Fatal(err)
+		}
+		j.setSeats(seats)
+		for _, id := range taken {
+			j.seats[id].occupy(0, 1, "X")
+		}
+		return j.ordered()
+	}
+	ids := func(seats []*seat) []string {
+		var ids []string
+		for _, s := range seats {
+			ids = append(ids, s.id)
+		}
+		return ids
+	}
+
+	tests := []struct {
+		name     string
+		taken    []string
+		n        int
+		fallback train.GroupSeating
+		want     []string
+	}{
+		{"same row", []string{"A1"}, 3, train.GroupSeating_GROUP_SEATING_ANYWHERE, []string{"A2", "A3", "A4"}},
+		{"next row", []string{"A2", "A6"}, 3, train.GroupSeating_GROUP_SEATING_ANYWHERE, []string{"B1", "B2", "B3"}},
+		{"across rows", []string{"A1", "B1"}, 5, train.GroupSeating_GROUP_SEATING_ANYWHERE, []string{"A2", "A3", "A4", "A5", "A6"}},
+		{"same coach", []string{"A2", "A6", "B2"}, 5, train.GroupSeating_GROUP_SEATING_SAME_COACH, []string{"A1", "A3", "A4", "A5", "A7"}},
+		{"anywhere", []string{"A2", "A4", "A6", "A8", "B2", "B4"}, 5, train.GroupSeating_GROUP_SEATING_ANYWHERE, []string{"A1", "A3", "A5", "A7", "B1"}},
+		{"adjacent only", []string{"A2", "A4", "A6", "A8", "B2"}, 3, train.GroupSeating_GROUP_SEATING_ADJACENT_ONLY, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := groupSeats(newSeats(tt.taken...), 0, 1, tt.n, tt.fallback)
+			if tt.want == nil {
+				if err == nil {
+					t.Fatalf("Expected error, got %v", ids(got))
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("groupSeats failed: %v", err)
+			}
+			if g, w := ids(got), tt.want; len(g) != len(w) || g[0] != w[0] || g[len(g)-1] != w[len(w)-1] {
+				t.Errorf("Expected %v, got %v", w, g)
+			}
+		})
+	}
+}
+
+func TestPurchaseGroup(t *testing.T) {
+	payments := NewFakePaymentProvider()
+	trainService := NewTrainReservationService(
+		WithPaymentProvider(payments),
+		WithSeatLayout(&train.SeatLayout{Coaches: []*train.Coach{{Code: "A", Rows: 2, Columns: "ABCD"}}}),
+	)
+	passengers := func(names ...string) []*train.User {
+		var users []*train.User
+		for _, n := range names {
+			users = append(users, &train.User{FirstName: n, Email: n + "@example.com"})
+		}
+		return users
+	}
+
+	res, err := trainService.PurchaseGroup(context.Background(), &train.PurchaseGroupRequest{
+		From:       "London",
+		To:         "Paris",
+		Passengers: passengers("pam", "quinn", "rosa"),
+	})
+	if err != nil {
+		t.Fatalf("PurchaseGroup failed: %v", err)
+	}
+	if len(res.Tickets) != 3 {
+		t.Fatalf("Expected 3 tickets, got %d", len(res.Tickets))
+	}
+	for i, want := range []string{"A1", "A2", "A3"} {
+		if res.Tickets[i].Seat != want || res.Tickets[i].GroupReference != res.GroupReference {
+			t.Errorf("Expected ticket %d in seat %s of group %s, got %s of %s", i, want, res.GroupReference, res.Tickets[i].Seat, res.Tickets[i].GroupReference)
+		}
+		if res.Tickets[i].PaymentId != "PAY000001" || res.Tickets[i].PaymentStatus != train.PaymentStatus_PAYMENT_STATUS_CAPTURED {
+			t.Errorf("Expected ticket %d paid on PAY000001, got %s %s", i, res.Tickets[i].PaymentId, res.Tickets[i].PaymentStatus)
+		}
+	}
+	// three fares of 20.00, paid by the first passenger
+	if payment, _ := payments.Payment("PAY000001"); payment.Status != train.PaymentStatus_PAYMENT_STATUS_CAPTURED || payment.Amount.MinorUnits != 6000 || payment.Email != "pam@example.com" {
+		t.Errorf("Expected 6000 captured from pam for the group, got %v", payment)
+	}
+
+	_, err = trainService.PurchaseGroup(context.Background(), &train.PurchaseGroupRequest{
+		From:       "London",
+		To:         "Paris",
+		Passengers: passengers("sam", "tess", "uma", "vic", "walt", "xena"),
+	})
+	if err == nil {
+		t.Fatal("Expected error for a group larger than the seats left, got nil")
+	}
+	list, err := trainService.ListTicketsByUser(context.Background(), &train.ListTicketsByUserRequest{Email: "sam@example.com"})
+	if err != nil {
+		t.Fatalf("ListTicketsByUser failed: %v", err)
+	}
+	if len(list.Tickets) != 0 {
+		t.Errorf("Expected a failed group to book nothing, got %d tickets", len(list.Tickets))
+	}
+	if payment, _ := payments.Payment("PAY000002"); payment.Status != train.PaymentStatus_PAYMENT_STATUS_VOIDED {
+		t.Errorf("Expected the payment of a failed group voided, got %s", payment.Status)
+	}
+}
+
+func TestPurchaseGroupPassengerTypes(t *testing.T) {
+	payments := NewFakePaymentProvider()
+	fares := DefaultFarePolicy()
+	fares.PassengerDiscounts = map[string]int64{"child": 50}
+	trainService := NewTrainReservationService(WithPaymentProvider(payments), WithFarePolicy(fares))
+	ctx := context.Background()
+	res, err := trainService.PurchaseGroup(ctx, &train.PurchaseGroupRequest{
+		From:           "London",
+		To:             "Paris",
+		Purchaser:      &train.Purchaser{Email: "yan.cole@example.com"},
+		Passengers:     []*train.User{{FirstName: "Yan"}, {FirstName: "Zoe"}, {FirstName: "Abe"}},
+		PassengerTypes: []train.PassengerType{train.PassengerType_PASSENGER_TYPE_ADULT, train.PassengerType_PASSENGER_TYPE_CHILD},
+	})
+	if err != nil {
+		t.Fatalf("PurchaseGroup failed: %v", err)
+	}
+	// the third passenger has no type and travels as an adult
+	for i, want := range []struct {
+		passenger train.PassengerType
+		price     int64
+	}{
+		{train.PassengerType_PASSENGER_TYPE_ADULT, 2000},
+		{train.PassengerType_PASSENGER_TYPE_CHILD, 1000},
+		{train.PassengerType_PASSENGER_TYPE_ADULT, 2000},
+	} {
+		if got := res.Tickets[i]; got.PassengerType != want.passenger || got.Price.MinorUnits != want.price {
+			t.Errorf("Expected ticket %d for a %s at %d, got %s at %d", i, want.passenger, want.price, got.PassengerType, got.Price.MinorUnits)
+		}
+	}
+	if payment, _ := payments.Payment(res.Tickets[0].PaymentId); payment.Amount.MinorUnits != 5000 {
+		t.Errorf("Expected 5000 for the group, got %d", payment.Amount.MinorUnits)
+	}
+
+	_, err = trainService.PurchaseGroup(ctx, &train.PurchaseGroupRequest{
+		From:           "London",
+		To:             "Paris",
+		Passengers:     []*train.User{{Email: "bea.cole@example.com"}},
+		PassengerTypes: []train.PassengerType{train.PassengerType_PASSENGER_TYPE_ADULT, train.PassengerType_PASSENGER_TYPE_CHILD},
+	})
+	if err == nil {
+		t.Error("Expected more passenger types than passengers to fail")
+	}
+}
@@ -0,0 +1,223 @@
+package reservation
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	train "github.com/bijoyv/train/pkg/proto"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	defaultHoldTTL = 10 * time.Minute
+
+	// holdSweepInterval is how often an idle service returns expired holds
+	// to inventory, busy services expire them before every op.
+	holdSweepInterval = time.Second
+)
+
+// heldOccupant is what GetSeatsBySection shows for a held seat.
+const heldOccupant = "held"
+
+// hold keeps a seat on [start, end) for a customer in checkout, the seat's
+// legs are occupied by the hold's id until it is confirmed or expires.
+type hold struct {
+	info       *train.Hold
+	start, end int
+	class      train.SeatClass
+	passenger  train.PassengerType
+	fare       *Fare
+	expires    time.Time
+}
+
+// expireHolds returns every hold past its TTL to inventory.
+func (s *TrainService) expireHolds(st *state) {
+	now := s.clock.Now()
+	for id, h := range st.holds {
+		if now.Before(h.expires) {
+			continue
+		}
+		st.releaseHold(h)
+		delete(st.holds, id)
+		if j, ok := st.journeys[h.info.JourneyId]; ok {
+			s.seatsFreed(st, j)
+		}
+	}
+}
+
+func (st *state) releaseHold(h *hold) {
+	if j, ok := st.journeys[h.info.JourneyId]; ok {
+		if seat, ok := j.seats[h.info.Seat]; ok {
+			seat.release(h.info.Id)
+		}
+	}
+}
+
+// occupantName shows a seat occupant as the passenger's email, or name when
+// the passenger has no email, or as held.
+func (st *state) occupantName(ref string) string {
+	if _, held := st.holds[ref]; held {
+		return heldOccupant
+	}
+	if ticket, ok := st.tickets[ref]; ok {
+		if ticket.User.Email == "" {
+			return passengerName(ticket.User)
+		}
+		return ticket.User.Email
+	}
+	return ref
+}
+
+// HoldSeat takes a seat out of sale for the hold TTL and locks its price.
+func (s *TrainService) HoldSeat(ctx context.Context, req *train.HoldSeatRequest) (*train.HoldSeatResponse, error) {
+	if err := validBooking(req.Purchaser, req.User); err != nil {
+		return nil, err
+	}
+	from, to, err := s.route(req.From, req.To)
+	if err != nil {
+		return nil, err
+	}
+	er := make(chan error, 1)
+	result := make(chan *train.HoldSeatResponse, 1)
+
+	s.ops <- func(st *state) {
+		j, err := st.openJourney(req.JourneyId)
+		if err != nil {
+			er <- err
+			return
+		}
+		start, end, err := j.segment(from, to)
+		if err != nil {
+			er <- err
+			return
+		}
+		seat, notes, err := s.assignSeat(j, start, end, req.SeatClass, req.Preferences)
+		if err != nil {
+			er <- err
+			return
+		}
+		fare, err := s.priceTicket(j, start, end, req.SeatClass, req.PassengerType)
+		if err != nil {
+			er <- err
+			return
+		}
+		expires := s.clock.Now().Add(s.holdTTL)
+		h := &hold{
+			info: &train.Hold{
+				Id:        st.newReference(),
+				JourneyId: j.info.Id,
+				Seat:      seat.id,
+				From:      j.info.Stops[start].Station,
+				To:        j.info.Stops[end].Station,
+				User:      req.User,
+				ExpiresAt: timestamppb.New(expires),
+				Price:     fare.Price(),
+				Purchaser: purchaserFor(req.Purchaser, req.User),
+			},
+			start:     start,
+			end:       end,
+			class:     req.SeatClass,
+			passenger: req.PassengerType,
+			fare:      fare,
+			expires:   expires,
+		}
+		st.holds[h.info.Id] = h
+		seat.occupy(start, end, h.info.Id)
+		result <- &train.HoldSeatResponse{Hold: proto.Clone(h.info).(*train.Hold), AllocationNotes: notes}
+	}
+	select {
+	case e := <-er:
+		return nil, e
+	case res := <-result:
+		return res, nil
+	}
+}
+
+// ConfirmHold turns a live hold into a ticket at the price locked by the hold.
+func (s *TrainService) ConfirmHold(ctx context.Context, req *train.ConfirmHoldRequest) (*train.ConfirmHoldResponse, error) {
+	held := make(chan *train.Hold, 1)
+	s.ops <- func(st *state) {
+		if h, exists := st.holds[req.HoldId]; exists {
+			held <- proto.Clone(h.info).(*train.Hold)
+			return
+		}
+		held <- nil
+	}
+	info := <-held
+	if info == nil {
+		return nil, fmt.Errorf("hold %s not found or expired", req.HoldId)
+	}
+	payment, err := s.payments.Authorize(ctx, info.Purchaser, info.Price)
+	if err != nil {
+		return nil, err
+	}
+
+	er := make(chan error, 1)
+	result := make(chan *train.Ticket, 1)
+
+	s.ops <- func(st *state) {
+		h, exists := st.holds[req.HoldId]
+		if !exists {
+			er <- fmt.Errorf("hold %s not found or expired", req.HoldId)
+			return
+		}
+		j, err := st.journey(h.info.JourneyId)
+		if err != nil {
+			er <- err
+			return
+		}
+		// the hold locks its fare, the payment must be for that fare
+		if h.fare.Total() != info.Price.GetMinorUnits() {
+			er <- fmt.Errorf("the price changed to %d %s, please try again", h.fare.Total(), h.fare.Currency)
+			return
+		}
+		st.releaseHold(h)
+		delete(st.holds, h.info.Id)
+		ticket, err := s.issue(st, sale{j: j, start: h.start, end: h.end, user: h.info.User, purchaser: h.info.Purchaser, class: h.class, passenger: h.passenger, fare: h.fare, payment: payment}, j.seats[h.info.Seat])
+		if err != nil {
+			er <- err
+			return
+		}
+		result <- snapshot(ticket)
+	}
+	select {
+	case e := <-er:
+		s.void(ctx, payment)
+		return nil, e
+	case t := <-result:
+		t, err := s.settle(ctx, t)
+		if err != nil {
+			return nil, err
+		}
+		s.emit(EventTicketPurchased, t)
+		return &train.ConfirmHoldResponse{Ticket: t}, nil
+	}
+}
+
+// ReleaseHold gives a held seat back before its TTL runs out.
+func (s *TrainService) ReleaseHold(ctx context.Context, req *train.ReleaseHoldRequest) (*train.ReleaseHoldResponse, error) {
+	er := make(chan error, 1)
+	result := make(chan bool, 1)
+
+	s.ops <- func(st *state) {
+		h, exists := st.holds[req.HoldId]
+		if !exists {
+			er <- fmt.Errorf("hold %s not found or expired", req.HoldId)
+			return
+		}
+		st.releaseHold(h)
+		delete(st.holds, h.info.Id)
+		if j, ok := st.journeys[h.info.JourneyId]; ok {
+			s.seatsFreed(st, j)
+		}
+		result <- true
+	}
+	select {
+	case e := <-er:
+		return nil, e
+	case <-result:
+		return &train.ReleaseHoldResponse{Success: true}, nil
+	}
+}
@@ -0,0 +1,98 @@
+package reservation
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	train "github.com/bijoyv/train/pkg/proto"
+)
+
+// fakeClock is a Clock the test moves by hand.
+type fakeClock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+func newFakeClock() *fakeClock {
+	return &fakeClock{now: time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)}
+}
+
+func (c *fakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+func (c *fakeClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(d)
+}
+
+func TestSeatHolds(t *testing.T) {
+	clock := newFakeClock()
+	trainService := NewTrainReservationService(WithClock(clock), WithHoldTTL(5*time.Minute))
+	ctx := context.Background()
+
+	holdSeat := func(email string) *train.Hold {
+		t.Helper()
+		res, err := trainService.HoldSeat(ctx, &train.HoldSeatRequest{
+			From: "London",
+			To:   "Paris",
+			User: &train.User{Email: email},
+		})
+		if err != nil {
+			t.Fatalf("HoldSeat failed: %v", err)
+		}
+		return res.Hold
+	}
+	seatOccupant := func(seat string) string {
+		t.Helper()
+		res, err := trainService.GetSeatsBySection(ctx, &train.GetSeatsBySectionRequest{Section: string(seat[0])})
+		if err != nil {
+			t.Fatalf("GetSeatsBySection failed: %v", err)
+		}
+		return res.Seats[seat]
+	}
+
+	t.Run("ConfirmHold", func(t *testing.T) {
+		h := holdSeat("amy.fox@example.com")
+		if got := seatOccupant(h.Seat); got != heldOccupant {
+			t.Errorf("Expected seat %s to show as held, got %q", h.Seat, got)
+		}
+		clock.Advance(4 * time.Minute)
+		res, err := trainService.ConfirmHold(ctx, &train.ConfirmHoldRequest{HoldId: h.Id})
+		if err != nil {
+			t.Fatalf("ConfirmHold failed: %v", err)
+		}
+		if res.Ticket.Seat != h.Seat || res.Ticket.Price.MinorUnits != h.Price.MinorUnits {
+			t.Errorf("Expected ticket for seat %s at %d, got %s at %d", h.Seat, h.Price.MinorUnits, res.Ticket.Seat, res.Ticket.Price.MinorUnits)
+		}
+		if got := seatOccupant(h.Seat); got != "amy.fox@example.com" {
+			t.Errorf("Expected seat %s to be sold, got %q", h.Seat, got)
+		}
+	})
+
+	t.Run("HoldExpires", func(t *testing.T) {
+		h := holdSeat("ben.fox@example.com")
+		clock.Advance(5 * time.Minute)
+		if got := seatOccupant(h.Seat); got != "" {
+			t.Errorf("Expected expired hold on %s to be released, got %q", h.Seat, got)
+		}
+		if _, err := trainService.ConfirmHold(ctx, &train.ConfirmHoldRequest{HoldId: h.Id}); err == nil {
+			t.Error("Expected error confirming an expired hold, got nil")
+		}
+	})
+
+	t.Run("ReleaseHold", func(t *testing.T) {
+		h := holdSeat("cat.fox@example.com")
+		if _, err := trainService.ReleaseHold(ctx, &train.ReleaseHoldRequest{HoldId: h.Id}); err != nil {
+			t.Fatalf("ReleaseHold failed: %v", err)
+		}
+		if got := seatOccupant(h.Seat); got != "" {
+			t.Errorf("Expected released seat %s to be free, got %q", h.Seat, got)
+		}
+	})
+}
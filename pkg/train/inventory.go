@@ -0,0 +1,125 @@
+package reservation
+
+import (
+	"fmt"
+	"sort"
+
+	train "github.com/bijoyv/train/pkg/proto"
+)
+
+// A journey with n stops has n-1 legs, leg i runs from stop i to stop i+1.
+// Every seat keeps the occupant of each leg so the same seat can be sold
+// to passengers travelling on segments that do not overlap.
+
+// segment returns the legs [from, to) between two stops of the journey,
+// empty stations default to the start and the end of the journey.
+func (j *journey) segment(from, to string) (int, int, error) {
+	start, end := 0, len(j.info.Stops)-1
+	if from != "" {
+		start = j.stopIndex(from)
+	}
+	if to != "" {
+		end = j.stopIndex(to)
+	}
+	if start < 0 {
+		return 0, 0, fmt.Errorf("journey %s does not call at %s", j.info.Id, from)
+	}
+	if end < 0 {
+		return 0, 0, fmt.Errorf("journey %s does not call at %s", j.info.Id, to)
+	}
+	if start >= end {
+		return 0, 0, fmt.Errorf("journey %s does not run from %s to %s", j.info.Id, from, to)
+	}
+	return start, end, nil
+}
+
+func (j *journey) stopIndex(station string) int {
+	for i, stop := range j.info.Stops {
+		if stop.Station == station {
+			return i
+		}
+	}
+	return -1
+}
+
+// ordered returns the seats in layout order.
+func (j *journey) ordered() []*seat {
+	seats := make([]*seat, 0, len(j.seats))
+	for _, seat := range j.seats {
+		seats = append(seats, seat)
+	}
+	sort.Slice(seats, func(a, b int) bool {
+		return seats[a].index < seats[b].index
+	})
+	return seats
+}
+
+// classSeats returns the seats of one class in layout order.
+func (j *journey) classSeats(class train.SeatClass) []*seat {
+	var seats []*seat
+	for _, seat := range j.ordered() {
+		if seat.class == class {
+			seats = append(seats, seat)
+		}
+	}
+	return seats
+}
+
+// setSeats installs a fresh inventory with one empty occupant per leg.
+func (j *journey) setSeats(seats map[string]*seat) {
+	legs := len(j.info.Stops) - 1
+	for _, seat := range seats {
+		seat.legs = make([]string, legs)
+	}
+	j.seats = seats
+}
+
+// free reports whether the seat is empty on all legs in [start, end) and
+// not blocked.
+func (s *seat) free(start, end int) bool {
+	if s.blocked {
+		return false
+	}
+	for _, occupant := range s.legs[start:end] {
+		if occupant != "" {
+			return false
+		}
+	}
+	return true
+}
+
+func (s *seat) occupy(start, end int, occupant string) {
+	for i := start; i < end; i++ {
+		s.legs[i] = occupant
+	}
+}
+
+// release frees every leg held by occupant.
+func (s *seat) release(occupant string) {
+	for i, o := range s.legs {
+		if o == occupant {
+			s.legs[i] = ""
+		}
+	}
+}
+
+// occupants lists who holds the seat on [start, end) in travel order.
+func (s *seat) occupants(start, end int) []string {
+	var names []string
+	for _, o := range s.legs[start:end] {
+		if o != "" && (len(names) == 0 || names[len(names)-1] != o) {
+			names = append(names, o)
+		}
+	}
+	return names
+}
+
+// occupied reports whether the seat is taken on any leg.
+func (s *seat) occupied() bool {
+	for _, occupant := range s.legs {
+		if occupant != "" {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,82 @@
+package reservation
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	train "github.com/bijoyv/train/pkg/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestSegmentInventory(t *testing.T) {
+	trainService := NewTrainReservationService()
+	departure := time.Date(2026, 10, 19, 9, 1, 0, 0, time.UTC)
+	res, err := trainService.CreateJourney(context.Background(), &train.CreateJourneyRequest{
+		TrainNumber: "9O14",
+		Departure:   timestamppb.New(departure),
+		Stops: []*train.Stop{
+			{Station: "London", Departure: timestamppb.New(departure)},
+			{Station: "Lille", Arrival: timestamppb.New(departure.Add(80 * time.Minute))},
+			{Station: "Paris", Arrival: timestamppb.New(departure.Add(140 * time.Minute))},
+		},
+		Layout: &train.SeatLayout{Coaches: []*train.Coach{
+			{Code: "A", Seats: []*train.SeatDefinition{{Id: "A1"}}},
+		}},
+	})
+	if err != nil {
+		t.Fatalf("CreateJourney failed: %v", err)
+	}
+	journeyID := res.Journey.Id
+
+	purchase := func(email, from, to string) (*train.PurchaseTicketResponse, error) {
+		return trainService.PurchaseTicket(context.Background(), &train.PurchaseTicketRequest{
+			From:      from,
+			To:        to,
+			User:      &train.User{Email: email},
+			JourneyId: journeyID,
+		})
+	}
+
+	if _, err := purchase("hana.ito@example.com", "London", "Lille"); err != nil {
+		t.Fatalf("PurchaseTicket London-Lille failed: %v", err)
+	}
+	if _, err := purchase("ivan.petrov@example.com", "Paris", "Lille"); err == nil {
+		t.Error("Expected error purchasing against the direction of travel, got nil")
+	}
+
+	seats, err := trainService.GetSeatsBySection(context.Background(), &train.GetSeatsBySectionRequest{
+		JourneyId: journeyID,
+		Section:   "A",
+		From:      "Lille",
+		To:        "Paris",
+	})
+	if err != nil {
+		t.Fatalf("GetSeatsBySection failed: %v", err)
+	}
+	if seats.Seats["A1"] != "" {
+		t.Errorf("Expected A1 free from Lille to Paris, got %q", seats.Seats["A1"])
+	}
+
+	ticket, err := purchase("ivan.petrov@example.com", "Lille", "Paris")
+	if err != nil {
+		t.Fatalf("PurchaseTicket Lille-Paris failed: %v", err)
+	}
+	if ticket.Ticket.Seat != "A1" {
+		t.Errorf("Expected seat A1 to be reused, got %s", ticket.Ticket.Seat)
+	}
+	if _, err := purchase("jack.moss@example.com", "London", "Paris"); err == nil {
+		t.Error("Expected no seats available for London-Paris, got nil")
+	}
+
+	seats, err = trainService.GetSeatsBySection(context.Background(), &train.GetSeatsBySectionRequest{
+		JourneyId: journeyID,
+		Section:   "A",
+	})
+	if err != nil {
+		t.Fatalf("GetSeatsBySection failed: %v", err)
+	}
+	if want := "hana.ito@example.com,ivan.petrov@example.com"; seats.Seats["A1"] != want {
+		t.Errorf("Expected A1 held by %q, got %q", want, seats.Seats["A1"])
+	}
+}
@@ -0,0 +1,79 @@
+package reservation
+
+import (
+	"context"
+	"fmt"
+
+	train "github.com/bijoyv/train/pkg/proto"
+)
+
+// connection checks the next journey leaves station at least the station's
+// minimum connection time after the previous journey gets there. Both times
+// must be in the timetable, an arrival is never guessed from a departure.
+func (s *TrainService) connection(previous, next *journey, station string) error {
+	arrival := previous.arrival(station)
+	leaves := next.departure(station)
+	if arrival == nil || leaves.IsZero() {
+		return fmt.Errorf("connection at %s needs timetabled journeys, %s or %s has no time there", station, previous.info.Id, next.info.Id)
+	}
+	arrives := arrival.AsTime()
+	if need := s.stations.MinConnection(station); leaves.Sub(arrives) < need {
+		return fmt.Errorf("connection at %s from %s to %s is %s, at least %s is needed", station, previous.info.Id, next.info.Id, leaves.Sub(arrives), need)
+	}
+	return nil
+}
+
+// PurchaseItinerary books a journey that changes trains in one operation,
+// either every leg is booked or none is. Each change must leave at least
+// the minimum connection time of the interchange station.
+func (s *TrainService) PurchaseItinerary(ctx context.Context, req *train.PurchaseItineraryRequest) (*train.PurchaseItineraryResponse, error) {
+	if err := validBooking(req.Purchaser, req.User); err != nil {
+		return nil, err
+	}
+	if len(req.Legs) < 2 {
+		return nil, fmt.Errorf("an itinerary requires at least two legs")
+	}
+	legs := make([]tripLeg, len(req.Legs))
+	for i, l := range req.Legs {
+		from, to, err := s.route(l.From, l.To)
+		if err != nil {
+			return nil, err
+		}
+		if l.JourneyId == "" || from == "" || to == "" {
+			return nil, fmt.Errorf("itinerary leg %d requires a journey, a from and a to station", i+1)
+		}
+		if i > 0 {
+			if from != legs[i-1].to {
+				return nil, fmt.Errorf("itinerary leg %d starts at %s but leg %d ends at %s", i+1, from, i, legs[i-1].to)
+			}
+			if l.JourneyId == legs[i-1].journeyID {
+				return nil, fmt.Errorf("itinerary legs %d and %d are on the same journey %s", i, i+1, l.JourneyId)
+			}
+		}
+		legs[i] = tripLeg{journeyID: l.JourneyId, from: from, to: to, name: fmt.Sprintf("leg %d", i+1)}
+	}
+	tickets, err := s.bookTrip(ctx, &tripBooking{
+		legs:        legs,
+		user:        req.User,
+		purchaser:   req.Purchaser,
+		class:       req.SeatClass,
+		passenger:   req.PassengerType,
+		preferences: req.Preferences,
+		check: func(sales []sale) error {
+			for i := 1; i < len(sales); i++ {
+				if err := s.connection(sales[i-1].j, sales[i].j, legs[i].from); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+		link: func(ticket *train.Ticket, reference string, leg int) {
+			ticket.ItineraryReference = reference
+			ticket.ItineraryLeg = int32(leg + 1)
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &train.PurchaseItineraryResponse{ItineraryReference: tickets[0].ItineraryReference, Tickets: tickets}, nil
+}
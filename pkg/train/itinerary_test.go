@@ -0,0 +1,131 @@
+package reservation
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	train "github.com/bijoyv/train/pkg/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestPurchaseItinerary(t *testing.T) {
+	payments := NewFakePaymentProvider()
+	trainService := NewTrainReservationService(WithClock(newFakeClock()), WithPaymentProvider(payments))
+	ctx := context.Background()
+	// arrives in Lille at 11:20
+	first := createTimetabledJourney(t, trainService, "9O12", "London", "Lille", "2026-10-19T09:00:00Z", nil)
+	second := createTimetabledJourney(t, trainService, "9B31", "Lille", "Brussels", "2026-10-19T11:45:00Z", nil)
+
+	res, err := trainService.PurchaseItinerary(ctx, &train.PurchaseItineraryRequest{
+		Legs: []*train.ItineraryLeg{
+			{JourneyId: first, From: "LON", To: "Lille Europe"},
+			{JourneyId: second, From: "Lille", To: "Brussels"},
+		},
+		User: &train.User{FirstName: "Rio", Email: "rio.vale@example.com"},
+	})
+	if err != nil {
+		t.Fatalf("PurchaseItinerary failed: %v", err)
+	}
+	if len(res.Tickets) != 2 {
+		t.Fatalf("Expected 2 tickets, got %d", len(res.Tickets))
+	}
+	for i, ticket := range res.Tickets {
+		if ticket.ItineraryReference != res.ItineraryReference || ticket.ItineraryLeg != int32(i+1) || ticket.Seat == "" {
+			t.Errorf("Expected leg %d of itinerary %s with a seat, got %v", i+1, res.ItineraryReference, ticket)
+		}
+		if ticket.PaymentStatus != train.PaymentStatus_PAYMENT_STATUS_CAPTURED || ticket.PaymentId != res.Tickets[0].PaymentId {
+			t.Errorf("Expected every leg captured on one payment, got %v", ticket)
+		}
+	}
+	if res.Tickets[0].To != "Lille" || res.Tickets[1].From != "Lille" {
+		t.Errorf("Expected a change at Lille, got %s and %s", res.Tickets[0].To, res.Tickets[1].From)
+	}
+
+	got, err := trainService.GetTicket(ctx, &train.GetTicketRequest{Reference: res.ItineraryReference})
+	if err != nil {
+		t.Fatalf("GetTicket failed: %v", err)
+	}
+	if len(got.Trip) != 2 || got.Trip[1].Reference != res.Tickets[1].Reference {
+		t.Errorf("Expected both legs of the itinerary, got %v", got.Trip)
+	}
+
+	if _, err := trainService.RemoveUser(ctx, &train.RemoveUserRequest{Reference: res.Tickets[1].Reference, Email: "rio.vale@example.com"}); err != nil {
+		t.Fatalf("RemoveUser failed: %v", err)
+	}
+	list, err := trainService.ListTicketsByUser(ctx, &train.ListTicketsByUserRequest{Email: "rio.vale@example.com"})
+	if err != nil {
+		t.Fatalf("ListTicketsByUser failed: %v", err)
+	}
+	if len(list.Tickets) != 0 {
+		t.Errorf("Expected the whole itinerary cancelled, got %v", list.Tickets)
+	}
+}
+
+func TestPurchaseItineraryAllOrNothing(t *testing.T) {
+	payments := NewFakePaymentProvider()
+	trainService := NewTrainReservationService(WithClock(newFakeClock()), WithPaymentProvider(payments))
+	ctx := context.Background()
+	single := &train.SeatLayout{Coaches: []*train.Coach{{Code: "A", Seats: []*train.SeatDefinition{{Id: "A1"}}}}}
+	first := createTimetabledJourney(t, trainService, "9O12", "London", "Lille", "2026-10-19T09:00:00Z", nil)
+	// 10 minutes at Lille, which needs 20
+	tight := createTimetabledJourney(t, trainService, "9B29", "Lille", "Brussels", "2026-10-19T11:30:00Z", nil)
+	full := createTimetabledJourney(t, trainService, "9B31", "Lille", "Brussels", "2026-10-19T11:45:00Z", single)
+	onward := createTimetabledJourney(t, trainService, "9B33", "Brussels", "Amsterdam", "2026-10-19T15:00:00Z", nil)
+	// no arrival time at Lille, only a departure from London
+	departure := timestamppb.New(time.Date(2026, 10, 19, 7, 0, 0, 0, time.UTC))
+	untimed, err := trainService.CreateJourney(ctx, &train.CreateJourneyRequest{
+		TrainNumber: "9O08",
+		Departure:   departure,
+		Stops:       []*train.Stop{{Station: "London", Departure: departure}, {Station: "Lille"}},
+	})
+	if err != nil {
+		t.Fatalf("CreateJourney failed: %v", err)
+	}
+	if _, err := trainService.PurchaseTicket(ctx, &train.PurchaseTicketRequest{JourneyId: full, From: "Lille", To: "Brussels", User: &train.User{Email: "ola.vale@example.com"}}); err != nil {
+		t.Fatalf("PurchaseTicket failed: %v", err)
+	}
+
+	tests := []struct {
+		name string
+		legs []*train.ItineraryLeg
+	}{
+		{"a full leg", []*train.ItineraryLeg{
+			{JourneyId: first, From: "London", To: "Lille"},
+			{JourneyId: full, From: "Lille", To: "Brussels"},
+		}},
+		{"a short connection", []*train.ItineraryLeg{
+			{JourneyId: first, From: "London", To: "Lille"},
+			{JourneyId: tight, From: "Lille", To: "Brussels"},
+		}},
+		{"no arrival time at the change", []*train.ItineraryLeg{
+			{JourneyId: untimed.Journey.Id, From: "London", To: "Lille"},
+			{JourneyId: tight, From: "Lille", To: "Brussels"},
+		}},
+		{"legs that do not meet", []*train.ItineraryLeg{
+			{JourneyId: first, From: "London", To: "Lille"},
+			{JourneyId: onward, From: "Brussels", To: "Amsterdam"},
+		}},
+		{"a single leg", []*train.ItineraryLeg{
+			{JourneyId: first, From: "London", To: "Lille"},
+		}},
+	}
+	for _, tt := range tests {
+		_, err := trainService.PurchaseItinerary(ctx, &train.PurchaseItineraryRequest{Legs: tt.legs, User: &train.User{Email: "pam.vale@example.com"}})
+		if err == nil {
+			t.Errorf("Expected an itinerary with %s to fail", tt.name)
+		}
+	}
+	list, err := trainService.ListTicketsByUser(ctx, &train.ListTicketsByUserRequest{Email: "pam.vale@example.com"})
+	if err != nil {
+		t.Fatalf("ListTicketsByUser failed: %v", err)
+	}
+	if len(list.Tickets) != 0 {
+		t.Errorf("Expected no ticket left from failed itineraries, got %v", list.Tickets)
+	}
+	for _, id := range []string{"PAY000002", "PAY000003"} {
+		if payment, _ := payments.Payment(id); payment.Status != train.PaymentStatus_PAYMENT_STATUS_VOIDED {
+			t.Errorf("Expected itinerary payment %s to be voided, got %s", id, payment.Status)
+		}
+	}
+}
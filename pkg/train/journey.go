@@ -0,0 +1,217 @@
+package reservation
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	train "github.com/bijoyv/train/pkg/proto"
+	"google.golang.org/protobuf/proto"
+)
+
+// DefaultJourneyID is the journey used by requests that do not name one,
+// it keeps the single train behaviour the service started with.
+const DefaultJourneyID = "default"
+
+// journey is a single departure of a train together with its own seats.
+type journey struct {
+	info     *train.Journey
+	seats    map[string]*seat
+	waitlist []*waitEntry
+}
+
+func newJourney(info *train.Journey, layout *train.SeatLayout) (*journey, error) {
+	if len(info.Stops) == 0 {
+		info.Stops = []*train.Stop{
+			{Station: info.Origin, Departure: info.Departure},
+			{Station: info.Destination},
+		}
+	}
+	if len(info.Stops) < 2 {
+		return nil, fmt.Errorf("journey %s needs at least two stops", info.Id)
+	}
+	seats, err := buildSeats(layout)
+	if err != nil {
+		return nil, err
+	}
+	j := &journey{info: info}
+	j.setSeats(seats)
+	return j, nil
+}
+
+// sold reports whether any seat of the journey is taken.
+func (j *journey) sold() bool {
+	for _, seat := range j.seats {
+		if seat.occupied() {
+			return true
+		}
+	}
+	return false
+}
+
+// journey looks up a journey, an empty id resolves to the default journey.
+func (st *state) journey(id string) (*journey, error) {
+	if id == "" {
+		id = DefaultJourneyID
+	}
+	j, ok := st.journeys[id]
+	if !ok {
+		return nil, fmt.Errorf("journey %s not found", id)
+	}
+	return j, nil
+}
+
+// openJourney is like journey but only returns journeys that are still on sale.
+func (st *state) openJourney(id string) (*journey, error) {
+	j, err := st.journey(id)
+	if err != nil {
+		return nil, err
+	}
+	if j.info.Retired {
+		return nil, fmt.Errorf("journey %s is retired", j.info.Id)
+	}
+	return j, nil
+}
+
+// journeyID builds a readable id out of the train number and departure date.
+func journeyID(req *train.CreateJourneyRequest) string {
+	return fmt.Sprintf("%s-%s", req.TrainNumber, req.Departure.AsTime().Format("20060102"))
+}
+
+func (s *TrainService) CreateJourney(ctx context.Context, req *train.CreateJourneyRequest) (*train.CreateJourneyResponse, error) {
+	if req.TrainNumber == "" || req.Departure == nil {
+		return nil, fmt.Errorf("journey requires a train number and a departure")
+	}
+	origin, destination, err := s.route(req.Origin, req.Destination)
+	if err != nil {
+		return nil, err
+	}
+	stops := make([]*train.Stop, 0, len(req.Stops))
+	for _, stop := range req.Stops {
+		stop = proto.Clone(stop).(*train.Stop)
+		if stop.Station, err = s.stationName(stop.Station); err != nil {
+			return nil, err
+		}
+		stops = append(stops, stop)
+	}
+	if n := len(stops); n > 0 {
+		if origin == "" {
+			origin = stops[0].Station
+		}
+		if destination == "" {
+			destination = stops[n-1].Station
+		}
+		if origin != stops[0].Station || destination != stops[n-1].Station {
+			return nil, fmt.Errorf("journey stops must start at the origin and end at the destination")
+		}
+	}
+	if origin == "" || destination == "" {
+		return nil, fmt.Errorf("journey requires an origin and a destination")
+	}
+	er := make(chan error, 1)
+	result := make(chan *train.Journey, 1)
+
+	s.ops <- func(st *state) {
+		id := journeyID(req)
+		if _, exists := st.journeys[id]; exists {
+			er <- fmt.Errorf("journey %s already exists", id)
+			return
+		}
+		info := &train.Journey{
+			Id:          id,
+			TrainNumber: req.TrainNumber,
+			Departure:   req.Departure,
+			Origin:      origin,
+			Destination: destination,
+			Stops:       stops,
+		}
+		layout := req.Layout
+		if layout == nil {
+			layout = s.layout
+		}
+		j, err := newJourney(info, layout)
+		if err != nil {
+			er <- err
+			return
+		}
+		st.journeys[id] = j
+		result <- proto.Clone(info).(*train.Journey)
+	}
+	select {
+	case e := <-er:
+		return nil, e
+	case j := <-result:
+		return &train.CreateJourneyResponse{Journey: j}, nil
+	}
+}
+
+func (s *TrainService) ListJourneys(ctx context.Context, req *train.ListJourneysRequest) (*train.ListJourneysResponse, error) {
+	result := make(chan []*train.Journey, 1)
+
+	s.ops <- func(st *state) {
+		journeys := make([]*train.Journey, 0, len(st.journeys))
+		for _, j := range st.journeys {
+			if j.info.Retired && !req.IncludeRetired {
+				continue
+			}
+			journeys = append(journeys, proto.Clone(j.info).(*train.Journey))
+		}
+		sort.Slice(journeys, func(a, b int) bool {
+			return journeys[a].Id < journeys[b].Id
+		})
+		result <- journeys
+	}
+	return &train.ListJourneysResponse{Journeys: <-result}, nil
+}
+
+// RetireJourney stops sales on a journey, tickets already sold stay valid.
+func (s *TrainService) RetireJourney(ctx context.Context, req *train.RetireJourneyRequest) (*train.RetireJourneyResponse, error) {
+	er := make(chan error, 1)
+	result := make(chan bool, 1)
+
+	s.ops <- func(st *state) {
+		j, exists := st.journeys[req.JourneyId]
+		if !exists {
+			er <- fmt.Errorf("journey %s not found", req.JourneyId)
+			return
+		}
+		j.info.Retired = true
+		result <- true
+	}
+	select {
+	case e := <-er:
+		return nil, e
+	case <-result:
+		return &train.RetireJourneyResponse{Success: true}, nil
+	}
+}
+
+// SetSeatLayout replaces the seat inventory of a journey that has not sold any seats yet.
+func (s *TrainService) SetSeatLayout(ctx context.Context, req *train.SetSeatLayoutRequest) (*train.SetSeatLayoutResponse, error) {
+	seats, err := buildSeats(req.Layout)
+	if err != nil {
+		return nil, err
+	}
+	er := make(chan error, 1)
+	result := make(chan int, 1)
+
+	s.ops <- func(st *state) {
+		j, err := st.journey(req.JourneyId)
+		if err != nil {
+			er <- err
+			return
+		}
+		if j.sold() {
+			er <- fmt.Errorf("journey %s already has seats sold", j.info.Id)
+			return
+		}
+		j.setSeats(seats)
+		result <- len(seats)
+	}
+	select {
+	case e := <-er:
+		return nil, e
+	case n := <-result:
+		return &train.SetSeatLayoutResponse{Seats: int32(n)}, nil
+	}
+}
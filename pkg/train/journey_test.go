@@ -0,0 +1,94 @@
+package reservation
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	train "github.com/bijoyv/train/pkg/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestJourneys(t *testing.T) {
+	trainService := NewTrainReservationService()
+	departure := timestamppb.New(time.Date(2026, 10, 19, 9, 1, 0, 0, time.UTC))
+
+	var journeyID string
+	t.Run("CreateJourney", func(t *testing.T) {
+		res, err := trainService.CreateJourney(context.Background(), &train.CreateJourneyRequest{
+			TrainNumber: "9O12",
+			Departure:   departure,
+			Origin:      "London",
+			Destination: "Paris",
+		})
+		if err != nil {
+			t.Fatalf("CreateJourney failed: %v", err)
+		}
+		journeyID = res.Journey.Id
+		if journeyID != "9O12-20261019" {
+			t.Errorf("Expected journey id '9O12-20261019', got %s", journeyID)
+		}
+	})
+
+	t.Run("CreateJourneyExists", func(t *testing.T) {
+		_, err := trainService.CreateJourney(context.Background(), &train.CreateJourneyRequest{
+			TrainNumber: "9O12",
+			Departure:   departure,
+			Origin:      "London",
+			Destination: "Paris",
+		})
+		if err == nil {
+			t.Fatal("Expected error for duplicate journey, got nil")
+		}
+	})
+
+	t.Run("SeatsArePerJourney", func(t *testing.T) {
+		req := &train.PurchaseTicketRequest{
+			From:      "London",
+			To:        "Paris",
+			User:      &train.User{Email: "erin.clark@example.com"},
+			JourneyId: journeyID,
+		}
+		res, err := trainService.PurchaseTicket(context.Background(), req)
+		if err != nil {
+			t.Fatalf("PurchaseTicket failed: %v", err)
+		}
+		if res.Ticket.JourneyId != journeyID {
+			t.Errorf("Expected journey %s, got %s", journeyID, res.Ticket.JourneyId)
+		}
+
+		section := string(res.Ticket.Seat[0])
+		seats, err := trainService.GetSeatsBySection(context.Background(), &train.GetSeatsBySectionRequest{Section: section})
+		if err != nil {
+			t.Fatalf("GetSeatsBySection failed: %v", err)
+		}
+		if seats.Seats[res.Ticket.Seat] != "" {
+			t.Errorf("Expected seat %s to be free on the default journey", res.Ticket.Seat)
+		}
+	})
+
+	t.Run("RetireJourney", func(t *testing.T) {
+		if _, err := trainService.RetireJourney(context.Background(), &train.RetireJourneyRequest{JourneyId: journeyID}); err != nil {
+			t.Fatalf("RetireJourney failed: %v", err)
+		}
+		list, err := trainService.ListJourneys(context.Background(), &train.ListJourneysRequest{})
+		if err != nil {
+			t.Fatalf("ListJourneys failed: %v", err)
+		}
+		for _, j := range list.Journeys {
+			if j.Id == journeyID {
+				t.Errorf("Expected retired journey %s to be hidden", journeyID)
+			}
+		}
+
+		_, err = trainService.PurchaseTicket(context.Background(), &train.PurchaseTicketRequest{
+			From:      "London",
+			To:        "Paris",
+			User:      &train.User{Email: "frank.green@example.com"},
+			JourneyId: journeyID,
+		})
+		if err == nil {
+			t.Fatal("Expected error purchasing on a retired journey, got nil")
+		}
+	})
+}
@@ -0,0 +1,140 @@
+package reservation
+
+import (
+	"fmt"
+	"os"
+
+	train "github.com/bijoyv/train/pkg/proto"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// Seat attributes set on generated seats.
+const (
+	AttrWindow  = "window"
+	AttrAisle   = "aisle"
+	AttrForward = "forward"
+)
+
+// seat is a single seat of a journey's inventory, index is its position
+// in the layout. Seats take the class of their coach.
+type seat struct {
+	index      int
+	id         string
+	coach      string
+	class      train.SeatClass
+	row        int
+	column     string
+	attributes []string
+	legs       []string
+	blocked    bool
+}
+
+// DefaultSeatLayout is the layout used when none is configured, two
+// standard class coaches A and B of 20 seats each.
+func DefaultSeatLayout() *train.SeatLayout {
+	return &train.SeatLayout{
+		Coaches: []*train.Coach{
+			{Code: "A", Rows: 5, Columns: "ABCD"},
+			{Code: "B", Rows: 5, Columns: "ABCD"},
+		},
+	}
+}
+
+// LoadSeatLayout reads a layout from a JSON file, field names follow the proto.
+func LoadSeatLayout(path string) (*train.SeatLayout, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read seat layout: %w", err)
+	}
+	layout := &train.SeatLayout{}
+	if err := protojson.Unmarshal(data, layout); err != nil {
+		return nil, fmt.Errorf("parse seat layout %s: %w", path, err)
+	}
+	if _, err := buildSeats(layout); err != nil {
+		return nil, err
+	}
+	return layout, nil
+}
+
+// buildSeats turns a layout into an empty inventory keyed by seat id.
+func buildSeats(layout *train.SeatLayout) (map[string]*seat, error) {
+	if layout == nil || len(layout.Coaches) == 0 {
+		return nil, fmt.Errorf("seat layout has no coaches")
+	}
+	seats := make(map[string]*seat)
+	for _, coach := range layout.Coaches {
+		if coach.Code == "" {
+			return nil, fmt.Errorf("seat layout has a coach without a code")
+		}
+		coachSeats, err := coachSeats(coach)
+		if err != nil {
+			return nil, err
+		}
+		for _, s := range coachSeats {
+			if _, exists := seats[s.id]; exists {
+				return nil, fmt.Errorf("seat %s is defined twice", s.id)
+			}
+			s.index = len(seats)
+			seats[s.id] = s
+		}
+	}
+	return seats, nil
+}
+
+func coachSeats(coach *train.Coach) ([]*seat, error) {
+	var seats []*seat
+	if len(coach.Seats) > 0 {
+		for _, def := range coach.Seats {
+			if def.Id == "" {
+				return nil, fmt.Errorf("coach %s has a seat without an id", coach.Code)
+			}
+			seats = append(seats, &seat{
+				id:         def.Id,
+				coach:      coach.Code,
+				class:      seatClass(coach.SeatClass),
+				row:        int(def.Row),
+				column:     def.Column,
+				attributes: def.Attributes,
+			})
+		}
+		return seats, nil
+	}
+
+	columns := []rune(coach.Columns)
+	if coach.Rows <= 0 || len(columns) == 0 {
+		return nil, fmt.Errorf("coach %s needs seats or rows and columns", coach.Code)
+	}
+	aisleAfter := int(coach.AisleAfter)
+	if aisleAfter <= 0 {
+		aisleAfter = len(columns) / 2
+	}
+	backward := make(map[int]bool)
+	for _, row := range coach.BackwardRows {
+		backward[int(row)] = true
+	}
+	n := 1
+	for row := 1; row <= int(coach.Rows); row++ {
+		for i, col := range columns {
+			var attributes []string
+			if !backward[row] {
+				attributes = append(attributes, AttrForward)
+			}
+			if i == 0 || i == len(columns)-1 {
+				attributes = append(attributes, AttrWindow)
+			}
+			if i == aisleAfter-1 || i == aisleAfter {
+				attributes = append(attributes, AttrAisle)
+			}
+			seats = append(seats, &seat{
+				id:         fmt.Sprintf("%s%d", coach.Code, n),
+				coach:      coach.Code,
+				class:      seatClass(coach.SeatClass),
+				row:        row,
+				column:     string(col),
+				attributes: attributes,
+			})
+			n++
+		}
+	}
+	return seats, nil
+}
@@ -0,0 +1,101 @@
+package reservation
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	train "github.com/bijoyv/train/pkg/proto"
+)
+
+func TestBuildSeats(t *testing.T) {
+	seats, err := buildSeats(DefaultSeatLayout())
+	if err != nil {
+		t.Fatalf("buildSeats failed: %v", err)
+	}
+	if len(seats) != 40 {
+		t.Fatalf("Expected 40 seats, got %d", len(seats))
+	}
+	for _, id := range []string{"A1", "A20", "B1", "B20"} {
+		if _, ok := seats[id]; !ok {
+			t.Errorf("Expected seat %s in default layout", id)
+		}
+	}
+	a1, a2 := seats["A1"], seats["A2"]
+	if a1.row != 1 || a1.column != "A" || !hasAttribute(a1.attributes, AttrWindow) {
+		t.Errorf("Expected A1 to be a window seat in row 1, got %+v", a1)
+	}
+	if !hasAttribute(a2.attributes, AttrAisle) {
+		t.Errorf("Expected A2 to be an aisle seat, got %+v", a2)
+	}
+
+	_, err = buildSeats(&train.SeatLayout{Coaches: []*train.Coach{
+		{Code: "C", Seats: []*train.SeatDefinition{{Id: "C1"}}},
+		{Code: "D", Seats: []*train.SeatDefinition{{Id: "C1"}}},
+	}})
+	if err == nil {
+		t.Error("Expected error for duplicate seat ids, got nil")
+	}
+}
+
+func TestLoadSeatLayout(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "layout.json")
+	data := `{"coaches": [{"code": "FA", "rows": 16, "columns": "ABCD"}]}`
+	if err := os.WriteFile(path, []byte(data), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	layout, err := LoadSeatLayout(path)
+	if err != nil {
+		t.Fatalf("LoadSeatLayout failed: %v", err)
+	}
+
+	trainService := NewTrainReservationService(WithSeatLayout(layout))
+	res, err := trainService.GetSeatsBySection(context.Background(), &train.GetSeatsBySectionRequest{Section: "FA"})
+	if err != nil {
+		t.Fatalf("GetSeatsBySection failed: %v", err)
+	}
+	if len(res.Seats) != 64 {
+		t.Errorf("Expected 64 seats in coach FA, got %d", len(res.Seats))
+	}
+
+	res, err = trainService.GetSeatsBySection(context.Background(), &train.GetSeatsBySectionRequest{Section: "F"})
+	if err != nil {
+		t.Fatalf("GetSeatsBySection failed: %v", err)
+	}
+	if len(res.Seats) != 0 {
+		t.Errorf("Expected no seats in coach F, got %d", len(res.Seats))
+	}
+}
+
+func TestModifySeatUnknownSeat(t *testing.T) {
+	trainService := NewTrainReservationService()
+	_, err := trainService.PurchaseTicket(context.Background(), &train.PurchaseTicketRequest{
+		From: "London",
+		To:   "Paris",
+		User: &train.User{Email: "gina.hall@example.com"},
+	})
+	if err != nil {
+		t.Fatalf("PurchaseTicket failed: %v", err)
+	}
+	_, err = trainService.ModifySeat(context.Background(), &train.ModifySeatRequest{
+		Email:   "gina.hall@example.com",
+		NewSeat: "Z99",
+	})
+	if err == nil {
+		t.Fatal("Expected error for a seat outside the layout, got nil")
+	}
+	_, err = trainService.SetSeatLayout(context.Background(), &train.SetSeatLayoutRequest{Layout: DefaultSeatLayout()})
+	if err == nil {
+		t.Fatal("Expected error replacing the layout of a sold journey, got nil")
+	}
+}
+
+func hasAttribute(attributes []string, want string) bool {
+	for _, a := range attributes {
+		if a == want {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,223 @@
+package reservation
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log"
+	"text/template"
+	"time"
+
+	train "github.com/bijoyv/train/pkg/proto"
+	"github.com/bijoyv/train/pkg/receipt"
+)
+
+// Notifier delivers messages to passengers. Notifiers are called on their
+// own go routine, never by the actor, and a send that fails is retried.
+type Notifier interface {
+	Notify(ctx context.Context, msg Message) error
+}
+
+// Message is a notification for one recipient about one booking event.
+type Message struct {
+	To        string
+	Subject   string
+	Body      string
+	Event     EventType
+	Reference string
+	Time      time.Time
+}
+
+// MessageTemplate renders an event into the subject and body of a message.
+// Templates are executed with the Event's fields and the recipient's Name,
+// and may format money with {{money .Ticket.Price}}.
+type MessageTemplate struct {
+	Subject *template.Template
+	Body    *template.Template
+}
+
+// messageData is what message templates are executed with.
+type messageData struct {
+	Event
+	Name string
+}
+
+var messageFuncs = template.FuncMap{
+	"money": receipt.Amount,
+	"class": func(class train.SeatClass) string { return enumName(class.String(), "SEAT_CLASS_") },
+}
+
+// NewMessageTemplate parses the subject and body templates of an event.
+func NewMessageTemplate(eventType EventType, subject, body string) (*MessageTemplate, error) {
+	s, err := template.New(string(eventType) + ".subject").Funcs(messageFuncs).Parse(subject)
+	if err != nil {
+		return nil, fmt.Errorf("subject template for %s: %v", eventType, err)
+	}
+	b, err := template.New(string(eventType) + ".body").Funcs(messageFuncs).Parse(body)
+	if err != nil {
+		return nil, fmt.Errorf("body template for %s: %v", eventType, err)
+	}
+	return &MessageTemplate{Subject: s, Body: b}, nil
+}
+
+const (
+	greeting  = "Hello{{with .Name}} {{.}}{{end}},\n\n"
+	signature = "\nBooking reference: {{.Ticket.Reference}}\n"
+	// disruptions all tell the passenger what the notice says
+	disruptionSubject = "Disruption to your journey from {{.Ticket.From}} to {{.Ticket.To}}"
+	disruptionBody    = greeting + "{{.Notice.Message}}.\n{{with .Notice.Refund}}Refund: {{money .}}\n{{end}}" + signature
+)
+
+// defaultTemplates are the subject and body of every event passengers are
+// told about.
+var defaultTemplates = map[EventType][2]string{
+	EventTicketPurchased: {
+		"Your ticket from {{.Ticket.From}} to {{.Ticket.To}}",
+		greeting + "Your ticket from {{.Ticket.From}} to {{.Ticket.To}} on journey {{.Ticket.JourneyId}} is booked.\nSeat: {{.Ticket.Seat}} ({{class .Ticket.SeatClass}})\nPrice: {{money .Ticket.Price}}\n" + signature,
+	},
+	EventSeatModified: {
+		"Your seat from {{.Ticket.From}} to {{.Ticket.To}} has changed",
+		greeting + "Your seat on journey {{.Ticket.JourneyId}} is now {{.Ticket.Seat}}.\n" + signature,
+	},
+	EventTicketCancelled: {
+		"Your ticket from {{.Ticket.From}} to {{.Ticket.To}} is cancelled",
+		greeting + "Your ticket on journey {{.Ticket.JourneyId}} is cancelled.\n{{with .Ticket.Cancellation}}Refund: {{money .Refund}}\n{{if .Fee.GetMinorUnits}}Fee: {{money .Fee}}\n{{end}}{{end}}" + signature,
+	},
+	EventWaitlistPromoted: {
+		"A seat came up from {{.Ticket.From}} to {{.Ticket.To}}",
+		greeting + "A seat came up on journey {{.Ticket.JourneyId}} and you are booked in seat {{.Ticket.Seat}}.\nPrice: {{money .Ticket.Price}}\n" + signature,
+	},
+	EventSeatReassigned: {
+		"Your seat from {{.Ticket.From}} to {{.Ticket.To}} has changed",
+		greeting + "Your seat is out of service, you are moved to seat {{.Ticket.Seat}} on journey {{.Ticket.JourneyId}}.\n" + signature,
+	},
+	EventJourneyDelayed:   {disruptionSubject, disruptionBody},
+	EventTicketRebooked:   {disruptionSubject, disruptionBody},
+	EventJourneyCancelled: {disruptionSubject, disruptionBody},
+}
+
+// DefaultMessageTemplates returns the templates for every booking event.
+func DefaultMessageTemplates() map[EventType]*MessageTemplate {
+	templates := make(map[EventType]*MessageTemplate, len(defaultTemplates))
+	for eventType, t := range defaultTemplates {
+		mt, err := NewMessageTemplate(eventType, t[0], t[1])
+		if err != nil {
+			panic(err)
+		}
+		templates[eventType] = mt
+	}
+	return templates
+}
+
+// render builds a message to one recipient.
+func (t *MessageTemplate) render(e Event, to, name string) (Message, error) {
+	data := messageData{Event: e, Name: name}
+	var subject, body bytes.Buffer
+	if err := t.Subject.Execute(&subject, data); err != nil {
+		return Message{}, err
+	}
+	if err := t.Body.Execute(&body, data); err != nil {
+		return Message{}, err
+	}
+	return Message{
+		To:        to,
+		Subject:   subject.String(),
+		Body:      body.String(),
+		Event:     e.Type,
+		Reference: e.Ticket.Reference,
+		Time:      e.Time,
+	}, nil
+}
+
+// NotifyRetry sets how often a failed send is tried again. The first retry
+// waits Backoff, each one after twice as long as the one before.
+type NotifyRetry struct {
+	Attempts int
+	Backoff  time.Duration
+}
+
+// DefaultNotifyRetry tries a message 5 times over about 15 seconds.
+func DefaultNotifyRetry() NotifyRetry {
+	return NotifyRetry{Attempts: 5, Backoff: time.Second}
+}
+
+// notifyTimeout bounds a single send.
+const notifyTimeout = 30 * time.Second
+
+// notification is a message waiting to be sent.
+type notification struct {
+	msg      Message
+	attempts int
+}
+
+// notifications renders booking events into messages and sends them on its
+// own go routine, so a slow or failing notifier holds up neither the actor
+// nor the other event listeners.
+type notifications struct {
+	notifier  Notifier
+	templates map[EventType]*MessageTemplate
+	retry     NotifyRetry
+	queue     chan notification
+}
+
+func newNotifications(notifier Notifier, templates map[EventType]*MessageTemplate, retry NotifyRetry) *notifications {
+	return &notifications{
+		notifier:  notifier,
+		templates: templates,
+		retry:     retry,
+		queue:     make(chan notification, eventBuffer),
+	}
+}
+
+// listen queues a message for the passenger and, when someone else booked
+// the ticket, for the purchaser. Events without a template are ignored.
+func (n *notifications) listen(e Event) {
+	t, ok := n.templates[e.Type]
+	if !ok || e.Ticket == nil {
+		return
+	}
+	user, purchaser := e.Ticket.User, e.Ticket.Purchaser
+	recipients := []struct{ email, name string }{{user.GetEmail(), user.GetFirstName()}}
+	if purchaser.GetEmail() != user.GetEmail() {
+		recipients = append(recipients, struct{ email, name string }{purchaser.GetEmail(), purchaser.GetFirstName()})
+	}
+	for _, r := range recipients {
+		if r.email == "" {
+			continue
+		}
+		msg, err := t.render(e, r.email, r.name)
+		if err != nil {
+			log.Printf("cannot render %s notification for %s: %v", e.Type, e.Ticket.Reference, err)
+			continue
+		}
+		n.enqueue(notification{msg: msg})
+	}
+}
+
+func (n *notifications) enqueue(note notification) {
+	select {
+	case n.queue <- note:
+	default:
+		log.Printf("notification queue full, dropping %s to %s for %s", note.msg.Event, note.msg.To, note.msg.Reference)
+	}
+}
+
+// run sends queued messages. A failed message is queued again after its
+// backoff, messages behind it are sent meanwhile.
+func (n *notifications) run() {
+	for note := range n.queue {
+		ctx, cancel := context.WithTimeout(context.Background(), notifyTimeout)
+		err := n.notifier.Notify(ctx, note.msg)
+		cancel()
+		if err == nil {
+			continue
+		}
+		note.attempts++
+		if note.attempts >= n.retry.Attempts {
+			log.Printf("giving up on %s to %s for %s after %d attempts: %v", note.msg.Event, note.msg.To, note.msg.Reference, note.attempts, err)
+			continue
+		}
+		retry := note
+		time.AfterFunc(n.retry.Backoff<<(note.attempts-1), func() { n.enqueue(retry) })
+	}
+}
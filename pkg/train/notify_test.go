@@ -0,0 +1,200 @@
+package reservation
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	train "github.com/bijoyv/train/pkg/proto"
+)
+
+// recordingNotifier fails the first fail sends, then hands messages to sent.
+type recordingNotifier struct {
+	mu       sync.Mutex
+	fail     int
+	attempts int
+	sent     chan Message
+}
+
+func (n *recordingNotifier) Notify(ctx context.Context, msg Message) error {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.attempts++
+	if n.attempts <= n.fail {
+		return fmt.Errorf("mail server unavailable")
+	}
+	n.sent <- msg
+	return nil
+}
+
+func receive(t *testing.T, sent chan Message) Message {
+	t.Helper()
+	select {
+	case msg := <-sent:
+		return msg
+	case <-time.After(time.Second):
+		t.Fatal("Expected a notification")
+	}
+	return Message{}
+}
+
+func TestNotifications(t *testing.T) {
+	notifier := &recordingNotifier{sent: make(chan Message, 10)}
+	trainService := NewTrainReservationService(WithClock(newFakeClock()), WithNotifier(notifier))
+	ctx := context.Background()
+
+	res, err := trainService.PurchaseTicket(ctx, &train.PurchaseTicketRequest{
+		From:      "London",
+		To:        "Paris",
+		User:      &train.User{FirstName: "Ada", Email: "ada.moss@example.com"},
+		Purchaser: &train.Purchaser{FirstName: "Tom", Email: "travel@example.com"},
+	})
+	if err != nil {
+		t.Fatalf("PurchaseTicket failed: %v", err)
+	}
+	ref := res.Ticket.Reference
+	// the passenger and the purchaser are both told
+	for _, want := range []struct{ to, name string }{{"ada.moss@example.com", "Hello Ada"}, {"travel@example.com", "Hello Tom"}} {
+		msg := receive(t, notifier.sent)
+		if msg.To != want.to || msg.Event != EventTicketPurchased || msg.Reference != ref {
+			t.Errorf("Expected a purchase confirmation for %s to %s, got %+v", ref, want.to, msg)
+		}
+		if msg.Subject != "Your ticket from London to Paris" || !strings.HasPrefix(msg.Body, want.name) {
+			t.Errorf("Expected the subject and greeting, got %q and %q", msg.Subject, msg.Body)
+		}
+		for _, line := range []string{"Seat: A1 (standard)", "Price: 20.00 GBP", "Booking reference: " + ref} {
+			if !strings.Contains(msg.Body, line) {
+				t.Errorf("Expected %q in the confirmation, got %q", line, msg.Body)
+			}
+		}
+	}
+
+	if _, err := trainService.ModifySeat(ctx, &train.ModifySeatRequest{Reference: ref, NewSeat: "B3"}); err != nil {
+		t.Fatalf("ModifySeat failed: %v", err)
+	}
+	if msg := receive(t, notifier.sent); msg.Event != EventSeatModified || !strings.Contains(msg.Body, "is now B3") {
+		t.Errorf("Expected a seat change to B3, got %+v", msg)
+	}
+	receive(t, notifier.sent)
+
+	if _, err := trainService.RemoveUser(ctx, &train.RemoveUserRequest{Reference: ref, Email: "travel@example.com"}); err != nil {
+		t.Fatalf("RemoveUser failed: %v", err)
+	}
+	if msg := receive(t, notifier.sent); msg.Event != EventTicketCancelled || !strings.Contains(msg.Body, "Refund: 19.00 GBP") || !strings.Contains(msg.Body, "Fee: 1.00 GBP") {
+		t.Errorf("Expected a cancellation with the refund and fee, got %+v", msg)
+	}
+}
+
+func TestNotificationRetry(t *testing.T) {
+	notifier := &recordingNotifier{fail: 2, sent: make(chan Message, 10)}
+	trainService := NewTrainReservationService(
+		WithNotifier(notifier),
+		WithNotifyRetry(NotifyRetry{Attempts: 3, Backoff: 10 * time.Millisecond}),
+	)
+	ctx := context.Background()
+	if _, err := trainService.PurchaseTicket(ctx, &train.PurchaseTicketRequest{From: "London", To: "Paris", User: &train.User{Email: "bo.moss@example.com"}}); err != nil {
+		t.Fatalf("PurchaseTicket failed: %v", err)
+	}
+	if msg := receive(t, notifier.sent); msg.To != "bo.moss@example.com" {
+		t.Errorf("Expected the confirmation sent on the third attempt, got %+v", msg)
+	}
+	notifier.mu.Lock()
+	defer notifier.mu.Unlock()
+	if notifier.attempts != 3 {
+		t.Errorf("Expected 3 attempts, got %d", notifier.attempts)
+	}
+}
+
+func TestNotificationsDoNotBlockBookings(t *testing.T) {
+	// a notifier that never returns
+	stuck := make(chan struct{})
+	defer close(stuck)
+	trainService := NewTrainReservationService(WithNotifier(notifierFunc(func(ctx context.Context, msg Message) error {
+		<-stuck
+		return nil
+	})))
+	ctx := context.Background()
+	done := make(chan error, 1)
+	go func() {
+		for i := 0; i < 20; i++ {
+			if _, err := trainService.PurchaseTicket(ctx, &train.PurchaseTicketRequest{From: "London", To: "Paris", User: &train.User{Email: fmt.Sprintf("p%d@example.com", i)}}); err != nil {
+				done <- err
+				return
+			}
+		}
+		done <- nil
+	}()
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Fatalf("PurchaseTicket failed: %v", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Expected purchases to go through while the notifier hangs")
+	}
+}
+
+// notifierFunc adapts a function to a Notifier.
+type notifierFunc func(ctx context.Context, msg Message) error
+
+func (f notifierFunc) Notify(ctx context.Context, msg Message) error {
+	return f(ctx, msg)
+}
+
+func TestMessageTemplates(t *testing.T) {
+	if _, err := NewMessageTemplate(EventTicketPurchased, "{{.Ticket.Reference", "body"); err == nil {
+		t.Error("Expected a broken template to fail")
+	}
+	custom, err := NewMessageTemplate(EventTicketPurchased, "Booked {{.Ticket.Reference}}", "{{.Ticket.From}} - {{.Ticket.To}}")
+	if err != nil {
+		t.Fatalf("NewMessageTemplate failed: %v", err)
+	}
+	notifier := &recordingNotifier{sent: make(chan Message, 10)}
+	trainService := NewTrainReservationService(
+		WithNotifier(notifier),
+		WithMessageTemplates(map[EventType]*MessageTemplate{EventTicketPurchased: custom}),
+	)
+	ctx := context.Background()
+	res, err := trainService.PurchaseTicket(ctx, &train.PurchaseTicketRequest{From: "London", To: "Paris", User: &train.User{Email: "cy.moss@example.com"}})
+	if err != nil {
+		t.Fatalf("PurchaseTicket failed: %v", err)
+	}
+	if msg := receive(t, notifier.sent); msg.Subject != "Booked "+res.Ticket.Reference || msg.Body != "London - Paris" {
+		t.Errorf("Expected the custom template, got %+v", msg)
+	}
+	// no template for seat changes
+	if _, err := trainService.ModifySeat(ctx, &train.ModifySeatRequest{Reference: res.Ticket.Reference, NewSeat: "A2"}); err != nil {
+		t.Fatalf("ModifySeat failed: %v", err)
+	}
+	select {
+	case msg := <-notifier.sent:
+		t.Errorf("Expected no message without a template, got %+v", msg)
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
+func TestDefaultMessageTemplates(t *testing.T) {
+	ticket := &train.Ticket{
+		Reference: "ABC123",
+		From:      "London",
+		To:        "Paris",
+		JourneyId: "9O12",
+		Seat:      "A1",
+		User:      &train.User{Email: "di.moss@example.com"},
+		Price:     &train.Money{Currency: "GBP", MinorUnits: 2000},
+	}
+	notice := &train.DisruptionNotice{Message: "Train 9O12 is cancelled", Refund: &train.Money{Currency: "GBP", MinorUnits: 2000}}
+	for eventType, tmpl := range DefaultMessageTemplates() {
+		msg, err := tmpl.render(Event{Type: eventType, Ticket: ticket, Notice: notice}, "di.moss@example.com", "")
+		if err != nil {
+			t.Errorf("Expected the %s template to render, got %v", eventType, err)
+			continue
+		}
+		if msg.Subject == "" || !strings.HasPrefix(msg.Body, "Hello,") || !strings.Contains(msg.Body, "ABC123") {
+			t.Errorf("Expected a %s message for ABC123, got %+v", eventType, msg)
+		}
+	}
+}
@@ -0,0 +1,85 @@
+package reservation
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"mime"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"time"
+)
+
+// headerBreaks turns line breaks in a header value into spaces, so a value
+// cannot end its header and start another.
+var headerBreaks = strings.NewReplacer("\r\n", " ", "\r", " ", "\n", " ")
+
+// formatMessage writes msg as a plain text mail from sender, lines end in
+// CRLF as SMTP expects. Addresses with a line break are rejected, line
+// breaks in other headers, such as a subject from a template, become spaces.
+func formatMessage(from string, msg Message) ([]byte, error) {
+	for _, addr := range []string{from, msg.To} {
+		if strings.ContainsAny(addr, "\r\n") {
+			return nil, fmt.Errorf("mail address %q contains a line break", addr)
+		}
+	}
+	var b bytes.Buffer
+	header := func(name, value string) {
+		fmt.Fprintf(&b, "%s: %s\r\n", name, headerBreaks.Replace(value))
+	}
+	header("From", from)
+	header("To", msg.To)
+	header("Subject", mime.QEncoding.Encode("utf-8", msg.Subject))
+	header("Date", msg.Time.Format(time.RFC1123Z))
+	header("MIME-Version", "1.0")
+	header("Content-Type", "text/plain; charset=utf-8")
+	header("X-Train-Event", string(msg.Event))
+	header("X-Train-Reference", msg.Reference)
+	b.WriteString("\r\n")
+	body := strings.ReplaceAll(msg.Body, "\r\n", "\n")
+	b.WriteString(strings.ReplaceAll(body, "\n", "\r\n"))
+	return b.Bytes(), nil
+}
+
+// MaildirOutbox writes messages to a maildir instead of sending them, for
+// local runs and for a mail agent to pick up. Each message is written to
+// tmp and moved to new once complete.
+type MaildirOutbox struct {
+	Dir  string
+	From string
+	next atomic.Int64
+}
+
+// NewMaildirOutbox creates the tmp, new and cur directories of a maildir.
+func NewMaildirOutbox(dir, from string) (*MaildirOutbox, error) {
+	for _, sub := range []string{"tmp", "new", "cur"} {
+		if err := os.MkdirAll(filepath.Join(dir, sub), 0o755); err != nil {
+			return nil, fmt.Errorf("create outbox %s: %w", dir, err)
+		}
+	}
+	return &MaildirOutbox{Dir: dir, From: from}, nil
+}
+
+func (o *MaildirOutbox) Notify(ctx context.Context, msg Message) error {
+	host, err := os.Hostname()
+	if err != nil {
+		host = "localhost"
+	}
+	// maildir names are time.pid_counter.host, unique for the process
+	name := fmt.Sprintf("%d.%d_%d.%s", time.Now().Unix(), os.Getpid(), o.next.Add(1), strings.NewReplacer("/", "_", ":", "_").Replace(host))
+	data, err := formatMessage(o.From, msg)
+	if err != nil {
+		return err
+	}
+	tmp := filepath.Join(o.Dir, "tmp", name)
+	if err := os.WriteFile(tmp, data, 0o644); err != nil {
+		return fmt.Errorf("write %s: %w", tmp, err)
+	}
+	if err := os.Rename(tmp, filepath.Join(o.Dir, "new", name)); err != nil {
+		os.Remove(tmp)
+		return fmt.Errorf("deliver %s: %w", name, err)
+	}
+	return nil
+}
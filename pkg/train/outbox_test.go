@@ -0,0 +1,91 @@
+package reservation
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestMaildirOutbox(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "outbox")
+	outbox, err := NewMaildirOutbox(dir, "tickets@example.com")
+	if err != nil {
+		t.Fatalf("NewMaildirOutbox failed: %v", err)
+	}
+	ctx := context.Background()
+	msg := Message{
+		To:        "ada.moss@example.com",
+		Subject:   "Your ticket from London to Paris",
+		Body:      "Hello Ada,\n\nSeat: A1\n",
+		Event:     EventTicketPurchased,
+		Reference: "ABC123",
+		Time:      time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC),
+	}
+	for i := 0; i < 2; i++ {
+		if err := outbox.Notify(ctx, msg); err != nil {
+			t.Fatalf("Notify failed: %v", err)
+		}
+	}
+
+	delivered, err := os.ReadDir(filepath.Join(dir, "new"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(delivered) != 2 {
+		t.Fatalf("Expected 2 messages in new, got %d", len(delivered))
+	}
+	if pending, _ := os.ReadDir(filepath.Join(dir, "tmp")); len(pending) != 0 {
+		t.Errorf("Expected nothing left in tmp, got %d", len(pending))
+	}
+	data, err := os.ReadFile(filepath.Join(dir, "new", delivered[0].Name()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	mail := string(data)
+	for _, want := range []string{
+		"From: tickets@example.com\r\n",
+		"To: ada.moss@example.com\r\n",
+		"Subject: Your ticket from London to Paris\r\n",
+		"Date: Sun, 18 Oct 2026 12:00:00 +0000\r\n",
+		"X-Train-Event: ticket.purchased\r\n",
+		"X-Train-Reference: ABC123\r\n",
+		"\r\n\r\nHello Ada,\r\n\r\nSeat: A1\r\n",
+	} {
+		if !strings.Contains(mail, want) {
+			t.Errorf("Expected %q in the message, got %q", want, mail)
+		}
+	}
+}
+
+func TestMaildirOutboxHeaderInjection(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "outbox")
+	outbox, err := NewMaildirOutbox(dir, "tickets@example.com")
+	if err != nil {
+		t.Fatalf("NewMaildirOutbox failed: %v", err)
+	}
+	ctx := context.Background()
+	msg := Message{To: "ada.moss@example.com\r\nBcc: eve@example.com", Subject: "Your ticket", Event: EventTicketPurchased, Reference: "ABC123"}
+	if err := outbox.Notify(ctx, msg); err == nil {
+		t.Error("Expected a recipient with a line break to fail")
+	}
+
+	msg.To = "ada.moss@example.com"
+	msg.Subject = "Your ticket\r\nBcc: eve@example.com"
+	if err := outbox.Notify(ctx, msg); err != nil {
+		t.Fatalf("Notify failed: %v", err)
+	}
+	delivered, err := os.ReadDir(filepath.Join(dir, "new"))
+	if err != nil || len(delivered) != 1 {
+		t.Fatalf("Expected 1 message in new, got %d: %v", len(delivered), err)
+	}
+	data, err := os.ReadFile(filepath.Join(dir, "new", delivered[0].Name()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if mail := string(data); strings.Contains(mail, "\r\nBcc:") {
+		t.Errorf("Expected the subject kept on one line, got %q", mail)
+	}
+}
@@ -0,0 +1,227 @@
+package reservation
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	train "github.com/bijoyv/train/pkg/proto"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// a season ticket is priced as two rides a day at seasonDiscount
+	// percent off, for defaultSeasonDays unless the buyer picks up to
+	// maxSeasonDays
+	defaultSeasonDays = 30
+	maxSeasonDays     = 366
+	seasonRidesPerDay = 2
+	seasonDiscount    = 50
+
+	// a carnet is carnetRides rides at carnetDiscount percent off, to be
+	// used within carnetDays
+	carnetRides    = 10
+	carnetDays     = 90
+	carnetDiscount = 15
+)
+
+// pass is a season ticket or carnet and the tickets booked on it.
+type pass struct {
+	info    *train.Pass
+	tickets []*train.Ticket
+}
+
+// ride counts rides taken, or given back when n is negative.
+func (p *pass) ride(n int32) {
+	p.info.RidesUsed += n
+	if p.info.Rides > 0 {
+		p.info.RidesRemaining = p.info.Rides - p.info.RidesUsed
+	}
+}
+
+// passValidity works out the first day of a pass, the days it is valid and
+// its rides, zero for a season ticket.
+func (s *TrainService) passValidity(kind train.PassType, from, startDate string, days int32) (time.Time, int, int32, error) {
+	origin, err := s.stations.Resolve(from)
+	if err != nil {
+		return time.Time{}, 0, 0, err
+	}
+	zone, err := time.LoadLocation(origin.TimeZone)
+	if err != nil {
+		return time.Time{}, 0, 0, err
+	}
+	now := s.clock.Now().In(zone)
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, zone)
+	start := today
+	if startDate != "" {
+		if start, err = time.ParseInLocation(time.DateOnly, startDate, zone); err != nil {
+			return time.Time{}, 0, 0, fmt.Errorf("pass start date must be YYYY-MM-DD: %v", err)
+		}
+		if start.Before(today) {
+			return time.Time{}, 0, 0, fmt.Errorf("pass cannot start in the past, %s", startDate)
+		}
+	}
+	switch kind {
+	case train.PassType_PASS_TYPE_SEASON:
+		if days == 0 {
+			days = defaultSeasonDays
+		}
+		if days < 1 || days > maxSeasonDays {
+			return time.Time{}, 0, 0, fmt.Errorf("season ticket must run for 1 to %d days", maxSeasonDays)
+		}
+		return start, int(days), 0, nil
+	case train.PassType_PASS_TYPE_CARNET:
+		if days != 0 && days != carnetDays {
+			return time.Time{}, 0, 0, fmt.Errorf("carnet is valid for %d days", carnetDays)
+		}
+		return start, carnetDays, carnetRides, nil
+	}
+	return time.Time{}, 0, 0, fmt.Errorf("pass type must be season or carnet")
+}
+
+// passPrice prices a pass on the fare of a ride in an empty train.
+func (s *TrainService) passPrice(info *train.Pass, days int) (*train.Money, error) {
+	fare, err := s.fares.Price(FareQuery{
+		From:      info.From,
+		To:        info.To,
+		Class:     seatClass(info.SeatClass),
+		Passenger: passengerType(info.PassengerType),
+		Capacity:  1,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if info.Type == train.PassType_PASS_TYPE_CARNET {
+		return fare.money(fare.Total() * int64(info.Rides) * (100 - carnetDiscount) / 100), nil
+	}
+	return fare.money(fare.Total() * int64(days) * seasonRidesPerDay * (100 - seasonDiscount) / 100), nil
+}
+
+// PurchasePass sells a season ticket or a carnet between two stations, paid
+// up front. Rides are booked with PurchaseTicket.
+func (s *TrainService) PurchasePass(ctx context.Context, req *train.PurchasePassRequest) (*train.PurchasePassResponse, error) {
+	if err := validBooking(req.Purchaser, req.User); err != nil {
+		return nil, err
+	}
+	if req.User.GetEmail() == "" {
+		return nil, fmt.Errorf("a pass requires the passenger's email")
+	}
+	from, to, err := s.route(req.From, req.To)
+	if err != nil {
+		return nil, err
+	}
+	if from == "" || to == "" || from == to {
+		return nil, fmt.Errorf("a pass requires two different stations")
+	}
+	validFrom, days, rides, err := s.passValidity(req.Type, from, req.StartDate, req.Days)
+	if err != nil {
+		return nil, err
+	}
+	info := &train.Pass{
+		Type:           req.Type,
+		From:           from,
+		To:             to,
+		User:           req.User,
+		Purchaser:      purchaserFor(req.Purchaser, req.User),
+		SeatClass:      seatClass(req.SeatClass),
+		PassengerType:  passengerType(req.PassengerType),
+		ValidFrom:      timestamppb.New(validFrom),
+		ValidUntil:     timestamppb.New(validFrom.AddDate(0, 0, days)),
+		Rides:          rides,
+		RidesRemaining: rides,
+	}
+	if info.Price, err = s.passPrice(info, days); err != nil {
+		return nil, err
+	}
+	if info.PaymentId, err = s.payments.Authorize(ctx, info.Purchaser, info.Price); err != nil {
+		return nil, err
+	}
+	// nothing is booked on the pass yet, so it is captured before the
+	// pass exists
+	if err := s.payments.Capture(ctx, info.PaymentId); err != nil {
+		s.void(ctx, info.PaymentId)
+		return nil, fmt.Errorf("capture payment %s: %w", info.PaymentId, err)
+	}
+	info.PaymentStatus = train.PaymentStatus_PAYMENT_STATUS_CAPTURED
+
+	result := make(chan *train.Pass, 1)
+	s.ops <- func(st *state) {
+		info.Id = st.newReference()
+		st.passes[info.Id] = &pass{info: info}
+		result <- proto.Clone(info).(*train.Pass)
+	}
+	sold := <-result
+	return &train.PurchasePassResponse{Pass: sold}, nil
+}
+
+// GetPass returns a pass with the rides booked on it.
+func (s *TrainService) GetPass(ctx context.Context, req *train.GetPassRequest) (*train.GetPassResponse, error) {
+	er := make(chan error, 1)
+	result := make(chan *train.GetPassResponse, 1)
+	s.ops <- func(st *state) {
+		p, ok := st.passes[req.Id]
+		if !ok {
+			er <- fmt.Errorf("pass %s not found", req.Id)
+			return
+		}
+		result <- &train.GetPassResponse{Pass: proto.Clone(p.info).(*train.Pass), Tickets: snapshots(p.tickets)}
+	}
+	select {
+	case e := <-er:
+		return nil, e
+	case res := <-result:
+		return res, nil
+	}
+}
+
+// passRide checks the pass can pay for user's ride on [start, end) of j.
+// Passes are valid either way between their stations, for one passenger at
+// a time, so a pass holds at most one ticket on any part of a journey.
+func (s *TrainService) passRide(st *state, id string, j *journey, start, end int, user *train.User) (*pass, error) {
+	p, ok := st.passes[id]
+	if !ok {
+		return nil, fmt.Errorf("pass %s not found", id)
+	}
+	info := p.info
+	if info.User.Email != user.GetEmail() {
+		return nil, fmt.Errorf("pass %s belongs to another passenger", id)
+	}
+	from, to := j.info.Stops[start].Station, j.info.Stops[end].Station
+	if !(from == info.From && to == info.To) && !(from == info.To && to == info.From) {
+		return nil, fmt.Errorf("pass %s is valid between %s and %s, not from %s to %s", id, info.From, info.To, from, to)
+	}
+	when := j.departure(from)
+	if when.IsZero() {
+		when = s.clock.Now()
+	}
+	if when.Before(info.ValidFrom.AsTime()) || !when.Before(info.ValidUntil.AsTime()) {
+		return nil, fmt.Errorf("pass %s is not valid on %s", id, when.Format(time.DateOnly))
+	}
+	if info.Rides > 0 && info.RidesUsed >= info.Rides {
+		return nil, fmt.Errorf("pass %s has no rides left", id)
+	}
+	for _, t := range p.tickets {
+		if st.tickets[t.Reference] != t || t.JourneyId != j.info.Id {
+			continue
+		}
+		if booked, arrives, err := j.segment(t.From, t.To); err == nil && booked < end && start < arrives {
+			return nil, fmt.Errorf("pass %s already has ticket %s on journey %s", id, t.Reference, j.info.Id)
+		}
+	}
+	return p, nil
+}
+
+// passDiscount takes what the pass covers off fare, the fare of the ride in
+// the pass's class. A ride in a higher class pays the difference.
+func (s *TrainService) passDiscount(fare *Fare, p *pass, j *journey, start, end int) error {
+	covered, err := s.priceTicket(j, start, end, p.info.SeatClass, p.info.PassengerType)
+	if err != nil {
+		return err
+	}
+	fare.Components = append(fare.Components, FareComponent{
+		Description: fmt.Sprintf("%s pass %s", enumName(p.info.Type.String(), "PASS_TYPE_"), p.info.Id),
+		Amount:      -min(covered.Total(), fare.Total()),
+	})
+	return nil
+}
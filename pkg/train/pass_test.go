@@ -0,0 +1,172 @@
+package reservation
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	train "github.com/bijoyv/train/pkg/proto"
+)
+
+func TestPurchasePass(t *testing.T) {
+	payments := NewFakePaymentProvider()
+	fares := DefaultFarePolicy()
+	fares.ClassSupplements = map[string]int64{"first": 50}
+	trainService := NewTrainReservationService(WithClock(newFakeClock()), WithPaymentProvider(payments), WithFarePolicy(fares))
+	ctx := context.Background()
+	layout := &train.SeatLayout{Coaches: []*train.Coach{
+		{Code: "F", Rows: 2, Columns: "AB", SeatClass: train.SeatClass_SEAT_CLASS_FIRST},
+		{Code: "A", Rows: 10, Columns: "ABCD"},
+	}}
+	outbound := createTimetabledJourney(t, trainService, "9O12", "London", "Paris", "2026-10-19T09:00:00Z", layout)
+	back := createTimetabledJourney(t, trainService, "9O51", "Paris", "London", "2026-10-19T18:00:00Z", nil)
+	later := createTimetabledJourney(t, trainService, "9O14", "London", "Paris", "2026-10-30T09:00:00Z", nil)
+	// a train a day for the rest of the carnet
+	var daily []string
+	for day := 20; day < 30; day++ {
+		daily = append(daily, createTimetabledJourney(t, trainService, "9O16", "London", "Paris", fmt.Sprintf("2026-10-%dT09:00:00Z", day), nil))
+	}
+	commuter := &train.User{FirstName: "Sam", Email: "sam.reed@example.com"}
+
+	tests := []struct {
+		name  string
+		req   *train.PurchasePassRequest
+		price int64
+		rides int32
+		until string
+	}{
+		// ten rides at 20.00 less 15%
+		{"carnet", &train.PurchasePassRequest{Type: train.PassType_PASS_TYPE_CARNET, From: "LON", To: "Paris Nord", User: commuter}, 17000, 10, "2027-01-16"},
+		// two rides a day for a week at half price
+		{"season", &train.PurchasePassRequest{Type: train.PassType_PASS_TYPE_SEASON, From: "London", To: "Paris", User: commuter, Days: 7}, 14000, 0, "2026-10-25"},
+	}
+	london, err := time.LoadLocation("Europe/London")
+	if err != nil {
+		t.Fatal(err)
+	}
+	passes := make(map[string]*train.Pass)
+	for _, tt := range tests {
+		res, err := trainService.PurchasePass(ctx, tt.req)
+		if err != nil {
+			t.Fatalf("PurchasePass %s failed: %v", tt.name, err)
+		}
+		p := res.Pass
+		if p.Price.MinorUnits != tt.price || p.Rides != tt.rides || p.RidesRemaining != tt.rides || p.From != "London" || p.To != "Paris" {
+			t.Errorf("Expected a %s London to Paris for %d with %d rides, got %v", tt.name, tt.price, tt.rides, p)
+		}
+		if got := p.ValidUntil.AsTime().In(london).Format(time.DateOnly); got != tt.until {
+			t.Errorf("Expected the %s to run until %s, got %s", tt.name, tt.until, got)
+		}
+		if payment, _ := payments.Payment(p.PaymentId); payment.Status != train.PaymentStatus_PAYMENT_STATUS_CAPTURED || payment.Amount.MinorUnits != tt.price {
+			t.Errorf("Expected %d captured for the %s, got %v", tt.price, tt.name, payment)
+		}
+		passes[tt.name] = p
+	}
+	carnet, season := passes["carnet"], passes["season"]
+
+	ride := func(journey, from, to string, p *train.Pass, class train.SeatClass) (*train.Ticket, error) {
+		res, err := trainService.PurchaseTicket(ctx, &train.PurchaseTicketRequest{JourneyId: journey, From: from, To: to, User: commuter, PassId: p.Id, SeatClass: class})
+		if err != nil {
+			return nil, err
+		}
+		return res.Ticket, nil
+	}
+
+	t.Run("Redeem", func(t *testing.T) {
+		for _, r := range []struct{ journey, from, to string }{{outbound, "London", "Paris"}, {back, "Paris", "London"}} {
+			ticket, err := ride(r.journey, r.from, r.to, carnet, train.SeatClass_SEAT_CLASS_UNSPECIFIED)
+			if err != nil {
+				t.Fatalf("PurchaseTicket on a pass failed: %v", err)
+			}
+			if ticket.Price.MinorUnits != 0 || ticket.PaymentId != "" || ticket.PassId != carnet.Id {
+				t.Errorf("Expected a free ride on %s, got %v", carnet.Id, ticket)
+			}
+		}
+		got, err := trainService.GetPass(ctx, &train.GetPassRequest{Id: carnet.Id})
+		if err != nil {
+			t.Fatalf("GetPass failed: %v", err)
+		}
+		if got.Pass.RidesUsed != 2 || got.Pass.RidesRemaining != 8 || len(got.Tickets) != 2 {
+			t.Errorf("Expected 2 rides used and 8 left, got %d used and %d left", got.Pass.RidesUsed, got.Pass.RidesRemaining)
+		}
+	})
+
+	t.Run("FirstClass", func(t *testing.T) {
+		ticket, err := ride(outbound, "London", "Paris", season, train.SeatClass_SEAT_CLASS_FIRST)
+		if err != nil {
+			t.Fatalf("PurchaseTicket on a pass failed: %v", err)
+		}
+		if ticket.Price.MinorUnits != 1000 || ticket.PaymentStatus != train.PaymentStatus_PAYMENT_STATUS_CAPTURED {
+			t.Errorf("Expected the 1000 first class supplement paid, got %v", ticket)
+		}
+	})
+
+	t.Run("Cancel", func(t *testing.T) {
+		ticket, err := ride(daily[0], "London", "Paris", carnet, train.SeatClass_SEAT_CLASS_UNSPECIFIED)
+		if err != nil {
+			t.Fatalf("PurchaseTicket on a pass failed: %v", err)
+		}
+		if _, err := trainService.TransferTicket(ctx, &train.TransferTicketRequest{Reference: ticket.Reference, Email: ticket.Purchaser.Email, NewUser: &train.User{Email: "kit.reed@example.com"}}); err == nil {
+			t.Error("Expected a ride on a pass not to be transferable")
+		}
+		if _, err := trainService.RemoveUser(ctx, &train.RemoveUserRequest{Reference: ticket.Reference, Email: ticket.Purchaser.Email}); err != nil {
+			t.Fatalf("RemoveUser failed: %v", err)
+		}
+		got, _ := trainService.GetPass(ctx, &train.GetPassRequest{Id: carnet.Id})
+		if got.Pass.RidesRemaining != 8 {
+			t.Errorf("Expected the cancelled ride back on the carnet, got %d left", got.Pass.RidesRemaining)
+		}
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		invalid := []struct {
+			name string
+			req  *train.PurchaseTicketRequest
+		}{
+			{"another passenger", &train.PurchaseTicketRequest{JourneyId: outbound, From: "London", To: "Paris", User: &train.User{Email: "kit.reed@example.com"}, PassId: carnet.Id}},
+			{"another route", &train.PurchaseTicketRequest{JourneyId: DefaultJourneyID, From: "London", To: "Brussels", User: commuter, PassId: season.Id}},
+			{"a day after it ends", &train.PurchaseTicketRequest{JourneyId: later, From: "London", To: "Paris", User: commuter, PassId: season.Id}},
+			{"an unknown pass", &train.PurchaseTicketRequest{JourneyId: outbound, From: "London", To: "Paris", User: commuter, PassId: "NOPASS"}},
+			{"a second ride on the same train", &train.PurchaseTicketRequest{JourneyId: outbound, From: "London", To: "Paris", User: commuter, PassId: carnet.Id}},
+		}
+		for _, tt := range invalid {
+			if _, err := trainService.PurchaseTicket(ctx, tt.req); err == nil {
+				t.Errorf("Expected a ride with %s to fail", tt.name)
+			}
+		}
+	})
+
+	t.Run("UsedUp", func(t *testing.T) {
+		for _, journey := range daily[:8] {
+			if _, err := ride(journey, "London", "Paris", carnet, train.SeatClass_SEAT_CLASS_UNSPECIFIED); err != nil {
+				t.Fatalf("PurchaseTicket on a pass failed: %v", err)
+			}
+		}
+		if _, err := ride(daily[8], "London", "Paris", carnet, train.SeatClass_SEAT_CLASS_UNSPECIFIED); err == nil {
+			t.Error("Expected an eleventh ride on a carnet to fail")
+		}
+	})
+}
+
+func TestPurchasePassValidation(t *testing.T) {
+	trainService := NewTrainReservationService(WithClock(newFakeClock()))
+	ctx := context.Background()
+	user := &train.User{Email: "sam.reed@example.com"}
+	tests := []struct {
+		name string
+		req  *train.PurchasePassRequest
+	}{
+		{"no type", &train.PurchasePassRequest{From: "London", To: "Paris", User: user}},
+		{"one station", &train.PurchasePassRequest{Type: train.PassType_PASS_TYPE_SEASON, From: "London", To: "London", User: user}},
+		{"a start in the past", &train.PurchasePassRequest{Type: train.PassType_PASS_TYPE_SEASON, From: "London", To: "Paris", User: user, StartDate: "2026-10-17"}},
+		{"too many days", &train.PurchasePassRequest{Type: train.PassType_PASS_TYPE_SEASON, From: "London", To: "Paris", User: user, Days: 400}},
+		{"a carnet for a week", &train.PurchasePassRequest{Type: train.PassType_PASS_TYPE_CARNET, From: "London", To: "Paris", User: user, Days: 7}},
+		{"no email", &train.PurchasePassRequest{Type: train.PassType_PASS_TYPE_CARNET, From: "London", To: "Paris", User: &train.User{FirstName: "Sam"}}},
+	}
+	for _, tt := range tests {
+		if _, err := trainService.PurchasePass(ctx, tt.req); err == nil {
+			t.Errorf("Expected a pass with %s to fail", tt.name)
+		}
+	}
+}
@@ -0,0 +1,322 @@
+package reservation
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"sync"
+
+	train "github.com/bijoyv/train/pkg/proto"
+)
+
+// PaymentProvider takes payments for tickets. A purchase authorizes the
+// price before a seat is booked, captures it once the ticket is issued and
+// voids it when the booking fails. Providers are called off the actor so a
+// slow provider never holds up other bookings.
+type PaymentProvider interface {
+	Authorize(ctx context.Context, payer *train.Purchaser, amount *train.Money) (string, error)
+	Capture(ctx context.Context, paymentID string) error
+	Void(ctx context.Context, paymentID string) error
+	Refund(ctx context.Context, paymentID string, amount *train.Money) error
+}
+
+// FakePayment is a payment as the FakePaymentProvider sees it.
+type FakePayment struct {
+	ID       string
+	Email    string
+	Amount   *train.Money
+	Refunded int64
+	Status   train.PaymentStatus
+}
+
+// FakePaymentProvider keeps payments in memory for tests and local runs.
+// Payment ids are numbered in order and every payment succeeds unless the
+// payer's email was declined.
+type FakePaymentProvider struct {
+	mu       sync.Mutex
+	next     int
+	payments map[string]*FakePayment
+	declined map[string]bool
+}
+
+// NewFakePaymentProvider returns a provider without any payments.
+func NewFakePaymentProvider() *FakePaymentProvider {
+	return &FakePaymentProvider{
+		payments: make(map[string]*FakePayment),
+		declined: make(map[string]bool),
+	}
+}
+
+// Decline makes every later authorization for email fail.
+func (p *FakePaymentProvider) Decline(email string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.declined[email] = true
+}
+
+// Payment returns a copy of a payment.
+func (p *FakePaymentProvider) Payment(id string) (FakePayment, bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	payment, ok := p.payments[id]
+	if !ok {
+		return FakePayment{}, false
+	}
+	return *payment, true
+}
+
+func (p *FakePaymentProvider) Authorize(ctx context.Context, payer *train.Purchaser, amount *train.Money) (string, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.declined[payer.Email] {
+		return "", fmt.Errorf("payment declined for %s", payer.Email)
+	}
+	p.next++
+	id := fmt.Sprintf("PAY%06d", p.next)
+	p.payments[id] = &FakePayment{ID: id, Email: payer.Email, Amount: amount, Status: train.PaymentStatus_PAYMENT_STATUS_AUTHORIZED}
+	return id, nil
+}
+
+func (p *FakePaymentProvider) Capture(ctx context.Context, paymentID string) error {
+	return p.move(paymentID, train.PaymentStatus_PAYMENT_STATUS_AUTHORIZED, train.PaymentStatus_PAYMENT_STATUS_CAPTURED)
+}
+
+func (p *FakePaymentProvider) Void(ctx context.Context, paymentID string) error {
+	return p.move(paymentID, train.PaymentStatus_PAYMENT_STATUS_AUTHORIZED, train.PaymentStatus_PAYMENT_STATUS_VOIDED)
+}
+
+func (p *FakePaymentProvider) Refund(ctx context.Context, paymentID string, amount *train.Money) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	payment, ok := p.payments[paymentID]
+	if !ok {
+		return fmt.Errorf("payment %s not found", paymentID)
+	}
+	if payment.Status != train.PaymentStatus_PAYMENT_STATUS_CAPTURED && payment.Status != train.PaymentStatus_PAYMENT_STATUS_REFUNDED {
+		return fmt.Errorf("payment %s is %s, only captured payments can be refunded", paymentID, payment.Status)
+	}
+	if amount.Currency != payment.Amount.Currency || payment.Refunded+amount.MinorUnits > payment.Amount.MinorUnits {
+		return fmt.Errorf("payment %s cannot refund %d %s", paymentID, amount.MinorUnits, amount.Currency)
+	}
+	payment.Refunded += amount.MinorUnits
+	payment.Status = train.PaymentStatus_PAYMENT_STATUS_REFUNDED
+	return nil
+}
+
+func (p *FakePaymentProvider) move(paymentID string, from, to train.PaymentStatus) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	payment, ok := p.payments[paymentID]
+	if !ok {
+		return fmt.Errorf("payment %s not found", paymentID)
+	}
+	if payment.Status != from {
+		return fmt.Errorf("payment %s is %s, expected %s", paymentID, payment.Status, from)
+	}
+	payment.Status = to
+	return nil
+}
+
+// quote prices a trip for the payment to be authorized, the ticket is later
+// sold at this price even when the fare moved in between. A ride on a pass
+// is priced less what the pass covers.
+func (s *TrainService) quote(req *train.PurchaseTicketRequest, from, to string) (*Fare, error) {
+	er := make(chan error, 1)
+	result := make(chan *Fare, 1)
+
+	s.ops <- func(st *state) {
+		j, err := st.openJourney(req.JourneyId)
+		if err != nil {
+			er <- err
+			return
+		}
+		start, end, err := j.segment(from, to)
+		if err != nil {
+			er <- err
+			return
+		}
+		fare, err := s.priceRequest(st, j, start, end, req)
+		if err != nil {
+			er <- err
+			return
+		}
+		result <- fare
+	}
+	select {
+	case e := <-er:
+		return nil, e
+	case fare := <-result:
+		return fare, nil
+	}
+}
+
+// priceRequest prices a ticket on [start, end) of j for req, less what its
+// pass covers.
+func (s *TrainService) priceRequest(st *state, j *journey, start, end int, req *train.PurchaseTicketRequest) (*Fare, error) {
+	fare, err := s.priceTicket(j, start, end, req.SeatClass, req.PassengerType)
+	if err != nil {
+		return nil, err
+	}
+	if req.PassId != "" {
+		p, err := s.passRide(st, req.PassId, j, start, end, req.User)
+		if err == nil {
+			err = s.passDiscount(fare, p, j, start, end)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	return fare, nil
+}
+
+// priceChanged fails a booking whose fare, priced again when the seat is
+// taken, is no longer the one authorized. Other bookings made while the
+// payment was authorized may have moved the train into another bucket.
+func priceChanged(authorized, current *Fare) error {
+	if current.Total() != authorized.Total() {
+		return fmt.Errorf("the price changed to %d %s, please try again", current.Total(), current.Currency)
+	}
+	return nil
+}
+
+// settle captures the payment of a freshly issued ticket. When the capture
+// fails the ticket is taken back and the authorization voided.
+func (s *TrainService) settle(ctx context.Context, ticket *train.Ticket) (*train.Ticket, error) {
+	tickets, err := s.settleAll(ctx, ticket.PaymentId, []*train.Ticket{ticket})
+	if err != nil {
+		return nil, err
+	}
+	return tickets[0], nil
+}
+
+// settleAll captures a payment taken for several tickets issued together,
+// when the capture fails all of them are taken back.
+func (s *TrainService) settleAll(ctx context.Context, paymentID string, tickets []*train.Ticket) ([]*train.Ticket, error) {
+	if err := s.payments.Capture(ctx, paymentID); err != nil {
+		done := make(chan bool, 1)
+		s.ops <- func(st *state) {
+			for _, t := range st.paidTickets(paymentID) {
+				if t.Cancellation != nil {
+					continue
+				}
+				st.drop(t)
+				if j, ok := st.journeys[t.JourneyId]; ok {
+					s.seatsFreed(st, j)
+				}
+			}
+			done <- true
+		}
+		<-done
+		s.void(ctx, paymentID)
+		return nil, fmt.Errorf("capture payment %s: %w", paymentID, err)
+	}
+	// only a paid sale is invoiced, in the order the tickets were issued,
+	// and the tickets are returned in that order
+	result := make(chan []*train.Ticket, 1)
+	s.ops <- func(st *state) {
+		paid := make(map[string]*train.Ticket)
+		for _, t := range st.paidTickets(paymentID) {
+			paid[t.Reference] = t
+		}
+		settled := make([]*train.Ticket, len(tickets))
+		for i, t := range tickets {
+			live, ok := paid[t.Reference]
+			if !ok {
+				t.PaymentStatus = train.PaymentStatus_PAYMENT_STATUS_CAPTURED
+				settled[i] = t
+				continue
+			}
+			live.PaymentStatus = train.PaymentStatus_PAYMENT_STATUS_CAPTURED
+			if live.InvoiceNumber == "" {
+				s.invoice(st, live)
+			}
+			settled[i] = snapshot(live)
+		}
+		result <- settled
+	}
+	return <-result, nil
+}
+
+// void gives up an authorization, there is nothing left to undo when that
+// fails so it is only logged. A sale without a payment has nothing to void.
+func (s *TrainService) void(ctx context.Context, paymentID string) {
+	if paymentID == "" {
+		return
+	}
+	if err := s.payments.Void(ctx, paymentID); err != nil {
+		log.Printf("void payment %s: %v", paymentID, err)
+	}
+}
+
+// refund pays a cancelled ticket's refund back, to the upgrade payments
+// first, latest first, and what is left to the original payment.
+func (s *TrainService) refund(ctx context.Context, t *train.Ticket) error {
+	left := t.Cancellation.Refund.MinorUnits
+	pay := func(paymentID string, most int64) error {
+		amount := min(left, most)
+		if paymentID == "" || amount <= 0 {
+			return nil
+		}
+		if err := s.payments.Refund(ctx, paymentID, &train.Money{Currency: t.Cancellation.Refund.Currency, MinorUnits: amount}); err != nil {
+			return err
+		}
+		s.setPaymentStatus(paymentID, train.PaymentStatus_PAYMENT_STATUS_REFUNDED)
+		left -= amount
+		return nil
+	}
+	for i := len(t.UpgradePayments) - 1; i >= 0; i-- {
+		if p := t.UpgradePayments[i]; p.Status == train.PaymentStatus_PAYMENT_STATUS_CAPTURED {
+			if err := pay(p.Id, p.Amount.MinorUnits); err != nil {
+				return err
+			}
+		}
+	}
+	return pay(t.PaymentId, left)
+}
+
+// setPaymentStatus records a payment's new status on the tickets it paid
+// for and returns copies of them, none when the tickets are gone.
+func (s *TrainService) setPaymentStatus(paymentID string, status train.PaymentStatus) []*train.Ticket {
+	result := make(chan []*train.Ticket, 1)
+	s.ops <- func(st *state) {
+		tickets := st.paidTickets(paymentID)
+		for _, ticket := range tickets {
+			if p := upgradePayment(ticket, paymentID); p != nil {
+				p.Status = status
+			} else {
+				ticket.PaymentStatus = status
+			}
+		}
+		result <- snapshots(tickets)
+	}
+	return <-result
+}
+
+// paidTickets finds the tickets, active or cancelled, a payment was taken
+// for, the legs of a round trip or itinerary share one payment. Payments are looked up by
+// id since transfers change the reference.
+func (st *state) paidTickets(paymentID string) []*train.Ticket {
+	var paid []*train.Ticket
+	for _, tickets := range []map[string]*train.Ticket{st.tickets, st.cancelled} {
+		for _, t := range tickets {
+			if t.PaymentId == paymentID || upgradePayment(t, paymentID) != nil {
+				paid = append(paid, t)
+			}
+		}
+	}
+	sort.Slice(paid, func(a, b int) bool {
+		return legOrder(paid[a]) < legOrder(paid[b])
+	})
+	return paid
+}
+
+// upgradePayment finds one of the ticket's upgrade payments.
+func upgradePayment(t *train.Ticket, paymentID string) *train.Payment {
+	for _, p := range t.UpgradePayments {
+		if p.Id == paymentID {
+			return p
+		}
+	}
+	return nil
+}
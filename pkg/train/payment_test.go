@@ -0,0 +1,132 @@
+package reservation
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	train "github.com/bijoyv/train/pkg/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// failingCapture authorizes payments but never captures them.
+type failingCapture struct {
+	*FakePaymentProvider
+}
+
+func (failingCapture) Capture(ctx context.Context, paymentID string) error {
+	return fmt.Errorf("capture of %s timed out", paymentID)
+}
+
+func TestPurchasePayment(t *testing.T) {
+	payments := NewFakePaymentProvider()
+	trainService := NewTrainReservationService(WithPaymentProvider(payments))
+	ctx := context.Background()
+
+	res, err := trainService.PurchaseTicket(ctx, &train.PurchaseTicketRequest{
+		From: "London",
+		To:   "Paris",
+		User: &train.User{Email: "ola.reid@example.com"},
+	})
+	if err != nil {
+		t.Fatalf("PurchaseTicket failed: %v", err)
+	}
+	ticket := res.Ticket
+	if ticket.PaymentId != "PAY000001" || ticket.PaymentStatus != train.PaymentStatus_PAYMENT_STATUS_CAPTURED {
+		t.Errorf("Expected captured payment PAY000001, got %s %s", ticket.PaymentId, ticket.PaymentStatus)
+	}
+	payment, _ := payments.Payment(ticket.PaymentId)
+	if payment.Amount.MinorUnits != ticket.Price.MinorUnits || payment.Status != train.PaymentStatus_PAYMENT_STATUS_CAPTURED {
+		t.Errorf("Expected %d captured, got %d %s", ticket.Price.MinorUnits, payment.Amount.MinorUnits, payment.Status)
+	}
+
+	t.Run("Refund", func(t *testing.T) {
+		removed, err := trainService.RemoveUser(ctx, &train.RemoveUserRequest{Reference: ticket.Reference, Email: ticket.Purchaser.Email})
+		if err != nil {
+			t.Fatalf("RemoveUser failed: %v", err)
+		}
+		payment, _ := payments.Payment(ticket.PaymentId)
+		if payment.Refunded != removed.Cancellation.Refund.MinorUnits || payment.Status != train.PaymentStatus_PAYMENT_STATUS_REFUNDED {
+			t.Errorf("Expected %d refunded, got %d %s", removed.Cancellation.Refund.MinorUnits, payment.Refunded, payment.Status)
+		}
+		got, err := trainService.GetTicket(ctx, &train.GetTicketRequest{Reference: ticket.Reference})
+		if err != nil {
+			t.Fatalf("GetTicket failed: %v", err)
+		}
+		if got.Ticket.PaymentStatus != train.PaymentStatus_PAYMENT_STATUS_REFUNDED {
+			t.Errorf("Expected the ticket to show the refund, got %s", got.Ticket.PaymentStatus)
+		}
+	})
+
+	t.Run("Declined", func(t *testing.T) {
+		payments.Decline("pat.reid@example.com")
+		_, err := trainService.PurchaseTicket(ctx, &train.PurchaseTicketRequest{
+			From: "London",
+			To:   "Paris",
+			User: &train.User{Email: "pat.reid@example.com"},
+		})
+		if err == nil {
+			t.Fatal("Expected a declined payment to fail the purchase")
+		}
+		list, _ := trainService.ListTicketsByUser(ctx, &train.ListTicketsByUserRequest{Email: "pat.reid@example.com"})
+		if len(list.Tickets) != 0 {
+			t.Errorf("Expected no tickets, got %d", len(list.Tickets))
+		}
+	})
+
+	t.Run("BookingFails", func(t *testing.T) {
+		journey, err := trainService.CreateJourney(ctx, &train.CreateJourneyRequest{
+			TrainNumber: "9O22",
+			Departure:   timestamppb.New(time.Date(2026, 11, 2, 9, 0, 0, 0, time.UTC)),
+			Origin:      "London",
+			Destination: "Paris",
+			Layout: &train.SeatLayout{Coaches: []*train.Coach{
+				{Code: "A", Seats: []*train.SeatDefinition{{Id: "A1"}}},
+			}},
+		})
+		if err != nil {
+			t.Fatalf("CreateJourney failed: %v", err)
+		}
+		for _, email := range []string{"quin.reid@example.com", "rob.reid@example.com"} {
+			trainService.PurchaseTicket(ctx, &train.PurchaseTicketRequest{
+				From:      "London",
+				To:        "Paris",
+				User:      &train.User{Email: email},
+				JourneyId: journey.Journey.Id,
+			})
+		}
+		payment, _ := payments.Payment("PAY000003")
+		if payment.Email != "rob.reid@example.com" || payment.Status != train.PaymentStatus_PAYMENT_STATUS_VOIDED {
+			t.Errorf("Expected the authorization for the full train to be voided, got %+v", payment)
+		}
+	})
+}
+
+func TestPurchaseCaptureFails(t *testing.T) {
+	payments := failingCapture{NewFakePaymentProvider()}
+	trainService := NewTrainReservationService(WithPaymentProvider(payments))
+	ctx := context.Background()
+
+	_, err := trainService.PurchaseTicket(ctx, &train.PurchaseTicketRequest{
+		From: "London",
+		To:   "Paris",
+		User: &train.User{Email: "rae.reid@example.com"},
+	})
+	if err == nil {
+		t.Fatal("Expected a failed capture to fail the purchase")
+	}
+	payment, _ := payments.Payment("PAY000001")
+	if payment.Status != train.PaymentStatus_PAYMENT_STATUS_VOIDED {
+		t.Errorf("Expected the authorization to be voided, got %s", payment.Status)
+	}
+	seats, err := trainService.GetSeatsBySection(ctx, &train.GetSeatsBySectionRequest{Section: "A"})
+	if err != nil {
+		t.Fatalf("GetSeatsBySection failed: %v", err)
+	}
+	for id, name := range seats.Seats {
+		if name != "" {
+			t.Errorf("Expected every seat to be free, %s is taken by %s", id, name)
+		}
+	}
+}
@@ -0,0 +1,80 @@
+package reservation
+
+import (
+	"fmt"
+	"sort"
+
+	train "github.com/bijoyv/train/pkg/proto"
+)
+
+// FareBucket marks up fares while the share of seats sold is below UpTo
+// percent of capacity.
+type FareBucket struct {
+	UpTo   int64 `json:"upTo"`
+	Markup int64 `json:"markup"`
+}
+
+// DynamicFarePolicy yield manages a base policy on the load factor of the
+// journey segment being priced, for example the first 50% of seats at the
+// base fare, the next 30% at +25% and the rest at +60%.
+type DynamicFarePolicy struct {
+	Base    FarePolicy
+	Buckets []FareBucket
+}
+
+// NewDynamicFarePolicy wraps base with load factor buckets.
+func NewDynamicFarePolicy(base FarePolicy, buckets []FareBucket) *DynamicFarePolicy {
+	sorted := append([]FareBucket(nil), buckets...)
+	sort.Slice(sorted, func(a, b int) bool {
+		return sorted[a].UpTo < sorted[b].UpTo
+	})
+	return &DynamicFarePolicy{Base: base, Buckets: sorted}
+}
+
+func (p *DynamicFarePolicy) Price(q FareQuery) (*Fare, error) {
+	fare, err := p.Base.Price(q)
+	if err != nil {
+		return nil, err
+	}
+	bucket, ok := p.bucket(q.Sold, q.Capacity)
+	if !ok {
+		return nil, fmt.Errorf("no fare bucket for %d of %d seats sold", q.Sold, q.Capacity)
+	}
+	if bucket.Markup != 0 {
+		fare.Components = append(fare.Components, FareComponent{
+			Description: fmt.Sprintf("Demand surcharge %+d%%", bucket.Markup),
+			Amount:      fare.Total() * bucket.Markup / 100,
+		})
+	}
+	return fare, nil
+}
+
+// bucket picks the first bucket whose UpTo is above the current load factor.
+// The last bucket is open ended, so a full train is priced by it.
+func (p *DynamicFarePolicy) bucket(sold, capacity int) (FareBucket, bool) {
+	if capacity <= 0 || len(p.Buckets) == 0 {
+		return FareBucket{}, false
+	}
+	for _, b := range p.Buckets {
+		if int64(sold)*100 < b.UpTo*int64(capacity) {
+			return b, true
+		}
+	}
+	return p.Buckets[len(p.Buckets)-1], true
+}
+
+// load counts the seats of a class taken on any leg of [start, end), each
+// class fills up on its own. Seats out of service are not for sale, so they
+// count neither as sold nor towards the capacity.
+func (j *journey) load(start, end int, class train.SeatClass) (sold, capacity int) {
+	for _, seat := range j.seats {
+		if seat.class != class || seat.blocked {
+			continue
+		}
+		capacity++
+		if !seat.free(start, end) {
+			sold++
+		}
+	}
+	return sold, capacity
+}
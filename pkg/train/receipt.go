@@ -0,0 +1,70 @@
+package reservation
+
+import (
+	"context"
+	"fmt"
+
+	train "github.com/bijoyv/train/pkg/proto"
+	"github.com/bijoyv/train/pkg/receipt"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// invoice gives a ticket the next invoice number once it is paid for, so
+// numbers follow the order of sales and a sale that fails to capture leaves
+// no gap.
+func (s *TrainService) invoice(st *state, ticket *train.Ticket) {
+	ticket.InvoiceNumber, ticket.InvoicedAt = s.nextInvoice(st)
+}
+
+// nextInvoice numbers the next invoice, for a sale or a payment taken after it.
+func (s *TrainService) nextInvoice(st *state) (string, *timestamppb.Timestamp) {
+	st.invoices++
+	return fmt.Sprintf("INV-%06d", st.invoices), timestamppb.New(s.clock.Now())
+}
+
+// GetReceipt renders the receipt of a ticket, cancelled tickets included.
+// Receipts are billing documents so only go to the purchaser.
+func (s *TrainService) GetReceipt(ctx context.Context, req *train.GetReceiptRequest) (*train.GetReceiptResponse, error) {
+	if _, ok := train.ReceiptFormat_name[int32(req.Format)]; !ok {
+		return nil, fmt.Errorf("unknown receipt format %d", req.Format)
+	}
+	if req.Email == "" {
+		return nil, errPurchaserEmail
+	}
+	er := make(chan error, 1)
+	result := make(chan *train.Ticket, 1)
+
+	s.ops <- func(st *state) {
+		ticket, err := st.findPurchase(req.Reference, req.Email)
+		if cancelled, ok := st.cancelledTicket(req.Reference, req.Email, purchasedBy); err != nil && ok {
+			ticket, err = cancelled, nil
+		}
+		if err != nil {
+			er <- err
+			return
+		}
+		result <- snapshot(ticket)
+	}
+	var ticket *train.Ticket
+	select {
+	case e := <-er:
+		return nil, e
+	case ticket = <-result:
+	}
+
+	r := &receipt.Receipt{
+		InvoiceNumber: ticket.InvoiceNumber,
+		Issued:        ticket.InvoicedAt.AsTime(),
+		Ticket:        ticket,
+	}
+	content, err := receipt.Render(r, req.Format)
+	if err != nil {
+		return nil, err
+	}
+	return &train.GetReceiptResponse{
+		InvoiceNumber: r.InvoiceNumber,
+		ContentType:   receipt.ContentType(req.Format),
+		FileName:      receipt.FileName(r, req.Format),
+		Content:       content,
+	}, nil
+}
@@ -0,0 +1,105 @@
+package reservation
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"testing"
+
+	train "github.com/bijoyv/train/pkg/proto"
+)
+
+func TestGetReceipt(t *testing.T) {
+	trainService := NewTrainReservationService()
+	ctx := context.Background()
+	var tickets []*train.Ticket
+	for _, email := range []string{"tom.shaw@example.com", "uma.shaw@example.com"} {
+		res, err := trainService.PurchaseTicket(ctx, &train.PurchaseTicketRequest{
+			From: "London",
+			To:   "Paris",
+			User: &train.User{Email: email},
+		})
+		if err != nil {
+			t.Fatalf("PurchaseTicket failed: %v", err)
+		}
+		tickets = append(tickets, res.Ticket)
+	}
+	if tickets[0].InvoiceNumber != "INV-000001" || tickets[1].InvoiceNumber != "INV-000002" {
+		t.Errorf("Expected invoices numbered in the order tickets were sold, got %s and %s", tickets[0].InvoiceNumber, tickets[1].InvoiceNumber)
+	}
+
+	receipt := func(ticket *train.Ticket, format train.ReceiptFormat) *train.GetReceiptResponse {
+		t.Helper()
+		res, err := trainService.GetReceipt(ctx, &train.GetReceiptRequest{Reference: ticket.Reference, Email: ticket.Purchaser.Email, Format: format})
+		if err != nil {
+			t.Fatalf("GetReceipt failed: %v", err)
+		}
+		return res
+	}
+	second := receipt(tickets[1], train.ReceiptFormat_RECEIPT_FORMAT_PDF)
+	first := receipt(tickets[0], train.ReceiptFormat_RECEIPT_FORMAT_TEXT)
+	if second.InvoiceNumber != "INV-000002" || first.InvoiceNumber != "INV-000001" {
+		t.Errorf("Expected receipts to carry the invoice numbers of the sales, got %s and %s", second.InvoiceNumber, first.InvoiceNumber)
+	}
+	if second.FileName != "INV-000002.pdf" || second.ContentType != "application/pdf" || !bytes.HasPrefix(second.Content, []byte("%PDF")) {
+		t.Errorf("Expected a PDF named INV-000002.pdf, got %s %s", second.FileName, second.ContentType)
+	}
+
+	t.Run("Again", func(t *testing.T) {
+		again := receipt(tickets[1], train.ReceiptFormat_RECEIPT_FORMAT_HTML)
+		if again.InvoiceNumber != "INV-000002" {
+			t.Errorf("Expected the ticket to keep INV-000002, got %s", again.InvoiceNumber)
+		}
+	})
+
+	t.Run("Cancelled", func(t *testing.T) {
+		if _, err := trainService.RemoveUser(ctx, &train.RemoveUserRequest{Reference: tickets[0].Reference, Email: "tom.shaw@example.com"}); err != nil {
+			t.Fatalf("RemoveUser failed: %v", err)
+		}
+		res := receipt(tickets[0], train.ReceiptFormat_RECEIPT_FORMAT_TEXT)
+		if res.InvoiceNumber != "INV-000001" || !bytes.Contains(res.Content, []byte("Refunded")) {
+			t.Errorf("Expected INV-000001 to show the refund, got %s\n%s", res.InvoiceNumber, res.Content)
+		}
+	})
+
+	t.Run("UnknownFormat", func(t *testing.T) {
+		if _, err := trainService.GetReceipt(ctx, &train.GetReceiptRequest{Reference: tickets[0].Reference, Email: "tom.shaw@example.com", Format: 9}); err == nil {
+			t.Error("Expected an unknown format to fail")
+		}
+	})
+}
+
+// declinedCapture fails the capture of one payer's payments.
+type declinedCapture struct {
+	*FakePaymentProvider
+	payer string
+}
+
+func (p declinedCapture) Capture(ctx context.Context, paymentID string) error {
+	if payment, _ := p.Payment(paymentID); payment.Email == p.payer {
+		return fmt.Errorf("capture of %s declined", paymentID)
+	}
+	return p.FakePaymentProvider.Capture(ctx, paymentID)
+}
+
+func TestInvoiceNumbersWithoutGaps(t *testing.T) {
+	trainService := NewTrainReservationService(WithPaymentProvider(declinedCapture{NewFakePaymentProvider(), "vic.shaw@example.com"}))
+	ctx := context.Background()
+	var invoices []string
+	for _, email := range []string{"tom.shaw@example.com", "vic.shaw@example.com", "uma.shaw@example.com"} {
+		res, err := trainService.PurchaseTicket(ctx, &train.PurchaseTicketRequest{From: "London", To: "Paris", User: &train.User{Email: email}})
+		if email == "vic.shaw@example.com" {
+			if err == nil {
+				t.Error("Expected the purchase with a failed capture to fail")
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("PurchaseTicket failed: %v", err)
+		}
+		invoices = append(invoices, res.Ticket.InvoiceNumber)
+	}
+	if invoices[0] != "INV-000001" || invoices[1] != "INV-000002" {
+		t.Errorf("Expected the failed sale to take no invoice number, got %v", invoices)
+	}
+}
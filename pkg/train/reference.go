@@ -0,0 +1,105 @@
+package reservation
+
+import (
+	"context"
+	"fmt"
+	"math/rand/v2"
+	"sort"
+
+	train "github.com/bijoyv/train/pkg/proto"
+)
+
+// referenceAlphabet leaves out characters that are easily misread (0/O, 1/I).
+const referenceAlphabet = "ABCDEFGHJKLMNPQRSTUVWXYZ23456789"
+
+const referenceLength = 6
+
+// newReference returns a reference that has never been handed out before.
+func (st *state) newReference() string {
+	for {
+		b := make([]byte, referenceLength)
+		for i := range b {
+			b[i] = referenceAlphabet[rand.IntN(len(referenceAlphabet))]
+		}
+		ref := string(b)
+		if !st.references[ref] {
+			st.references[ref] = true
+			return ref
+		}
+	}
+}
+
+// ticketsFor lists the tickets email bought or travels on, ordered by
+// journey and reference.
+func (st *state) ticketsFor(email string, match func(*train.Ticket, string) bool) []*train.Ticket {
+	var tickets []*train.Ticket
+	for _, t := range st.tickets {
+		if match(t, email) {
+			tickets = append(tickets, t)
+		}
+	}
+	sort.Slice(tickets, func(a, b int) bool {
+		if tickets[a].JourneyId != tickets[b].JourneyId {
+			return tickets[a].JourneyId < tickets[b].JourneyId
+		}
+		return tickets[a].Reference < tickets[b].Reference
+	})
+	return tickets
+}
+
+// findTicket looks a ticket up for its purchaser or passenger, by booking
+// reference or, when no reference is given, by email as long as that email
+// holds a single ticket. A trip reference finds the first active leg of the
+// round trip. A reference without an email is enough to find the ticket.
+func (st *state) findTicket(reference, email string) (*train.Ticket, error) {
+	return st.lookup(reference, email, heldBy)
+}
+
+// findPurchase is findTicket for the things only a purchaser may do, a
+// reference alone is not enough, the purchaser's email has to match.
+func (st *state) findPurchase(reference, email string) (*train.Ticket, error) {
+	if email == "" {
+		return nil, errPurchaserEmail
+	}
+	return st.lookup(reference, email, purchasedBy)
+}
+
+var errPurchaserEmail = fmt.Errorf("the purchaser's email is required")
+
+func (st *state) lookup(reference, email string, match func(*train.Ticket, string) bool) (*train.Ticket, error) {
+	if reference != "" {
+		ticket, exists := st.tickets[reference]
+		if !exists {
+			ticket, exists = st.tripTicket(reference, false)
+		}
+		if !exists || (email != "" && !match(ticket, email)) {
+			return nil, fmt.Errorf("ticket %s not found", reference)
+		}
+		return ticket, nil
+	}
+	if email == "" {
+		return nil, fmt.Errorf("a booking reference or an email is required")
+	}
+	tickets := st.ticketsFor(email, match)
+	switch len(tickets) {
+	case 0:
+		return nil, fmt.Errorf("ticket not found for user %s", email)
+	case 1:
+		return tickets[0], nil
+	default:
+		return nil, fmt.Errorf("user %s holds %d tickets, use a booking reference", email, len(tickets))
+	}
+}
+
+// ListTicketsByUser returns every ticket an email bought or travels on.
+func (s *TrainService) ListTicketsByUser(ctx context.Context, req *train.ListTicketsByUserRequest) (*train.ListTicketsByUserResponse, error) {
+	if req.Email == "" {
+		return nil, fmt.Errorf("email is required")
+	}
+	result := make(chan []*train.Ticket, 1)
+
+	s.ops <- func(st *state) {
+		result <- snapshots(st.ticketsFor(req.Email, heldBy))
+	}
+	return &train.ListTicketsByUserResponse{Tickets: <-result}, nil
+}
@@ -0,0 +1,174 @@
+package reservation
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"time"
+
+	train "github.com/bijoyv/train/pkg/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Refund is what a cancelled ticket gets back, in the minor units of
+// Currency. Rule says which part of the policy applied.
+type Refund struct {
+	Currency string
+	Amount   int64
+	Fee      int64
+	Rule     string
+}
+
+// RefundPolicy decides the refund for cancelling a ticket. departure is when
+// the ticket's train leaves its boarding station, zero when unknown.
+type RefundPolicy interface {
+	Refund(ticket *train.Ticket, departure, now time.Time) (*Refund, error)
+}
+
+// RefundTier refunds Percent of the price when cancelling at least Before
+// ahead of departure.
+type RefundTier struct {
+	Before  time.Duration
+	Percent int64
+}
+
+// TieredRefundPolicy refunds a share of the price that shrinks as departure
+// gets closer, less a flat cancellation Fee. The first tier the cancellation
+// is early enough for applies, nothing is refunded once every tier has
+// passed or when the fare is non-refundable. Journeys without a departure
+// time get the first tier.
+type TieredRefundPolicy struct {
+	Tiers []RefundTier
+	Fee   int64
+}
+
+// DefaultRefundPolicy refunds in full up to two days before departure and
+// half up to departure, less a 1.00 fee.
+func DefaultRefundPolicy() *TieredRefundPolicy {
+	return NewTieredRefundPolicy([]RefundTier{
+		{Before: 48 * time.Hour, Percent: 100},
+		{Before: 0, Percent: 50},
+	}, 100)
+}
+
+// NewTieredRefundPolicy orders tiers from the earliest cancellation to the
+// latest.
+func NewTieredRefundPolicy(tiers []RefundTier, fee int64) *TieredRefundPolicy {
+	sorted := append([]RefundTier(nil), tiers...)
+	sort.Slice(sorted, func(a, b int) bool {
+		return sorted[a].Before > sorted[b].Before
+	})
+	return &TieredRefundPolicy{Tiers: sorted, Fee: fee}
+}
+
+func (p *TieredRefundPolicy) Refund(ticket *train.Ticket, departure, now time.Time) (*Refund, error) {
+	refund := &Refund{Currency: ticket.Price.GetCurrency()}
+	if ticket.NonRefundable {
+		refund.Rule = "non-refundable fare"
+		return refund, nil
+	}
+	tier, ok := p.tier(departure, now)
+	if !ok {
+		refund.Rule = "cancelled after departure"
+		return refund, nil
+	}
+	amount := ticket.Price.GetMinorUnits() * tier.Percent / 100
+	refund.Fee = min(p.Fee, amount)
+	refund.Amount = amount - refund.Fee
+	refund.Rule = fmt.Sprintf("%d%% refund at least %s before departure", tier.Percent, tier.Before)
+	return refund, nil
+}
+
+func (p *TieredRefundPolicy) tier(departure, now time.Time) (RefundTier, bool) {
+	if len(p.Tiers) == 0 {
+		return RefundTier{}, false
+	}
+	if departure.IsZero() {
+		return p.Tiers[0], true
+	}
+	for _, tier := range p.Tiers {
+		if !now.After(departure.Add(-tier.Before)) {
+			return tier, true
+		}
+	}
+	return RefundTier{}, false
+}
+
+// refundConfig is the file format of LoadRefundPolicy, durations are Go
+// durations such as "48h".
+type refundConfig struct {
+	Fee   int64 `json:"fee"`
+	Tiers []struct {
+		Before  string `json:"before"`
+		Percent int64  `json:"percent"`
+	} `json:"tiers"`
+}
+
+// LoadRefundPolicy reads a TieredRefundPolicy from a JSON file.
+func LoadRefundPolicy(path string) (*TieredRefundPolicy, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read refund policy: %w", err)
+	}
+	config := &refundConfig{}
+	if err := json.Unmarshal(data, config); err != nil {
+		return nil, fmt.Errorf("parse refund policy %s: %w", path, err)
+	}
+	tiers := make([]RefundTier, 0, len(config.Tiers))
+	for _, t := range config.Tiers {
+		before, err := time.ParseDuration(t.Before)
+		if err != nil {
+			return nil, fmt.Errorf("refund policy %s: %w", path, err)
+		}
+		tiers = append(tiers, RefundTier{Before: before, Percent: t.Percent})
+	}
+	return NewTieredRefundPolicy(tiers, config.Fee), nil
+}
+
+// cancel frees the seat of a ticket and keeps it as a cancellation record.
+func (s *TrainService) cancel(st *state, ticket *train.Ticket) (*train.Cancellation, error) {
+	j, err := st.journey(ticket.JourneyId)
+	if err != nil {
+		return nil, err
+	}
+	refund, err := s.refunds.Refund(ticket, j.departure(ticket.From), s.clock.Now())
+	if err != nil {
+		return nil, err
+	}
+	return s.cancelWith(st, j, ticket, refund), nil
+}
+
+// cancelWith cancels a ticket on j with refund, whatever the refund policy
+// would give.
+func (s *TrainService) cancelWith(st *state, j *journey, ticket *train.Ticket, refund *Refund) *train.Cancellation {
+	now := s.clock.Now()
+	departure := j.departure(ticket.From)
+	st.drop(ticket)
+	ticket.Cancellation = &train.Cancellation{
+		CancelledAt: timestamppb.New(now),
+		Refund:      &train.Money{Currency: refund.Currency, MinorUnits: refund.Amount},
+		Fee:         &train.Money{Currency: refund.Currency, MinorUnits: refund.Fee},
+		Rule:        refund.Rule,
+	}
+	st.cancelled[ticket.Reference] = ticket
+	if p, ok := st.passes[ticket.PassId]; ok && (departure.IsZero() || now.Before(departure)) {
+		// a ride cancelled before the train leaves goes back on the pass
+		p.ride(-1)
+	}
+	s.seatsFreed(st, j)
+	return ticket.Cancellation
+}
+
+// cancelledTicket looks up the cancellation record of a booking reference
+// for an email that matches.
+func (st *state) cancelledTicket(reference, email string, match func(*train.Ticket, string) bool) (*train.Ticket, bool) {
+	ticket, ok := st.cancelled[reference]
+	if !ok {
+		ticket, ok = st.tripTicket(reference, true)
+	}
+	if !ok || (email != "" && !match(ticket, email)) {
+		return nil, false
+	}
+	return ticket, true
+}
@@ -0,0 +1,120 @@
+package reservation
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	train "github.com/bijoyv/train/pkg/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestTieredRefundPolicy(t *testing.T) {
+	policy := DefaultRefundPolicy()
+	departure := time.Date(2026, 10, 20, 9, 0, 0, 0, time.UTC)
+	ticket := &train.Ticket{Reference: "ABC234", Price: &train.Money{Currency: "GBP", MinorUnits: 2000}}
+	tests := []struct {
+		name      string
+		ticket    *train.Ticket
+		departure time.Time
+		now       time.Time
+		amount    int64
+		fee       int64
+	}{
+		{"early", ticket, departure, departure.Add(-72 * time.Hour), 1900, 100},
+		{"late", ticket, departure, departure.Add(-time.Hour), 900, 100},
+		{"departed", ticket, departure, departure.Add(time.Minute), 0, 0},
+		{"no departure", ticket, time.Time{}, departure, 1900, 100},
+		{"non-refundable", &train.Ticket{Price: ticket.Price, NonRefundable: true}, departure, departure.Add(-72 * time.Hour), 0, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			refund, err := policy.Refund(tt.ticket, tt.departure, tt.now)
+			if err != nil {
+				t.Fatalf("Refund failed: %v", err)
+			}
+			if refund.Amount != tt.amount || refund.Fee != tt.fee {
+				t.Errorf("Expected a refund of %d less %d, got %d less %d (%s)", tt.amount, tt.fee, refund.Amount, refund.Fee, refund.Rule)
+			}
+		})
+	}
+}
+
+func TestLoadRefundPolicy(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "refunds.json")
+	config := `{"fee": 250, "tiers": [{"before": "0s", "percent": 25}, {"before": "168h", "percent": 100}]}`
+	if err := os.WriteFile(path, []byte(config), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	policy, err := LoadRefundPolicy(path)
+	if err != nil {
+		t.Fatalf("LoadRefundPolicy failed: %v", err)
+	}
+	if len(policy.Tiers) != 2 || policy.Tiers[0].Before != 168*time.Hour || policy.Fee != 250 {
+		t.Errorf("Expected the 168h tier first and a fee of 250, got %+v", policy)
+	}
+}
+
+func TestCancellation(t *testing.T) {
+	clock := newFakeClock()
+	trainService := NewTrainReservationService(WithClock(clock))
+	ctx := context.Background()
+	journey, err := trainService.CreateJourney(ctx, &train.CreateJourneyRequest{
+		TrainNumber: "9O20",
+		Departure:   timestamppb.New(clock.Now().Add(5 * time.Hour)),
+		Origin:      "London",
+		Destination: "Paris",
+	})
+	if err != nil {
+		t.Fatalf("CreateJourney failed: %v", err)
+	}
+	purchase, err := trainService.PurchaseTicket(ctx, &train.PurchaseTicketRequest{
+		From:      "London",
+		To:        "Paris",
+		User:      &train.User{Email: "ned.quinn@example.com"},
+		JourneyId: journey.Journey.Id,
+	})
+	if err != nil {
+		t.Fatalf("PurchaseTicket failed: %v", err)
+	}
+	ticket := purchase.Ticket
+
+	res, err := trainService.RemoveUser(ctx, &train.RemoveUserRequest{Reference: ticket.Reference, Email: ticket.Purchaser.Email})
+	if err != nil {
+		t.Fatalf("RemoveUser failed: %v", err)
+	}
+	if !res.Success || res.Cancellation.GetRefund().GetMinorUnits() != 900 || res.Cancellation.GetFee().GetMinorUnits() != 100 {
+		t.Errorf("Expected half of 2000 refunded less a fee of 100, got %v", res.Cancellation)
+	}
+
+	t.Run("Record", func(t *testing.T) {
+		got, err := trainService.GetTicket(ctx, &train.GetTicketRequest{Reference: ticket.Reference})
+		if err != nil {
+			t.Fatalf("GetTicket failed: %v", err)
+		}
+		if got.Ticket.Cancellation == nil {
+			t.Errorf("Expected a cancellation record, got %v", got.Ticket)
+		}
+		if _, err := trainService.GetTicket(ctx, &train.GetTicketRequest{Email: "ned.quinn@example.com"}); err == nil {
+			t.Error("Expected no active ticket for the email, got one")
+		}
+	})
+
+	t.Run("SeatFreed", func(t *testing.T) {
+		seats, err := trainService.GetSeatsBySection(ctx, &train.GetSeatsBySectionRequest{Section: "A", JourneyId: journey.Journey.Id})
+		if err != nil {
+			t.Fatalf("GetSeatsBySection failed: %v", err)
+		}
+		if seats.Seats[ticket.Seat] != "" {
+			t.Errorf("Expected seat %s to be free, got %s", ticket.Seat, seats.Seats[ticket.Seat])
+		}
+	})
+
+	t.Run("Twice", func(t *testing.T) {
+		if _, err := trainService.RemoveUser(ctx, &train.RemoveUserRequest{Reference: ticket.Reference, Email: ticket.Purchaser.Email}); err == nil {
+			t.Error("Expected cancelling a cancelled ticket to fail")
+		}
+	})
+}
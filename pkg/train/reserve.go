@@ -2,149 +2,483 @@ package reservation
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"log"
+	"strings"
+	"time"
 
 	train "github.com/bijoyv/train/pkg/proto"
+	"google.golang.org/protobuf/proto"
 )
 
 // TrainService implements the grpc interface using CSP.
 type TrainService struct {
-	ops chan func(map[string]*train.Ticket, map[string]string)
+	ops       chan func(*state)
+	layout    *train.SeatLayout
+	fares     FarePolicy
+	group     train.GroupSeating
+	allocator SeatAllocator
+	clock     Clock
+	holdTTL   time.Duration
+	events    chan Event
+	listeners []EventListener
+	transfers TransferPolicy
+	refunds   RefundPolicy
+	payments  PaymentProvider
+	stations  *StationRegistry
+	notifier  Notifier
+	templates map[EventType]*MessageTemplate
+	retry     NotifyRetry
 	train.UnimplementedTrainServiceServer
 }
 
+// Option configures a TrainService.
+type Option func(*TrainService)
+
+// WithClock sets the clock the service reads the time from.
+func WithClock(clock Clock) Option {
+	return func(s *TrainService) {
+		s.clock = clock
+	}
+}
+
+// WithEventListener adds a listener for booking events.
+func WithEventListener(listener EventListener) Option {
+	return func(s *TrainService) {
+		s.listeners = append(s.listeners, listener)
+	}
+}
+
+// WithFarePolicy sets the policy tickets are priced with.
+func WithFarePolicy(policy FarePolicy) Option {
+	return func(s *TrainService) {
+		s.fares = policy
+	}
+}
+
+// WithGroupSeating sets what group bookings fall back to when a group does
+// not fit in adjacent seats.
+func WithGroupSeating(fallback train.GroupSeating) Option {
+	return func(s *TrainService) {
+		s.group = fallback
+	}
+}
+
+// WithHoldTTL sets how long a seat hold lasts before it is released.
+func WithHoldTTL(ttl time.Duration) Option {
+	return func(s *TrainService) {
+		s.holdTTL = ttl
+	}
+}
+
+// WithMessageTemplates replaces the templates notifications are written
+// with, events without a template are not sent.
+func WithMessageTemplates(templates map[EventType]*MessageTemplate) Option {
+	return func(s *TrainService) {
+		s.templates = templates
+	}
+}
+
+// WithNotifier sends passengers a message about every booking event.
+func WithNotifier(notifier Notifier) Option {
+	return func(s *TrainService) {
+		s.notifier = notifier
+	}
+}
+
+// WithNotifyRetry sets how failed notifications are retried.
+func WithNotifyRetry(retry NotifyRetry) Option {
+	return func(s *TrainService) {
+		s.retry = retry
+	}
+}
+
+// WithPaymentProvider sets who takes the payments for tickets.
+func WithPaymentProvider(provider PaymentProvider) Option {
+	return func(s *TrainService) {
+		s.payments = provider
+	}
+}
+
+// WithRefundPolicy sets how much cancelled tickets get back.
+func WithRefundPolicy(policy RefundPolicy) Option {
+	return func(s *TrainService) {
+		s.refunds = policy
+	}
+}
+
+// WithSeatAllocator sets how seats are picked for single passengers.
+func WithSeatAllocator(allocator SeatAllocator) Option {
+	return func(s *TrainService) {
+		s.allocator = allocator
+	}
+}
+
+// WithSeatLayout sets the layout used for journeys created without one.
+func WithSeatLayout(layout *train.SeatLayout) Option {
+	return func(s *TrainService) {
+		s.layout = layout
+	}
+}
+
+// WithStationRegistry sets the stations tickets can be sold between.
+func WithStationRegistry(stations *StationRegistry) Option {
+	return func(s *TrainService) {
+		s.stations = stations
+	}
+}
+
+// WithTransferPolicy sets when tickets may be transferred.
+func WithTransferPolicy(policy TransferPolicy) Option {
+	return func(s *TrainService) {
+		s.transfers = policy
+	}
+}
+
+// state is owned by the Run go routine, ops are the only way to touch it.
+type state struct {
+	journeys   map[string]*journey
+	tickets    map[string]*train.Ticket
+	cancelled  map[string]*train.Ticket
+	holds      map[string]*hold
+	waiting    map[string]*journey
+	swaps      map[string]*pendingSwap
+	references map[string]bool
+	blocks     map[string]*seatBlock
+	blocked    map[*seat]*journey
+	trips      map[string][]*train.Ticket
+	passes     map[string]*pass
+	invoices   int
+}
+
 // This is for running a go routine to make the data local for synchronization
 func (s *TrainService) Run() {
-	tickets := make(map[string]*train.Ticket)
-	seats := initializeSeats()
+	st := &state{
+		journeys:   make(map[string]*journey),
+		tickets:    make(map[string]*train.Ticket),
+		cancelled:  make(map[string]*train.Ticket),
+		holds:      make(map[string]*hold),
+		waiting:    make(map[string]*journey),
+		swaps:      make(map[string]*pendingSwap),
+		references: make(map[string]bool),
+		blocks:     make(map[string]*seatBlock),
+		blocked:    make(map[*seat]*journey),
+		trips:      make(map[string][]*train.Ticket),
+		passes:     make(map[string]*pass),
+	}
+	j, err := newJourney(&train.Journey{
+		Id:          DefaultJourneyID,
+		Origin:      "London",
+		Destination: "Paris",
+	}, s.layout)
+	if err != nil {
+		log.Fatalf("default journey: %v", err)
+	}
+	st.journeys[DefaultJourneyID] = j
 
-	for op := range s.ops {
-		op(tickets, seats)
+	sweep := time.NewTicker(holdSweepInterval)
+	defer sweep.Stop()
+	for {
+		select {
+		case op, ok := <-s.ops:
+			if !ok {
+				return
+			}
+			// expire holds and apply seat blocks first so no op ever sees
+			// a hold past its TTL or a seat blocked out of its window
+			s.expireHolds(st)
+			s.applyBlocks(st)
+			op(st)
+		case <-sweep.C:
+			s.expireHolds(st)
+			s.applyBlocks(st)
+		}
 	}
 }
 
-// helper function to initialize seats for section A and B
-func initializeSeats() map[string]string {
-	seats := make(map[string]string)
-	for i := 1; i <= 20; i++ {
-		seats[fmt.Sprintf("A%d", i)] = ""
-		seats[fmt.Sprintf("B%d", i)] = ""
+// sale is a ticket about to be issued on the legs [start, end) of a journey,
+// fare is priced at issue time unless it was locked in before. payment is
+// the authorization taken for the sale, if any. user is the passenger
+// travelling, purchaser who bought the ticket for them.
+type sale struct {
+	j          *journey
+	start, end int
+	user       *train.User
+	purchaser  *train.Purchaser
+	class      train.SeatClass
+	passenger  train.PassengerType
+	fare       *Fare
+	payment    string
+}
+
+// issue prices the sale and books it on seat, the seat must be free.
+func (s *TrainService) issue(st *state, sl sale, seat *seat) (*train.Ticket, error) {
+	fare := sl.fare
+	if fare == nil {
+		var err error
+		fare, err = s.priceTicket(sl.j, sl.start, sl.end, sl.class, sl.passenger)
+		if err != nil {
+			return nil, err
+		}
+	}
+	ticket := &train.Ticket{
+		From:          sl.j.info.Stops[sl.start].Station,
+		To:            sl.j.info.Stops[sl.end].Station,
+		User:          sl.user,
+		Price:         fare.Price(),
+		FareBreakdown: fare.Breakdown(),
+		Seat:          seat.id,
+		JourneyId:     sl.j.info.Id,
+		SeatClass:     seatClass(sl.class),
+		PassengerType: passengerType(sl.passenger),
+		Reference:     st.newReference(),
+		NonRefundable: fare.NonRefundable,
+		Purchaser:     purchaserFor(sl.purchaser, sl.user),
 	}
-	return seats
+	if sl.payment != "" {
+		ticket.PaymentId = sl.payment
+		ticket.PaymentStatus = train.PaymentStatus_PAYMENT_STATUS_AUTHORIZED
+	}
+	if sl.payment == "" {
+		// nothing to capture, the sale is complete
+		s.invoice(st, ticket)
+	}
+	st.tickets[ticket.Reference] = ticket
+	seat.occupy(sl.start, sl.end, ticket.Reference)
+	return ticket, nil
 }
 
-// helper function to assign seat
-func assignSeat(seats map[string]string) string {
+// snapshot copies a ticket so it can leave the actor, the actor keeps
+// changing the original.
+func snapshot(ticket *train.Ticket) *train.Ticket {
+	return proto.Clone(ticket).(*train.Ticket)
+}
 
-	for seat, taken := range seats {
-		if taken == "" {
-			seats[seat] = "taken" //mark the seat as occupied
-			return seat
+// snapshots copies a list of tickets, see snapshot.
+func snapshots(tickets []*train.Ticket) []*train.Ticket {
+	copies := make([]*train.Ticket, 0, len(tickets))
+	for _, t := range tickets {
+		copies = append(copies, snapshot(t))
+	}
+	return copies
+}
+
+// drop frees the seat of a ticket and forgets the ticket.
+func (st *state) drop(ticket *train.Ticket) {
+	if j, ok := st.journeys[ticket.JourneyId]; ok {
+		if seat, ok := j.seats[ticket.Seat]; ok {
+			seat.release(ticket.Reference)
 		}
 	}
-	return ""
+	delete(st.tickets, ticket.Reference)
 }
 
 // Implement grpc service methods
 func (s *TrainService) PurchaseTicket(ctx context.Context, req *train.PurchaseTicketRequest) (*train.PurchaseTicketResponse, error) {
-	if req.User == nil || req.User.Email == "" {
-		return nil, fmt.Errorf("Invalid User Information")
+	if err := validBooking(req.Purchaser, req.User); err != nil {
+		return nil, err
+	}
+	from, to, err := s.route(req.From, req.To)
+	if err != nil {
+		return nil, err
+	}
+	if req.PassId != "" && req.JoinWaitlist {
+		return nil, fmt.Errorf("a ride on a pass cannot join the waitlist")
+	}
+	fare, err := s.quote(req, from, to)
+	if err != nil {
+		return nil, err
+	}
+	// a ride the pass pays for in full has nothing to authorize
+	var payment string
+	if fare.Total() > 0 {
+		if payment, err = s.payments.Authorize(ctx, purchaserFor(req.Purchaser, req.User), fare.Price()); err != nil {
+			return nil, err
+		}
 	}
 	result := make(chan error, 1)
-	resTicket := make(chan *train.Ticket, 1)
-	s.ops <- func(tickets map[string]*train.Ticket, seats map[string]string) {
-		seat := assignSeat(seats)
-		if seat == "" {
-			result <- fmt.Errorf("no seats available")
+	resTicket := make(chan *train.PurchaseTicketResponse, 1)
+	s.ops <- func(st *state) {
+		j, err := st.openJourney(req.JourneyId)
+		if err != nil {
+			result <- err
 			return
 		}
-		if _, exists := tickets[req.User.Email]; exists {
-			seats[seat] = "" //reset the taken as we are not purchasing
-			result <- fmt.Errorf("ticket already exist for this user")
+		start, end, err := j.segment(from, to)
+		if err != nil {
+			result <- err
 			return
 		}
-
-		ticket := &train.Ticket{
-			From:  req.From,
-			To:    req.To,
-			User:  req.User,
-			Price: 20,
-			Seat:  seat,
+		current, err := s.priceRequest(st, j, start, end, req)
+		if err == nil {
+			err = priceChanged(fare, current)
+		}
+		if err != nil {
+			result <- err
+			return
+		}
+		var p *pass
+		if req.PassId != "" {
+			if p, err = s.passRide(st, req.PassId, j, start, end, req.User); err != nil {
+				result <- err
+				return
+			}
+		}
+		seat, notes, err := s.assignSeat(j, start, end, req.SeatClass, req.Preferences)
+		if errors.Is(err, ErrNoSeats) && req.JoinWaitlist {
+			resTicket <- &train.PurchaseTicketResponse{Waitlist: s.joinWaitlist(st, j, start, end, req, fare, payment)}
+			return
+		}
+		if err != nil {
+			result <- err
+			return
+		}
+		ticket, err := s.issue(st, sale{j: j, start: start, end: end, user: req.User, purchaser: req.Purchaser, class: req.SeatClass, passenger: req.PassengerType, fare: fare, payment: payment}, seat)
+		if err != nil {
+			result <- err
+			return
 		}
-		tickets[req.User.Email] = ticket
-		seats[seat] = req.User.Email
-		resTicket <- ticket
+		if p != nil {
+			ticket.PassId = p.info.Id
+			p.tickets = append(p.tickets, ticket)
+			p.ride(1)
+		}
+		resTicket <- &train.PurchaseTicketResponse{Ticket: snapshot(ticket), AllocationNotes: notes}
 	}
+	var res *train.PurchaseTicketResponse
 	select {
 	case er := <-result:
+		s.void(ctx, payment)
 		return nil, er
-	case tkt := <-resTicket:
-
-		return &train.PurchaseTicketResponse{Ticket: tkt}, nil
+	case res = <-resTicket:
+	}
+	if res.Ticket == nil {
+		// waitlisted, the authorization is captured once a seat comes up
+		return res, nil
+	}
+	if payment != "" {
+		if res.Ticket, err = s.settle(ctx, res.Ticket); err != nil {
+			return nil, err
+		}
 	}
+	s.emit(EventTicketPurchased, res.Ticket)
+	return res, nil
 }
 func (s *TrainService) GetTicket(ctx context.Context, req *train.GetTicketRequest) (*train.GetTicketResponse, error) {
 	er := make(chan error, 1)
-	resTicket := make(chan *train.Ticket, 1)
-	s.ops <- func(tickets map[string]*train.Ticket, seats map[string]string) {
-		ticket, exists := tickets[req.Email]
-		if !exists {
-			er <- fmt.Errorf("Ticket not found for user %s", req.Email)
+	resTicket := make(chan *train.GetTicketResponse, 1)
+	s.ops <- func(st *state) {
+		ticket, err := st.findTicket(req.Reference, req.Email)
+		if cancelled, ok := st.cancelledTicket(req.Reference, req.Email, heldBy); err != nil && ok {
+			ticket, err = cancelled, nil
+		}
+		if err != nil {
+			er <- err
 			return
 		}
-		resTicket <- ticket
+		res := &train.GetTicketResponse{Ticket: snapshot(ticket)}
+		if tripReference(ticket) != "" {
+			res.Trip = snapshots(st.tripLegs(ticket))
+		}
+		resTicket <- res
 
 	}
 
 	select {
 	case e := <-er:
 		return nil, e
-	case t := <-resTicket:
+	case res := <-resTicket:
 
-		return &train.GetTicketResponse{Ticket: t}, nil
+		return res, nil
 	}
 }
 func (s *TrainService) GetSeatsBySection(ctx context.Context, req *train.GetSeatsBySectionRequest) (*train.GetSeatsBySectionResponse, error) {
-
+	from, to, err := s.route(req.From, req.To)
+	if err != nil {
+		return nil, err
+	}
+	er := make(chan error, 1)
 	seatc := make(chan map[string]string, 1)
-	s.ops <- func(tickets map[string]*train.Ticket, seats map[string]string) {
+	s.ops <- func(st *state) {
+		j, err := st.journey(req.JourneyId)
+		if err != nil {
+			er <- err
+			return
+		}
+		start, end, err := j.segment(from, to)
+		if err != nil {
+			er <- err
+			return
+		}
 		result := make(map[string]string)
-		for seat, email := range seats {
-			if string(seat[0]) == req.Section {
-				result[seat] = email
+		for id, seat := range j.seats {
+			if seat.coach == req.Section {
+				var names []string
+				for _, ref := range seat.occupants(start, end) {
+					names = append(names, st.occupantName(ref))
+				}
+				if len(names) == 0 && seat.blocked {
+					names = append(names, blockedOccupant)
+				}
+				result[id] = strings.Join(names, ",")
 			}
 
 		}
 		seatc <- result
 	}
 
-	result := <-seatc
-	return &train.GetSeatsBySectionResponse{Seats: result}, nil
+	select {
+	case e := <-er:
+		return nil, e
+	case result := <-seatc:
+		return &train.GetSeatsBySectionResponse{Seats: result}, nil
+	}
 }
 
 func (s *TrainService) RemoveUser(ctx context.Context, req *train.RemoveUserRequest) (*train.RemoveUserResponse, error) {
 	er := make(chan error, 1)
-	result := make(chan bool, 1)
+	result := make(chan []*train.Ticket, 1)
 
-	s.ops <- func(tickets map[string]*train.Ticket, seats map[string]string) {
+	s.ops <- func(st *state) {
 
-		ticket, exists := tickets[req.Email]
-		if !exists {
-			er <- fmt.Errorf("User %s not found with ticket", req.Email)
+		ticket, err := st.findPurchase(req.Reference, req.Email)
+		if err != nil {
+			er <- err
 			return
 		}
-		//		delete(seats, ticket.Seat)
-		seats[ticket.Seat] = ""
-		delete(tickets, req.Email)
+		// the legs of a round trip or itinerary are cancelled together
+		var cancelled []*train.Ticket
+		for _, leg := range st.tripLegs(ticket) {
+			if !st.active(leg) {
+				continue
+			}
+			if _, err := s.cancel(st, leg); err != nil {
+				er <- err
+				return
+			}
+			cancelled = append(cancelled, snapshot(leg))
+		}
 
-		result <- true
+		result <- cancelled
 	}
 	select {
 	case e := <-er:
 		return nil, e
-	case <-result:
-
-		return &train.RemoveUserResponse{Success: true}, nil
+	case tickets := <-result:
+		// every leg is cancelled already, so each one is refunded and announced
+		// even when another leg's refund fails
+		var errs []error
+		for _, t := range tickets {
+			if err := s.refund(ctx, t); err != nil {
+				errs = append(errs, fmt.Errorf("ticket %s cancelled but not refunded: %w", t.Reference, err))
+			}
+			s.emit(EventTicketCancelled, t)
+		}
+		if len(errs) > 0 {
+			return nil, errors.Join(errs...)
+		}
+		return &train.RemoveUserResponse{Success: true, Cancellation: tripCancellation(tickets)}, nil
 	}
 }
 
@@ -152,20 +486,48 @@ func (s *TrainService) ModifySeat(ctx context.Context, req *train.ModifySeatRequ
 	er := make(chan error, 1)
 	result := make(chan bool, 1)
 
-	s.ops <- func(tickets map[string]*train.Ticket, seats map[string]string) {
+	s.ops <- func(st *state) {
 
-		ticket, exists := tickets[req.Email]
+		ticket, err := st.findTicket(req.Reference, req.Email)
+		if err != nil {
+			er <- err
+			return
+		}
+		if req.JourneyId != "" && req.JourneyId != ticket.JourneyId {
+			er <- fmt.Errorf("ticket %s is not on journey %s", ticket.Reference, req.JourneyId)
+			return
+		}
+		j, err := st.journey(ticket.JourneyId)
+		if err != nil {
+			er <- err
+			return
+		}
+		target, exists := j.seats[req.NewSeat]
 		if !exists {
-			er <- fmt.Errorf("user %s not found with ticket", req.Email)
+			er <- fmt.Errorf("seat %s does not exist on journey %s", req.NewSeat, j.info.Id)
+			return
+		}
+		start, end, err := j.segment(ticket.From, ticket.To)
+		if err != nil {
+			er <- err
+			return
+		}
+		if target.class != seatClass(ticket.SeatClass) {
+			er <- fmt.Errorf("seat %s is in %s class, use UpgradeSeat to change class", req.NewSeat, enumName(target.class.String(), "SEAT_CLASS_"))
 			return
 		}
-		if seat, taken := seats[req.NewSeat]; taken && seat != "" {
-			er <- fmt.Errorf("seat %s already in use", seat)
+		if target.blocked {
+			er <- fmt.Errorf("seat %s is out of service", req.NewSeat)
 			return
 		}
-		delete(seats, ticket.Seat)
-		seats[req.NewSeat] = req.Email
+		if !target.free(start, end) {
+			er <- fmt.Errorf("seat %s already in use", req.NewSeat)
+			return
+		}
+		j.seats[ticket.Seat].release(ticket.Reference)
+		target.occupy(start, end, ticket.Reference)
 		ticket.Seat = req.NewSeat
+		s.emit(EventSeatModified, ticket)
 		result <- true
 	}
 	select {
@@ -178,10 +540,32 @@ func (s *TrainService) ModifySeat(ctx context.Context, req *train.ModifySeatRequ
 }
 
 // initialize the service
-func NewTrainReservationService() *TrainService {
+func NewTrainReservationService(opts ...Option) *TrainService {
 	ts := &TrainService{
-		ops: make(chan func(map[string]*train.Ticket, map[string]string)),
+		ops:       make(chan func(*state)),
+		layout:    DefaultSeatLayout(),
+		fares:     DefaultFarePolicy(),
+		group:     train.GroupSeating_GROUP_SEATING_ANYWHERE,
+		allocator: PreferenceAllocator{Fallback: SequentialAllocator{}},
+		clock:     systemClock{},
+		holdTTL:   defaultHoldTTL,
+		events:    make(chan Event, eventBuffer),
+		transfers: DefaultTransferPolicy(),
+		refunds:   DefaultRefundPolicy(),
+		payments:  NewFakePaymentProvider(),
+		stations:  DefaultStationRegistry(),
+		templates: DefaultMessageTemplates(),
+		retry:     DefaultNotifyRetry(),
+	}
+	for _, opt := range opts {
+		opt(ts)
+	}
+	if ts.notifier != nil {
+		n := newNotifications(ts.notifier, ts.templates, ts.retry)
+		ts.listeners = append(ts.listeners, n.listen)
+		go n.run()
 	}
+	go ts.dispatch()
 	go ts.Run()
 	return ts
 }
@@ -52,9 +52,34 @@ func TestTrainService(t *testing.T) {
 			User: user,
 		}
 
-		_, err := trainService.PurchaseTicket(context.Background(), req)
+		res, err := trainService.PurchaseTicket(context.Background(), req)
+		if err != nil {
+			t.Fatalf("Expected a second ticket for existing user, got %v", err)
+		}
+
+		list, err := trainService.ListTicketsByUser(context.Background(), &train.ListTicketsByUserRequest{
+			Email: "john.doe@example.com",
+		})
+		if err != nil {
+			t.Fatalf("ListTicketsByUser failed: %v", err)
+		}
+		if len(list.Tickets) != 2 {
+			t.Fatalf("Expected 2 tickets for user, got %d", len(list.Tickets))
+		}
+		if list.Tickets[0].Reference == list.Tickets[1].Reference {
+			t.Errorf("Expected distinct booking references, got %s twice", res.Ticket.Reference)
+		}
+
+		_, err = trainService.GetTicket(context.Background(), &train.GetTicketRequest{Email: "john.doe@example.com"})
 		if err == nil {
-			t.Fatal("Expected error for existing user, got nil")
+			t.Error("Expected error looking up an email with two tickets, got nil")
+		}
+		got, err := trainService.GetTicket(context.Background(), &train.GetTicketRequest{Reference: res.Ticket.Reference})
+		if err != nil {
+			t.Fatalf("GetTicket by reference failed: %v", err)
+		}
+		if got.Ticket.Seat != res.Ticket.Seat {
+			t.Errorf("Expected seat %s, got %s", res.Ticket.Seat, got.Ticket.Seat)
 		}
 	})
 
@@ -193,7 +218,7 @@ func TestTrainService(t *testing.T) {
 
 		modifySeatReq := &train.ModifySeatRequest{
 			Email:   "carol.williams@example.com",
-			NewSeat: "A2",
+			NewSeat: "B2", // seats are allocated front to back, so B2 is still free
 		}
 
 		res, err := trainService.ModifySeat(context.Background(), modifySeatReq)
@@ -213,8 +238,8 @@ func TestTrainService(t *testing.T) {
 		if err != nil {
 			t.Fatalf("GetTicket failed: %v", err)
 		}
-		if getTicketRes.Ticket.Seat != "A2" {
-			t.Errorf("Expected seat to be %s, got %s", "A2", getTicketRes.Ticket.Seat)
+		if getTicketRes.Ticket.Seat != "B2" {
+			t.Errorf("Expected seat to be %s, got %s", "B2", getTicketRes.Ticket.Seat)
 		}
 	})
 
@@ -234,6 +259,14 @@ func TestTrainService(t *testing.T) {
 		if err != nil {
 			t.Fatalf("PurchaseTicket failed: %v", err)
 		}
+
+		_, err = trainService.ModifySeat(context.Background(), &train.ModifySeatRequest{
+			Email:   "david.brown@example.com",
+			NewSeat: "B2",
+		})
+		if err == nil {
+			t.Fatal("Expected error moving to a taken seat, got nil")
+		}
 	})
 
 }
@@ -0,0 +1,203 @@
+package reservation
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strconv"
+	"time"
+
+	train "github.com/bijoyv/train/pkg/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	defaultPageSize = 20
+	maxPageSize     = 100
+)
+
+// arrival returns when the journey reaches station, nil when the stop has
+// no arrival time.
+func (j *journey) arrival(station string) *timestamppb.Timestamp {
+	if i := j.stopIndex(station); i >= 0 && j.info.Stops[i].Arrival != nil {
+		return timestamppb.New(j.info.Stops[i].Arrival.AsTime())
+	}
+	return nil
+}
+
+// classes lists the seat classes of the journey in class order.
+func (j *journey) classes() []train.SeatClass {
+	seen := make(map[train.SeatClass]bool)
+	var classes []train.SeatClass
+	for _, seat := range j.seats {
+		if !seen[seat.class] {
+			seen[seat.class] = true
+			classes = append(classes, seat.class)
+		}
+	}
+	sort.Slice(classes, func(a, b int) bool {
+		return classes[a] < classes[b]
+	})
+	return classes
+}
+
+// searchResult describes the trip [start, end) of a journey, departure is
+// zero when the journey has no timetable and lowest zero when it is sold out.
+type searchResult struct {
+	result    *train.JourneyResult
+	departure time.Time
+	lowest    int64
+}
+
+// searchJourney counts the seats left in every class of the trip and prices
+// the classes that are not sold out. A class that cannot be priced is listed
+// without a fare rather than failing the whole search.
+func (s *TrainService) searchJourney(j *journey, start, end int, passenger train.PassengerType) *searchResult {
+	from, to := j.info.Stops[start].Station, j.info.Stops[end].Station
+	sr := &searchResult{
+		result: &train.JourneyResult{
+			JourneyId:   j.info.Id,
+			TrainNumber: j.info.TrainNumber,
+			From:        from,
+			To:          to,
+			Arrival:     j.arrival(to),
+		},
+		departure: j.departure(from),
+	}
+	if !sr.departure.IsZero() {
+		sr.result.Departure = timestamppb.New(sr.departure)
+	}
+	for _, class := range j.classes() {
+		left := 0
+		for _, seat := range j.classSeats(class) {
+			if seat.free(start, end) {
+				left++
+			}
+		}
+		availability := &train.ClassAvailability{SeatClass: class, SeatsLeft: int32(left)}
+		sr.result.Classes = append(sr.result.Classes, availability)
+		if left == 0 {
+			continue
+		}
+		fare, err := s.priceTicket(j, start, end, class, passenger)
+		if err != nil {
+			log.Printf("cannot price %s on %s: %v", seatClass(class), j.info.Id, err)
+			continue
+		}
+		availability.Fare = fare.Price()
+		if sr.result.LowestFare == nil || fare.Total() < sr.lowest {
+			sr.result.LowestFare = fare.Price()
+			sr.lowest = fare.Total()
+		}
+	}
+	return sr
+}
+
+// sortResults orders search results by departure or by lowest fare, sold out
+// journeys and journeys without a timetable go last.
+func sortResults(results []*searchResult, by train.JourneySort) {
+	byDeparture := func(a, b *searchResult) bool {
+		if a.departure.IsZero() != b.departure.IsZero() {
+			return b.departure.IsZero()
+		}
+		if !a.departure.Equal(b.departure) {
+			return a.departure.Before(b.departure)
+		}
+		return a.result.JourneyId < b.result.JourneyId
+	}
+	sort.Slice(results, func(x, y int) bool {
+		a, b := results[x], results[y]
+		if by == train.JourneySort_JOURNEY_SORT_PRICE {
+			soldOutA, soldOutB := a.result.LowestFare == nil, b.result.LowestFare == nil
+			if soldOutA != soldOutB {
+				return soldOutB
+			}
+			if a.lowest != b.lowest {
+				return a.lowest < b.lowest
+			}
+		}
+		return byDeparture(a, b)
+	})
+}
+
+// page cuts one page out of the results, the page token is the offset of the
+// next page.
+func page(total, size int, token string) (int, int, string, error) {
+	if size <= 0 {
+		size = defaultPageSize
+	}
+	size = min(size, maxPageSize)
+	offset := 0
+	if token != "" {
+		var err error
+		if offset, err = strconv.Atoi(token); err != nil || offset < 0 || offset > total {
+			return 0, 0, "", fmt.Errorf("invalid page token %q", token)
+		}
+	}
+	end := min(offset+size, total)
+	next := ""
+	if end < total {
+		next = strconv.Itoa(end)
+	}
+	return offset, end, next, nil
+}
+
+// SearchJourneys lists the journeys on sale between two stations with the
+// seats left and the fare of every class.
+func (s *TrainService) SearchJourneys(ctx context.Context, req *train.SearchJourneysRequest) (*train.SearchJourneysResponse, error) {
+	if req.From == "" || req.To == "" {
+		return nil, fmt.Errorf("journey search requires a from and a to station")
+	}
+	from, to, err := s.route(req.From, req.To)
+	if err != nil {
+		return nil, err
+	}
+	var day time.Time
+	if req.Date != "" {
+		origin, _ := s.stations.Resolve(from)
+		zone, err := time.LoadLocation(origin.TimeZone)
+		if err != nil {
+			return nil, err
+		}
+		if day, err = time.ParseInLocation(time.DateOnly, req.Date, zone); err != nil {
+			return nil, fmt.Errorf("journey search date must be YYYY-MM-DD: %v", err)
+		}
+	}
+	result := make(chan []*searchResult, 1)
+
+	s.ops <- func(st *state) {
+		now := s.clock.Now()
+		var results []*searchResult
+		for _, j := range st.journeys {
+			if j.info.Retired {
+				continue
+			}
+			start, end, err := j.segment(from, to)
+			if err != nil {
+				continue
+			}
+			departure := j.departure(from)
+			if !departure.IsZero() && departure.Before(now) {
+				continue
+			}
+			if !day.IsZero() && (departure.Before(day) || !departure.Before(day.AddDate(0, 0, 1))) {
+				continue
+			}
+			results = append(results, s.searchJourney(j, start, end, req.PassengerType))
+		}
+		result <- results
+	}
+	results := <-result
+
+	sortResults(results, req.SortBy)
+	start, end, next, err := page(len(results), int(req.PageSize), req.PageToken)
+	if err != nil {
+		return nil, err
+	}
+	res := &train.SearchJourneysResponse{NextPageToken: next, TotalResults: int32(len(results))}
+	for _, sr := range results[start:end] {
+		res.Results = append(res.Results, sr.result)
+	}
+	return res, nil
+}
@@ -0,0 +1,142 @@
+package reservation
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	train "github.com/bijoyv/train/pkg/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestSearchJourneys(t *testing.T) {
+	clock := newFakeClock()
+	trainService := NewTrainReservationService(
+		WithClock(clock),
+		WithSeatLayout(&train.SeatLayout{Coaches: []*train.Coach{{Code: "A", Rows: 1, Columns: "AB"}}}),
+		WithFarePolicy(NewDynamicFarePolicy(DefaultFarePolicy(), []FareBucket{{UpTo: 50, Markup: 0}, {UpTo: 100, Markup: 50}})),
+	)
+	ctx := context.Background()
+	for _, j := range []struct {
+		number string
+		depart string
+	}{
+		{"9O12", "2026-10-19T09:00:00Z"},
+		{"9O14", "2026-10-19T13:00:00Z"},
+		{"9O16", "2026-10-20T09:00:00Z"},
+	} {
+		departure, _ := time.Parse(time.RFC3339, j.depart)
+		_, err := trainService.CreateJourney(ctx, &train.CreateJourneyRequest{
+			TrainNumber: j.number,
+			Departure:   timestamppb.New(departure),
+			Stops: []*train.Stop{
+				{Station: "London", Departure: timestamppb.New(departure)},
+				{Station: "Paris", Arrival: timestamppb.New(departure.Add(2*time.Hour + 20*time.Minute))},
+			},
+		})
+		if err != nil {
+			t.Fatalf("CreateJourney failed: %v", err)
+		}
+	}
+	_, err := trainService.PurchaseTicket(ctx, &train.PurchaseTicketRequest{
+		JourneyId: "9O12-20261019",
+		From:      "London",
+		To:        "Paris",
+		User:      &train.User{Email: "lea.park@example.com"},
+	})
+	if err != nil {
+		t.Fatalf("PurchaseTicket failed: %v", err)
+	}
+	search := func(req *train.SearchJourneysRequest) *train.SearchJourneysResponse {
+		t.Helper()
+		res, err := trainService.SearchJourneys(ctx, req)
+		if err != nil {
+			t.Fatalf("SearchJourneys failed: %v", err)
+		}
+		return res
+	}
+	ids := func(res *train.SearchJourneysResponse) []string {
+		var ids []string
+		for _, r := range res.Results {
+			ids = append(ids, r.JourneyId)
+		}
+		return ids
+	}
+
+	t.Run("ByDate", func(t *testing.T) {
+		res := search(&train.SearchJourneysRequest{From: "LON", To: "Paris", Date: "2026-10-19"})
+		if got := ids(res); len(got) != 2 || got[0] != "9O12-20261019" || got[1] != "9O14-20261019" {
+			t.Fatalf("Expected 9O12 then 9O14, got %v", got)
+		}
+		first := res.Results[0]
+		if first.Classes[0].SeatsLeft != 1 || first.LowestFare.MinorUnits != 3000 {
+			t.Errorf("Expected 1 seat left at 3000, got %d at %d", first.Classes[0].SeatsLeft, first.LowestFare.MinorUnits)
+		}
+		if first.Arrival.AsTime().Format("15:04") != "11:20" {
+			t.Errorf("Expected arrival at 11:20, got %s", first.Arrival.AsTime().Format("15:04"))
+		}
+	})
+
+	t.Run("ByPrice", func(t *testing.T) {
+		res := search(&train.SearchJourneysRequest{From: "London", To: "Paris", Date: "2026-10-19", SortBy: train.JourneySort_JOURNEY_SORT_PRICE})
+		if got := ids(res); got[0] != "9O14-20261019" {
+			t.Errorf("Expected the cheaper 9O14 first, got %v", got)
+		}
+	})
+
+	t.Run("Paging", func(t *testing.T) {
+		first := search(&train.SearchJourneysRequest{From: "London", To: "Paris", PageSize: 3})
+		if first.TotalResults != 4 || len(first.Results) != 3 || first.NextPageToken == "" {
+			t.Fatalf("Expected 3 of 4 results and a next page, got %d of %d", len(first.Results), first.TotalResults)
+		}
+		second := search(&train.SearchJourneysRequest{From: "London", To: "Paris", PageSize: 3, PageToken: first.NextPageToken})
+		if got := ids(second); len(got) != 1 || got[0] != DefaultJourneyID || second.NextPageToken != "" {
+			t.Errorf("Expected the untimetabled default journey on the last page, got %v", got)
+		}
+	})
+
+	t.Run("SoldOut", func(t *testing.T) {
+		_, err := trainService.PurchaseTicket(ctx, &train.PurchaseTicketRequest{
+			JourneyId: "9O12-20261019",
+			From:      "London",
+			To:        "Paris",
+			User:      &train.User{Email: "max.park@example.com"},
+		})
+		if err != nil {
+			t.Fatalf("PurchaseTicket failed: %v", err)
+		}
+		res := search(&train.SearchJourneysRequest{From: "London", To: "Paris", Date: "2026-10-19"})
+		if got := ids(res); len(got) != 2 {
+			t.Fatalf("Expected the sold out journey still listed, got %v", got)
+		}
+		full := res.Results[0]
+		if full.Classes[0].SeatsLeft != 0 || full.Classes[0].Fare != nil || full.LowestFare != nil {
+			t.Errorf("Expected no seats and no fare on 9O12, got %v", full)
+		}
+	})
+
+	t.Run("Departed", func(t *testing.T) {
+		clock.Advance(22 * time.Hour)
+		res := search(&train.SearchJourneysRequest{From: "London", To: "Paris", Date: "2026-10-19"})
+		if got := ids(res); len(got) != 1 || got[0] != "9O14-20261019" {
+			t.Errorf("Expected only 9O14 once 9O12 left, got %v", got)
+		}
+	})
+
+	t.Run("Validation", func(t *testing.T) {
+		tests := []struct {
+			name string
+			req  *train.SearchJourneysRequest
+		}{
+			{"no destination", &train.SearchJourneysRequest{From: "London"}},
+			{"unknown station", &train.SearchJourneysRequest{From: "London", To: "Nowhere"}},
+			{"bad date", &train.SearchJourneysRequest{From: "London", To: "Paris", Date: "19/10/2026"}},
+			{"bad page token", &train.SearchJourneysRequest{From: "London", To: "Paris", PageToken: "x"}},
+		}
+		for _, tt := range tests {
+			if _, err := trainService.SearchJourneys(ctx, tt.req); err == nil {
+				t.Errorf("Expected a search with %s to fail", tt.name)
+			}
+		}
+	})
+}
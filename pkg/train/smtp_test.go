@@ -0,0 +1,145 @@
+package reservation
+
+import (
+	"bufio"
+	"context"
+	"net"
+	"net/textproto"
+	"strings"
+	"testing"
+	"time"
+
+	train "github.com/bijoyv/train/pkg/proto"
+)
+
+// smtpMail is a message as the fake SMTP server received it.
+type smtpMail struct {
+	from, to, data string
+}
+
+// fakeSMTPServer accepts mail on a local port and hands each message to the
+// returned channel. Recipients in reject are refused.
+func fakeSMTPServer(t *testing.T, reject string) (string, chan smtpMail) {
+	t.Helper()
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { lis.Close() })
+	mails := make(chan smtpMail, 10)
+	go func() {
+		for {
+			conn, err := lis.Accept()
+			if err != nil {
+				return
+			}
+			go serveSMTP(conn, reject, mails)
+		}
+	}()
+	return lis.Addr().String(), mails
+}
+
+func serveSMTP(conn net.Conn, reject string, mails chan smtpMail) {
+	defer conn.Close()
+	tp := textproto.NewConn(conn)
+	tp.PrintfLine("220 localhost fake ESMTP")
+	var mail smtpMail
+	for {
+		line, err := tp.ReadLine()
+		if err != nil {
+			return
+		}
+		verb, arg, _ := strings.Cut(line, " ")
+		switch strings.ToUpper(verb) {
+		case "EHLO", "HELO":
+			tp.PrintfLine("250 localhost")
+		case "MAIL":
+			mail = smtpMail{from: strings.Trim(strings.TrimPrefix(arg, "FROM:"), "<>")}
+			tp.PrintfLine("250 OK")
+		case "RCPT":
+			mail.to = strings.Trim(strings.TrimPrefix(arg, "TO:"), "<>")
+			if mail.to == reject {
+				tp.PrintfLine("550 no such user")
+				continue
+			}
+			tp.PrintfLine("250 OK")
+		case "DATA":
+			tp.PrintfLine("354 end with .")
+			data, err := tp.ReadDotBytes()
+			if err != nil {
+				return
+			}
+			mail.data = string(data)
+			mails <- mail
+			tp.PrintfLine("250 OK")
+		case "QUIT":
+			tp.PrintfLine("221 bye")
+			return
+		default:
+			tp.PrintfLine("502 not implemented")
+		}
+	}
+}
+
+func TestSMTPNotifier(t *testing.T) {
+	addr, mails := fakeSMTPServer(t, "nobody@example.com")
+	notifier := &SMTPNotifier{Addr: addr, From: "tickets@example.com"}
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	msg := Message{
+		To:        "ada.moss@example.com",
+		Subject:   "Your ticket from London to Paris",
+		Body:      "Hello Ada,\n\nSeat: A1\n",
+		Event:     EventTicketPurchased,
+		Reference: "ABC123",
+		Time:      time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC),
+	}
+	if err := notifier.Notify(ctx, msg); err != nil {
+		t.Fatalf("Notify failed: %v", err)
+	}
+	select {
+	case mail := <-mails:
+		if mail.from != "tickets@example.com" || mail.to != "ada.moss@example.com" {
+			t.Errorf("Expected mail from tickets@ to ada.moss@, got %s to %s", mail.from, mail.to)
+		}
+		// ReadDotBytes turns CRLF into LF
+		if !strings.Contains(mail.data, "Subject: Your ticket from London to Paris\n") || !strings.HasSuffix(mail.data, "\n\nHello Ada,\n\nSeat: A1\n") {
+			t.Errorf("Expected the subject and body, got %q", mail.data)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Expected the fake server to receive the mail")
+	}
+
+	msg.To = "ada.moss@example.com\r\nBcc: eve@example.com"
+	if err := notifier.Notify(ctx, msg); err == nil {
+		t.Error("Expected a recipient with a line break to fail")
+	}
+	msg.To = "nobody@example.com"
+	if err := notifier.Notify(ctx, msg); err == nil {
+		t.Error("Expected a refused recipient to fail")
+	}
+	if err := (&SMTPNotifier{Addr: "127.0.0.1:1", From: "tickets@example.com"}).Notify(ctx, msg); err == nil {
+		t.Error("Expected an unreachable server to fail")
+	}
+}
+
+func TestSMTPNotifierWithService(t *testing.T) {
+	addr, mails := fakeSMTPServer(t, "")
+	trainService := NewTrainReservationService(WithNotifier(&SMTPNotifier{Addr: addr, From: "tickets@example.com"}))
+	if _, err := trainService.PurchaseTicket(context.Background(), &train.PurchaseTicketRequest{From: "London", To: "Paris", User: &train.User{Email: "eli.moss@example.com"}}); err != nil {
+		t.Fatalf("PurchaseTicket failed: %v", err)
+	}
+	select {
+	case mail := <-mails:
+		r := textproto.NewReader(bufio.NewReader(strings.NewReader(mail.data)))
+		header, err := r.ReadMIMEHeader()
+		if err != nil {
+			t.Fatalf("Expected a readable message, got %v", err)
+		}
+		if header.Get("X-Train-Event") != string(EventTicketPurchased) || mail.to != "eli.moss@example.com" {
+			t.Errorf("Expected a purchase confirmation to eli.moss@, got %v to %s", header, mail.to)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Expected the confirmation to reach the fake server")
+	}
+}
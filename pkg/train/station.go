@@ -0,0 +1,285 @@
+package reservation
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"time"
+	"unicode"
+
+	train "github.com/bijoyv/train/pkg/proto"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+const (
+	// defaultSearchLimit is how many stations SearchStations returns when
+	// the request does not say.
+	defaultSearchLimit = 10
+
+	// defaultMinConnection is the connection time at stations that do not
+	// set their own.
+	defaultMinConnection = 10 * time.Minute
+)
+
+// StationRegistry knows the stations the service sells tickets between and
+// resolves their codes, names and aliases to the station, ignoring case,
+// punctuation and extra spaces.
+type StationRegistry struct {
+	stations []*train.Station
+	keys     map[string]*train.Station
+}
+
+// NewStationRegistry checks every station has a code, a name and a valid
+// time zone, and that no code, name or alias is used twice.
+func NewStationRegistry(stations []*train.Station) (*StationRegistry, error) {
+	r := &StationRegistry{keys: make(map[string]*train.Station)}
+	for _, st := range stations {
+		if st.Code == "" || st.Name == "" {
+			return nil, fmt.Errorf("station %q needs a code and a name", st.Name+st.Code)
+		}
+		if _, err := time.LoadLocation(st.TimeZone); st.TimeZone == "" || err != nil {
+			return nil, fmt.Errorf("station %s has no valid time zone %q", st.Code, st.TimeZone)
+		}
+		if st.MinConnection.AsDuration() < 0 {
+			return nil, fmt.Errorf("station %s has a negative connection time", st.Code)
+		}
+		for _, name := range append([]string{st.Code, st.Name}, st.Aliases...) {
+			key := stationKey(name)
+			if other, taken := r.keys[key]; taken && other != st {
+				return nil, fmt.Errorf("station name %q is used by %s and %s", name, other.Code, st.Code)
+			}
+			r.keys[key] = st
+		}
+		r.stations = append(r.stations, st)
+	}
+	sort.Slice(r.stations, func(a, b int) bool {
+		return r.stations[a].Name < r.stations[b].Name
+	})
+	return r, nil
+}
+
+// DefaultStationRegistry lists the stations of the London to Paris and
+// Brussels lines. Connections at the international terminals allow for
+// check in.
+func DefaultStationRegistry() *StationRegistry {
+	checkIn := durationpb.New(45 * time.Minute)
+	r, err := NewStationRegistry([]*train.Station{
+		{Code: "LON", Name: "London", Aliases: []string{"London St Pancras", "St Pancras International", "STP"}, TimeZone: "Europe/London", MinConnection: checkIn},
+		{Code: "EBB", Name: "Ebbsfleet", Aliases: []string{"Ebbsfleet International"}, TimeZone: "Europe/London"},
+		{Code: "AFK", Name: "Ashford", Aliases: []string{"Ashford International"}, TimeZone: "Europe/London"},
+		{Code: "CFR", Name: "Calais", Aliases: []string{"Calais Frethun"}, TimeZone: "Europe/Paris"},
+		{Code: "LIL", Name: "Lille", Aliases: []string{"Lille Europe"}, TimeZone: "Europe/Paris", MinConnection: durationpb.New(20 * time.Minute)},
+		{Code: "PAR", Name: "Paris", Aliases: []string{"Paris Nord", "Gare du Nord"}, TimeZone: "Europe/Paris", MinConnection: checkIn},
+		{Code: "BRU", Name: "Brussels", Aliases: []string{"Brussels Midi", "Bruxelles Midi", "Bruxelles"}, TimeZone: "Europe/Brussels", MinConnection: checkIn},
+		{Code: "RTD", Name: "Rotterdam", Aliases: []string{"Rotterdam Centraal"}, TimeZone: "Europe/Amsterdam"},
+		{Code: "AMS", Name: "Amsterdam", Aliases: []string{"Amsterdam Centraal"}, TimeZone: "Europe/Amsterdam"},
+	})
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// stationConfig is the JSON form of a station registry:
+//
+//	{"stations": [{"code": "LON", "name": "London", "aliases": ["St Pancras"], "timeZone": "Europe/London", "minConnection": "45m"}]}
+type stationConfig struct {
+	Stations []struct {
+		Code          string   `json:"code"`
+		Name          string   `json:"name"`
+		Aliases       []string `json:"aliases"`
+		TimeZone      string   `json:"timeZone"`
+		MinConnection string   `json:"minConnection"`
+	} `json:"stations"`
+}
+
+// LoadStationRegistry reads a station registry from a JSON file.
+func LoadStationRegistry(path string) (*StationRegistry, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read station registry: %w", err)
+	}
+	config := &stationConfig{}
+	if err := json.Unmarshal(data, config); err != nil {
+		return nil, fmt.Errorf("parse station registry %s: %w", path, err)
+	}
+	stations := make([]*train.Station, 0, len(config.Stations))
+	for _, st := range config.Stations {
+		station := &train.Station{Code: st.Code, Name: st.Name, Aliases: st.Aliases, TimeZone: st.TimeZone}
+		if st.MinConnection != "" {
+			connection, err := time.ParseDuration(st.MinConnection)
+			if err != nil {
+				return nil, fmt.Errorf("station registry %s: %w", path, err)
+			}
+			station.MinConnection = durationpb.New(connection)
+		}
+		stations = append(stations, station)
+	}
+	r, err := NewStationRegistry(stations)
+	if err != nil {
+		return nil, fmt.Errorf("station registry %s: %w", path, err)
+	}
+	return r, nil
+}
+
+// stationKey folds a station name for lookups, " St-Pancras " is "st pancras".
+func stationKey(name string) string {
+	return strings.Join(strings.FieldsFunc(strings.ToLower(name), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	}), " ")
+}
+
+// Resolve finds the station a code, name or alias stands for.
+func (r *StationRegistry) Resolve(name string) (*train.Station, error) {
+	st, ok := r.keys[stationKey(name)]
+	if !ok {
+		return nil, fmt.Errorf("unknown station %q", name)
+	}
+	return st, nil
+}
+
+// MinConnection is the least time to allow for changing trains at a
+// station.
+func (r *StationRegistry) MinConnection(name string) time.Duration {
+	st, err := r.Resolve(name)
+	if err != nil || st.MinConnection == nil {
+		return defaultMinConnection
+	}
+	return st.MinConnection.AsDuration()
+}
+
+// Stations returns copies of every station ordered by name.
+func (r *StationRegistry) Stations() []*train.Station {
+	stations := make([]*train.Station, 0, len(r.stations))
+	for _, st := range r.stations {
+		stations = append(stations, proto.Clone(st).(*train.Station))
+	}
+	return stations
+}
+
+// Search returns up to limit stations matching query, best first. A code or
+// name matching exactly beats a prefix, a prefix of any word beats a match
+// inside a word, and those beat names that are a typo or two away.
+func (r *StationRegistry) Search(query string, limit int) []*train.Station {
+	q := stationKey(query)
+	type match struct {
+		station *train.Station
+		score   int
+	}
+	var matches []match
+	for _, st := range r.stations {
+		best := -1
+		for _, name := range append([]string{st.Code, st.Name}, st.Aliases...) {
+			if score := matchScore(q, stationKey(name)); score >= 0 && (best < 0 || score < best) {
+				best = score
+			}
+		}
+		if best >= 0 {
+			matches = append(matches, match{st, best})
+		}
+	}
+	sort.SliceStable(matches, func(a, b int) bool {
+		return matches[a].score < matches[b].score
+	})
+	stations := make([]*train.Station, 0, min(limit, len(matches)))
+	for _, m := range matches[:min(limit, len(matches))] {
+		stations = append(stations, proto.Clone(m.station).(*train.Station))
+	}
+	return stations
+}
+
+// matchScore rates how well query matches name, lower is better and -1 is
+// no match. A typo is allowed for every four characters of the query.
+func matchScore(query, name string) int {
+	switch {
+	case query == name:
+		return 0
+	case strings.HasPrefix(name, query):
+		return 1
+	case strings.Contains(" "+name, " "+query):
+		return 2
+	case strings.Contains(name, query):
+		return 3
+	}
+	allowed := len([]rune(query)) / 4
+	if allowed == 0 {
+		return -1
+	}
+	best := -1
+	for _, word := range append([]string{name}, strings.Fields(name)...) {
+		prefix := []rune(word)
+		if len(prefix) > len([]rune(query)) {
+			prefix = prefix[:len([]rune(query))]
+		}
+		if d := editDistance(query, string(prefix)); d <= allowed && (best < 0 || 4+d < best) {
+			best = 4 + d
+		}
+	}
+	return best
+}
+
+// editDistance is the Levenshtein distance between a and b.
+func editDistance(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	prev := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		cur := make([]int, len(rb)+1)
+		cur[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			cur[j] = min(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+		}
+		prev = cur
+	}
+	return prev[len(rb)]
+}
+
+// stationName resolves a station to its registry name, an empty station
+// stays empty so requests can leave the ends of a journey out.
+func (s *TrainService) stationName(name string) (string, error) {
+	if name == "" {
+		return "", nil
+	}
+	st, err := s.stations.Resolve(name)
+	if err != nil {
+		return "", err
+	}
+	return st.Name, nil
+}
+
+// route resolves both ends of a trip.
+func (s *TrainService) route(from, to string) (string, string, error) {
+	from, err := s.stationName(from)
+	if err != nil {
+		return "", "", err
+	}
+	to, err = s.stationName(to)
+	if err != nil {
+		return "", "", err
+	}
+	return from, to, nil
+}
+
+// ListStations returns every station in the registry.
+func (s *TrainService) ListStations(ctx context.Context, req *train.ListStationsRequest) (*train.ListStationsResponse, error) {
+	return &train.ListStationsResponse{Stations: s.stations.Stations()}, nil
+}
+
+// SearchStations finds stations for autocomplete.
+func (s *TrainService) SearchStations(ctx context.Context, req *train.SearchStationsRequest) (*train.SearchStationsResponse, error) {
+	limit := int(req.Limit)
+	if limit <= 0 {
+		limit = defaultSearchLimit
+	}
+	return &train.SearchStationsResponse{Stations: s.stations.Search(req.Query, limit)}, nil
+}
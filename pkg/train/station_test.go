@@ -0,0 +1,157 @@
+package reservation
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	train "github.com/bijoyv/train/pkg/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+func TestResolveStation(t *testing.T) {
+	registry := DefaultStationRegistry()
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{"London", "London"},
+		{"london", "London"},
+		{"London ", "London"},
+		{"LON", "London"},
+		{"st-pancras  international", "London"},
+		{"Gare du Nord", "Paris"},
+	}
+	for _, tt := range tests {
+		st, err := registry.Resolve(tt.input)
+		if err != nil {
+			t.Errorf("Resolve(%q) failed: %v", tt.input, err)
+			continue
+		}
+		if st.Name != tt.want {
+			t.Errorf("Expected %q to resolve to %s, got %s", tt.input, tt.want, st.Name)
+		}
+	}
+	if _, err := registry.Resolve("Londres"); err == nil {
+		t.Error("Expected an unknown station to fail")
+	}
+}
+
+func TestSearchStations(t *testing.T) {
+	trainService := NewTrainReservationService()
+	ctx := context.Background()
+	tests := []struct {
+		query string
+		first string
+	}{
+		{"par", "Paris"},
+		{"brux", "Brussels"},
+		{"midi", "Brussels"},
+		{"amstredam", "Amsterdam"},
+		{"RTD", "Rotterdam"},
+	}
+	for _, tt := range tests {
+		res, err := trainService.SearchStations(ctx, &train.SearchStationsRequest{Query: tt.query})
+		if err != nil {
+			t.Fatalf("SearchStations failed: %v", err)
+		}
+		if len(res.Stations) == 0 || res.Stations[0].Name != tt.first {
+			t.Errorf("Expected %q to find %s first, got %v", tt.query, tt.first, res.Stations)
+		}
+	}
+
+	res, err := trainService.SearchStations(ctx, &train.SearchStationsRequest{Query: "xyz"})
+	if err != nil {
+		t.Fatalf("SearchStations failed: %v", err)
+	}
+	if len(res.Stations) != 0 {
+		t.Errorf("Expected no stations for xyz, got %v", res.Stations)
+	}
+
+	res, err = trainService.SearchStations(ctx, &train.SearchStationsRequest{Query: "a", Limit: 2})
+	if err != nil {
+		t.Fatalf("SearchStations failed: %v", err)
+	}
+	if len(res.Stations) != 2 {
+		t.Errorf("Expected the limit to cut the results to 2, got %d", len(res.Stations))
+	}
+
+	list, err := trainService.ListStations(ctx, &train.ListStationsRequest{})
+	if err != nil {
+		t.Fatalf("ListStations failed: %v", err)
+	}
+	if len(list.Stations) != 9 || list.Stations[0].Name != "Amsterdam" {
+		t.Errorf("Expected 9 stations starting with Amsterdam, got %v", list.Stations)
+	}
+}
+
+func TestPurchaseNormalisesStations(t *testing.T) {
+	trainService := NewTrainReservationService()
+	ctx := context.Background()
+	res, err := trainService.PurchaseTicket(ctx, &train.PurchaseTicketRequest{
+		From: "lon",
+		To:   " Paris Nord",
+		User: &train.User{Email: "jon.reed@example.com"},
+	})
+	if err != nil {
+		t.Fatalf("PurchaseTicket failed: %v", err)
+	}
+	if res.Ticket.From != "London" || res.Ticket.To != "Paris" {
+		t.Errorf("Expected London to Paris, got %s to %s", res.Ticket.From, res.Ticket.To)
+	}
+	_, err = trainService.PurchaseTicket(ctx, &train.PurchaseTicketRequest{
+		From: "Londres",
+		To:   "Paris",
+		User: &train.User{Email: "kim.reed@example.com"},
+	})
+	if err == nil {
+		t.Error("Expected an unknown station to be rejected")
+	}
+}
+
+func TestLoadStationRegistry(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "stations.json")
+	data := `{"stations": [
+		{"code": "EDB", "name": "Edinburgh", "aliases": ["Edinburgh Waverley"], "timeZone": "Europe/London", "minConnection": "15m"},
+		{"code": "KGX", "name": "London Kings Cross", "aliases": ["Kings Cross"], "timeZone": "Europe/London"}
+	]}`
+	if err := os.WriteFile(path, []byte(data), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	registry, err := LoadStationRegistry(path)
+	if err != nil {
+		t.Fatalf("LoadStationRegistry failed: %v", err)
+	}
+	if st, err := registry.Resolve("waverley"); err == nil {
+		t.Errorf("Expected only whole aliases to resolve, got %s", st.Name)
+	}
+	if st, err := registry.Resolve("edinburgh waverley"); err != nil || st.Code != "EDB" {
+		t.Errorf("Expected Edinburgh, got %v %v", st, err)
+	}
+	if got := registry.MinConnection("EDB"); got != 15*time.Minute {
+		t.Errorf("Expected 15m to change at Edinburgh, got %s", got)
+	}
+	if got := registry.MinConnection("Kings Cross"); got != defaultMinConnection {
+		t.Errorf("Expected the default connection time at Kings Cross, got %s", got)
+	}
+
+	invalid := []struct {
+		name     string
+		stations []*train.Station
+	}{
+		{"no code", []*train.Station{{Name: "Edinburgh", TimeZone: "Europe/London"}}},
+		{"bad time zone", []*train.Station{{Code: "EDB", Name: "Edinburgh", TimeZone: "Europe/Edinburgh"}}},
+		{"negative connection", []*train.Station{{Code: "EDB", Name: "Edinburgh", TimeZone: "Europe/London", MinConnection: durationpb.New(-time.Minute)}}},
+		{"shared alias", []*train.Station{
+			{Code: "EDB", Name: "Edinburgh", Aliases: []string{"Scotland"}, TimeZone: "Europe/London"},
+			{Code: "GLC", Name: "Glasgow", Aliases: []string{"scotland"}, TimeZone: "Europe/London"},
+		}},
+	}
+	for _, tt := range invalid {
+		if _, err := NewStationRegistry(tt.stations); err == nil {
+			t.Errorf("Expected a registry with %s to fail", tt.name)
+		}
+	}
+}
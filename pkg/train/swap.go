@@ -0,0 +1,145 @@
+package reservation
+
+import (
+	"context"
+	"fmt"
+
+	train "github.com/bijoyv/train/pkg/proto"
+)
+
+// pendingSwap waits for the holder of the second ticket to agree.
+type pendingSwap struct {
+	id             string
+	reference      string
+	otherReference string
+}
+
+// swapTickets looks up both tickets of a swap and checks they can trade
+// seats: same journey and class, and each seat free for the other's segment once the
+// two tickets are left out. email must hold the ticket whose holder acts.
+func (st *state) swapTickets(reference, otherReference, email, acting string) (*journey, *train.Ticket, *train.Ticket, error) {
+	if reference == otherReference {
+		return nil, nil, nil, fmt.Errorf("cannot swap ticket %s with itself", reference)
+	}
+	if email == "" {
+		return nil, nil, nil, fmt.Errorf("swapping seats requires the email of ticket %s", acting)
+	}
+	aEmail, bEmail := "", ""
+	if acting == reference {
+		aEmail = email
+	} else {
+		bEmail = email
+	}
+	a, err := st.findTicket(reference, aEmail)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	b, err := st.findTicket(otherReference, bEmail)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if a.JourneyId != b.JourneyId {
+		return nil, nil, nil, fmt.Errorf("tickets %s and %s are on different journeys", a.Reference, b.Reference)
+	}
+	if a.SeatClass != b.SeatClass {
+		return nil, nil, nil, fmt.Errorf("tickets %s and %s are in different classes", a.Reference, b.Reference)
+	}
+	j, err := st.journey(a.JourneyId)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	return j, a, b, nil
+}
+
+// swap exchanges the seats of a and b or leaves both untouched.
+func (st *state) swap(j *journey, a, b *train.Ticket) error {
+	aStart, aEnd, err := j.segment(a.From, a.To)
+	if err != nil {
+		return err
+	}
+	bStart, bEnd, err := j.segment(b.From, b.To)
+	if err != nil {
+		return err
+	}
+	seatA, seatB := j.seats[a.Seat], j.seats[b.Seat]
+	seatA.release(a.Reference)
+	seatB.release(b.Reference)
+	if !seatB.free(aStart, aEnd) || !seatA.free(bStart, bEnd) {
+		seatA.occupy(aStart, aEnd, a.Reference)
+		seatB.occupy(bStart, bEnd, b.Reference)
+		return fmt.Errorf("seats %s and %s are not free for each other's journey", a.Seat, b.Seat)
+	}
+	seatB.occupy(aStart, aEnd, a.Reference)
+	seatA.occupy(bStart, bEnd, b.Reference)
+	a.Seat, b.Seat = b.Seat, a.Seat
+	return nil
+}
+
+// SwapSeats exchanges the seats of two tickets in one actor operation, or
+// records a pending swap when the other passenger has to consent. Consent
+// is needed unless both tickets were bought by the same purchaser.
+func (s *TrainService) SwapSeats(ctx context.Context, req *train.SwapSeatsRequest) (*train.SwapSeatsResponse, error) {
+	er := make(chan error, 1)
+	result := make(chan *train.SwapSeatsResponse, 1)
+
+	s.ops <- func(st *state) {
+		j, a, b, err := st.swapTickets(req.Reference, req.OtherReference, req.Email, req.Reference)
+		if err != nil {
+			er <- err
+			return
+		}
+		if req.RequireConsent || a.Purchaser.GetEmail() != b.Purchaser.GetEmail() {
+			p := &pendingSwap{id: st.newReference(), reference: a.Reference, otherReference: b.Reference}
+			st.swaps[p.id] = p
+			result <- &train.SwapSeatsResponse{SwapId: p.id}
+			return
+		}
+		if err := st.swap(j, a, b); err != nil {
+			er <- err
+			return
+		}
+		result <- &train.SwapSeatsResponse{Swapped: true, Tickets: snapshots([]*train.Ticket{a, b})}
+	}
+	select {
+	case e := <-er:
+		return nil, e
+	case res := <-result:
+		return res, nil
+	}
+}
+
+// AcceptSwap carries out a pending swap, only the holder of the second
+// ticket can accept it, identified by its reference and email.
+func (s *TrainService) AcceptSwap(ctx context.Context, req *train.AcceptSwapRequest) (*train.AcceptSwapResponse, error) {
+	er := make(chan error, 1)
+	result := make(chan []*train.Ticket, 1)
+
+	s.ops <- func(st *state) {
+		p, exists := st.swaps[req.SwapId]
+		if !exists {
+			er <- fmt.Errorf("swap %s not found", req.SwapId)
+			return
+		}
+		if req.Reference != p.otherReference {
+			er <- fmt.Errorf("swap %s can only be accepted by ticket %s", p.id, p.otherReference)
+			return
+		}
+		j, a, b, err := st.swapTickets(p.reference, p.otherReference, req.Email, p.otherReference)
+		if err != nil {
+			er <- err
+			return
+		}
+		delete(st.swaps, p.id)
+		if err := st.swap(j, a, b); err != nil {
+			er <- err
+			return
+		}
+		result <- snapshots([]*train.Ticket{a, b})
+	}
+	select {
+	case e := <-er:
+		return nil, e
+	case tickets := <-result:
+		return &train.AcceptSwapResponse{Tickets: tickets}, nil
+	}
+}
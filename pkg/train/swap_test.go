@@ -0,0 +1,113 @@
+package reservation
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	train "github.com/bijoyv/train/pkg/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestSwapSeats(t *testing.T) {
+	trainService := NewTrainReservationService()
+	ctx := context.Background()
+	purchase := func(email string, purchaser *train.Purchaser) *train.Ticket {
+		t.Helper()
+		res, err := trainService.PurchaseTicket(ctx, &train.PurchaseTicketRequest{
+			From:      "London",
+			To:        "Paris",
+			User:      &train.User{Email: email},
+			Purchaser: purchaser,
+		})
+		if err != nil {
+			t.Fatalf("PurchaseTicket failed: %v", err)
+		}
+		return res.Ticket
+	}
+	seatOf := func(ref string) string {
+		t.Helper()
+		res, err := trainService.GetTicket(ctx, &train.GetTicketRequest{Reference: ref})
+		if err != nil {
+			t.Fatalf("GetTicket failed: %v", err)
+		}
+		return res.Ticket.Seat
+	}
+
+	a, b := purchase("hal.ortiz@example.com", nil), purchase("ida.ortiz@example.com", nil)
+
+	t.Run("SwapSeats", func(t *testing.T) {
+		family := &train.Purchaser{Email: "kay.ortiz@example.com"}
+		c, d := purchase("lea.ortiz@example.com", family), purchase("max.ortiz@example.com", family)
+		res, err := trainService.SwapSeats(ctx, &train.SwapSeatsRequest{Reference: c.Reference, OtherReference: d.Reference, Email: "lea.ortiz@example.com"})
+		if err != nil {
+			t.Fatalf("SwapSeats failed: %v", err)
+		}
+		if !res.Swapped {
+			t.Fatal("Expected seats bought by one purchaser to be swapped")
+		}
+		if seatOf(c.Reference) != d.Seat || seatOf(d.Reference) != c.Seat {
+			t.Errorf("Expected %s and %s to trade seats %s and %s", c.Reference, d.Reference, c.Seat, d.Seat)
+		}
+	})
+
+	t.Run("SwapNotHolder", func(t *testing.T) {
+		for _, email := range []string{"", "ida.ortiz@example.com", "nobody@example.com"} {
+			if _, err := trainService.SwapSeats(ctx, &train.SwapSeatsRequest{Reference: a.Reference, OtherReference: b.Reference, Email: email}); err == nil {
+				t.Errorf("Expected a swap of %s asked by %q to fail", a.Reference, email)
+			}
+		}
+	})
+
+	t.Run("SwapWithConsent", func(t *testing.T) {
+		seatA, seatB := seatOf(a.Reference), seatOf(b.Reference)
+		// different purchasers always need the other passenger's consent
+		res, err := trainService.SwapSeats(ctx, &train.SwapSeatsRequest{Reference: a.Reference, OtherReference: b.Reference, Email: "hal.ortiz@example.com"})
+		if err != nil {
+			t.Fatalf("SwapSeats failed: %v", err)
+		}
+		if res.Swapped || res.SwapId == "" {
+			t.Fatalf("Expected a pending swap, got %v", res)
+		}
+		if seatOf(a.Reference) != seatA {
+			t.Error("Expected seats to stay put until the swap is accepted")
+		}
+		if _, err := trainService.AcceptSwap(ctx, &train.AcceptSwapRequest{SwapId: res.SwapId, Reference: a.Reference, Email: "hal.ortiz@example.com"}); err == nil {
+			t.Error("Expected error when the requester accepts their own swap, got nil")
+		}
+		if _, err := trainService.AcceptSwap(ctx, &train.AcceptSwapRequest{SwapId: res.SwapId, Reference: b.Reference, Email: "hal.ortiz@example.com"}); err == nil {
+			t.Error("Expected error when someone else accepts with the other reference, got nil")
+		}
+		if _, err := trainService.AcceptSwap(ctx, &train.AcceptSwapRequest{SwapId: res.SwapId, Reference: b.Reference, Email: "ida.ortiz@example.com"}); err != nil {
+			t.Fatalf("AcceptSwap failed: %v", err)
+		}
+		if seatOf(a.Reference) != seatB || seatOf(b.Reference) != seatA {
+			t.Error("Expected seats to be swapped after acceptance")
+		}
+	})
+
+	t.Run("SwapOtherJourney", func(t *testing.T) {
+		other, err := trainService.CreateJourney(ctx, &train.CreateJourneyRequest{
+			TrainNumber: "9O16",
+			Departure:   timestamppb.New(time.Date(2026, 10, 19, 11, 1, 0, 0, time.UTC)),
+			Origin:      "London",
+			Destination: "Paris",
+		})
+		if err != nil {
+			t.Fatalf("CreateJourney failed: %v", err)
+		}
+		res, err := trainService.PurchaseTicket(ctx, &train.PurchaseTicketRequest{
+			From:      "London",
+			To:        "Paris",
+			User:      &train.User{Email: "jon.ortiz@example.com"},
+			JourneyId: other.Journey.Id,
+		})
+		if err != nil {
+			t.Fatalf("PurchaseTicket failed: %v", err)
+		}
+		_, err = trainService.SwapSeats(ctx, &train.SwapSeatsRequest{Reference: a.Reference, OtherReference: res.Ticket.Reference, Email: "hal.ortiz@example.com"})
+		if err == nil {
+			t.Error("Expected error swapping across journeys, got nil")
+		}
+	})
+}
@@ -0,0 +1,155 @@
+package reservation
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	train "github.com/bijoyv/train/pkg/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// TransferPolicy decides whether a ticket may change hands. departure is
+// when the ticket's train leaves its boarding station, zero when unknown.
+type TransferPolicy interface {
+	Allow(ticket *train.Ticket, departure, now time.Time) error
+}
+
+// CutoffTransferPolicy refuses transfers within Cutoff of departure and
+// after MaxTransfers transfers, a zero MaxTransfers allows any number.
+type CutoffTransferPolicy struct {
+	Cutoff       time.Duration
+	MaxTransfers int
+}
+
+// DefaultTransferPolicy allows one transfer up to two hours before departure.
+func DefaultTransferPolicy() CutoffTransferPolicy {
+	return CutoffTransferPolicy{Cutoff: 2 * time.Hour, MaxTransfers: 1}
+}
+
+func (p CutoffTransferPolicy) Allow(ticket *train.Ticket, departure, now time.Time) error {
+	if p.MaxTransfers > 0 && len(ticket.PreviousHolders) >= p.MaxTransfers {
+		return fmt.Errorf("ticket %s has already been transferred %d times", ticket.Reference, len(ticket.PreviousHolders))
+	}
+	if !departure.IsZero() && now.After(departure.Add(-p.Cutoff)) {
+		return fmt.Errorf("ticket %s can no longer be transferred, the cutoff is %s before departure", ticket.Reference, p.Cutoff)
+	}
+	return nil
+}
+
+// departure returns when the journey leaves station, falling back to the
+// journey's departure for stops without a time.
+func (j *journey) departure(station string) time.Time {
+	if i := j.stopIndex(station); i >= 0 && j.info.Stops[i].Departure != nil {
+		return j.info.Stops[i].Departure.AsTime()
+	}
+	if j.info.Departure != nil {
+		return j.info.Departure.AsTime()
+	}
+	return time.Time{}
+}
+
+// rename moves every leg held by one occupant to another.
+func (s *seat) rename(from, to string) {
+	for i, o := range s.legs {
+		if o == from {
+			s.legs[i] = to
+		}
+	}
+}
+
+// rekey gives a ticket a new booking reference, so the old one stops working.
+func (st *state) rekey(ticket *train.Ticket) {
+	old := ticket.Reference
+	ticket.Reference = st.newReference()
+	if j, ok := st.journeys[ticket.JourneyId]; ok {
+		if seat, ok := j.seats[ticket.Seat]; ok {
+			seat.rename(old, ticket.Reference)
+		}
+	}
+	delete(st.tickets, old)
+	st.tickets[ticket.Reference] = ticket
+	for id, p := range st.swaps {
+		if p.reference == old || p.otherReference == old {
+			delete(st.swaps, id)
+		}
+	}
+}
+
+// TransferTicket hands a ticket to a new passenger, keeping the seat and
+// recording the previous holder. Only the purchaser may transfer a ticket. Every leg of a round trip or itinerary is
+// handed over together and the trip gets a new reference too.
+func (s *TrainService) TransferTicket(ctx context.Context, req *train.TransferTicketRequest) (*train.TransferTicketResponse, error) {
+	if req.NewUser == nil || req.NewUser.Email == "" {
+		return nil, fmt.Errorf("Invalid User Information")
+	}
+	er := make(chan error, 1)
+	result := make(chan *train.TransferTicketResponse, 1)
+
+	s.ops <- func(st *state) {
+		ticket, err := st.findPurchase(req.Reference, req.Email)
+		if err != nil {
+			er <- err
+			return
+		}
+		now := s.clock.Now()
+		if ticket.PassId != "" {
+			er <- fmt.Errorf("ticket %s was booked on pass %s and cannot be transferred", ticket.Reference, ticket.PassId)
+			return
+		}
+		var legs []*train.Ticket
+		for _, leg := range st.tripLegs(ticket) {
+			if !st.active(leg) {
+				continue
+			}
+			j, err := st.journey(leg.JourneyId)
+			if err != nil {
+				er <- err
+				return
+			}
+			if err := s.transfers.Allow(leg, j.departure(leg.From), now); err != nil {
+				er <- err
+				return
+			}
+			legs = append(legs, leg)
+		}
+		for _, leg := range legs {
+			leg.PreviousHolders = append(leg.PreviousHolders, &train.PreviousHolder{
+				User:          leg.User,
+				Reference:     leg.Reference,
+				TransferredAt: timestamppb.New(now),
+			})
+			leg.User = req.NewUser
+			st.rekey(leg)
+		}
+		if trip := tripReference(ticket); trip != "" {
+			st.rekeyTrip(trip)
+		}
+		res := &train.TransferTicketResponse{Ticket: snapshot(ticket)}
+		if tripReference(ticket) != "" {
+			res.Trip = snapshots(st.tripLegs(ticket))
+		}
+		result <- res
+	}
+	select {
+	case e := <-er:
+		return nil, e
+	case res := <-result:
+		return res, nil
+	}
+}
+
+// rekeyTrip gives a round trip or itinerary a new reference.
+func (st *state) rekeyTrip(old string) {
+	legs := st.trips[old]
+	trip := st.newReference()
+	for _, leg := range legs {
+		if leg.TripReference != "" {
+			leg.TripReference = trip
+		} else {
+			leg.ItineraryReference = trip
+		}
+	}
+	delete(st.trips, old)
+	st.trips[trip] = legs
+}
@@ -0,0 +1,97 @@
+package reservation
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	train "github.com/bijoyv/train/pkg/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestTransferTicket(t *testing.T) {
+	clock := newFakeClock()
+	trainService := NewTrainReservationService(WithClock(clock))
+	ctx := context.Background()
+	journey, err := trainService.CreateJourney(ctx, &train.CreateJourneyRequest{
+		TrainNumber: "9O18",
+		Departure:   timestamppb.New(clock.Now().Add(5 * time.Hour)),
+		Origin:      "London",
+		Destination: "Paris",
+	})
+	if err != nil {
+		t.Fatalf("CreateJourney failed: %v", err)
+	}
+	purchase := func(email string) *train.Ticket {
+		t.Helper()
+		res, err := trainService.PurchaseTicket(ctx, &train.PurchaseTicketRequest{
+			From:      "London",
+			To:        "Paris",
+			User:      &train.User{Email: email},
+			JourneyId: journey.Journey.Id,
+		})
+		if err != nil {
+			t.Fatalf("PurchaseTicket failed: %v", err)
+		}
+		return res.Ticket
+	}
+	original := purchase("kim.park@example.com")
+	late := purchase("lou.park@example.com")
+
+	// the reference alone, or someone else's email, is not enough
+	for _, email := range []string{"", "max.park@example.com"} {
+		_, err := trainService.TransferTicket(ctx, &train.TransferTicketRequest{
+			Reference: original.Reference,
+			Email:     email,
+			NewUser:   &train.User{Email: "max.park@example.com"},
+		})
+		if err == nil {
+			t.Errorf("Expected a transfer with email %q to fail", email)
+		}
+	}
+
+	res, err := trainService.TransferTicket(ctx, &train.TransferTicketRequest{
+		Reference: original.Reference,
+		Email:     "kim.park@example.com",
+		NewUser:   &train.User{Email: "max.park@example.com"},
+	})
+	if err != nil {
+		t.Fatalf("TransferTicket failed: %v", err)
+	}
+	ticket := res.Ticket
+	if ticket.Seat != original.Seat || ticket.User.Email != "max.park@example.com" || ticket.Reference == original.Reference {
+		t.Errorf("Expected seat %s under a new reference for max.park, got %v", original.Seat, ticket)
+	}
+	if len(ticket.PreviousHolders) != 1 || ticket.PreviousHolders[0].User.Email != "kim.park@example.com" {
+		t.Errorf("Expected kim.park recorded as previous holder, got %v", ticket.PreviousHolders)
+	}
+	if _, err := trainService.GetTicket(ctx, &train.GetTicketRequest{Reference: original.Reference}); err == nil {
+		t.Error("Expected the old reference to stop working, got nil")
+	}
+	seats, err := trainService.GetSeatsBySection(ctx, &train.GetSeatsBySectionRequest{JourneyId: journey.Journey.Id, Section: "A"})
+	if err != nil {
+		t.Fatalf("GetSeatsBySection failed: %v", err)
+	}
+	if seats.Seats[ticket.Seat] != "max.park@example.com" {
+		t.Errorf("Expected seat %s held by max.park, got %q", ticket.Seat, seats.Seats[ticket.Seat])
+	}
+
+	_, err = trainService.TransferTicket(ctx, &train.TransferTicketRequest{
+		Reference: ticket.Reference,
+		Email:     "kim.park@example.com",
+		NewUser:   &train.User{Email: "ned.park@example.com"},
+	})
+	if err == nil {
+		t.Error("Expected error transferring a ticket twice, got nil")
+	}
+
+	clock.Advance(4 * time.Hour)
+	_, err = trainService.TransferTicket(ctx, &train.TransferTicketRequest{
+		Reference: late.Reference,
+		Email:     "lou.park@example.com",
+		NewUser:   &train.User{Email: "ned.park@example.com"},
+	})
+	if err == nil {
+		t.Error("Expected error transferring inside the cutoff, got nil")
+	}
+}
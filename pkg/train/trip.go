@@ -0,0 +1,277 @@
+package reservation
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	train "github.com/bijoyv/train/pkg/proto"
+)
+
+// tripLeg is one train of a round trip or an itinerary, from and to are
+// registry names.
+type tripLeg struct {
+	journeyID string
+	from, to  string
+	name      string
+}
+
+// tripBooking is a set of legs booked and paid for together. check runs once
+// every leg has a seat and link marks each issued ticket as part of the trip.
+type tripBooking struct {
+	legs        []tripLeg
+	user        *train.User
+	purchaser   *train.Purchaser
+	class       train.SeatClass
+	passenger   train.PassengerType
+	preferences *train.SeatPreferences
+	roundTrip   bool
+	check       func(sales []sale) error
+	link        func(ticket *train.Ticket, reference string, leg int)
+}
+
+// tripReference is the round trip or itinerary reference of ticket, empty
+// for a single ticket.
+func tripReference(ticket *train.Ticket) string {
+	if ticket.TripReference != "" {
+		return ticket.TripReference
+	}
+	return ticket.ItineraryReference
+}
+
+// legOrder orders the tickets of a trip, outbound before return and
+// itinerary legs in travel order.
+func legOrder(ticket *train.Ticket) int {
+	return int(ticket.TripLeg) + int(ticket.ItineraryLeg)
+}
+
+// tripLegs returns the tickets of the round trip or itinerary ticket belongs
+// to, active or cancelled, in leg order. A single ticket is its own trip.
+func (st *state) tripLegs(ticket *train.Ticket) []*train.Ticket {
+	if legs, ok := st.trips[tripReference(ticket)]; ok {
+		return legs
+	}
+	return []*train.Ticket{ticket}
+}
+
+// tripTicket resolves a trip reference to the first of its tickets that is
+// still active, or cancelled when all are.
+func (st *state) tripTicket(reference string, cancelled bool) (*train.Ticket, bool) {
+	for _, t := range st.trips[reference] {
+		if (t.Cancellation != nil) == cancelled {
+			return t, true
+		}
+	}
+	return nil, false
+}
+
+// active reports whether ticket is still booked, it may have been cancelled
+// or have been given a new reference.
+func (st *state) active(ticket *train.Ticket) bool {
+	return st.tickets[ticket.Reference] == ticket
+}
+
+// quoteTrip prices every leg of a trip, at return fares for a round trip.
+func (s *TrainService) quoteTrip(legs []tripLeg, class train.SeatClass, passenger train.PassengerType, roundTrip bool) ([]*Fare, error) {
+	er := make(chan error, 1)
+	result := make(chan []*Fare, 1)
+
+	s.ops <- func(st *state) {
+		var fares []*Fare
+		for _, l := range legs {
+			j, err := st.openJourney(l.journeyID)
+			if err != nil {
+				er <- err
+				return
+			}
+			start, end, err := j.segment(l.from, l.to)
+			if err != nil {
+				er <- err
+				return
+			}
+			fare, err := s.priceLeg(j, start, end, class, passenger, roundTrip)
+			if err != nil {
+				er <- err
+				return
+			}
+			fares = append(fares, fare)
+		}
+		result <- fares
+	}
+	select {
+	case e := <-er:
+		return nil, e
+	case fares := <-result:
+		return fares, nil
+	}
+}
+
+// bookTrip books every leg of b in one operation, either all legs are
+// booked or none is. The legs are paid with one payment.
+func (s *TrainService) bookTrip(ctx context.Context, b *tripBooking) ([]*train.Ticket, error) {
+	fares, err := s.quoteTrip(b.legs, b.class, b.passenger, b.roundTrip)
+	if err != nil {
+		return nil, err
+	}
+	total := int64(0)
+	for _, fare := range fares {
+		total += fare.Total()
+	}
+	payment, err := s.payments.Authorize(ctx, purchaserFor(b.purchaser, b.user), fares[0].money(total))
+	if err != nil {
+		return nil, err
+	}
+
+	er := make(chan error, 1)
+	result := make(chan []*train.Ticket, 1)
+	s.ops <- func(st *state) {
+		sales := make([]sale, len(b.legs))
+		seats := make([]*seat, len(b.legs))
+		for i, l := range b.legs {
+			j, err := st.openJourney(l.journeyID)
+			if err != nil {
+				er <- err
+				return
+			}
+			start, end, err := j.segment(l.from, l.to)
+			if err != nil {
+				er <- err
+				return
+			}
+			current, err := s.priceLeg(j, start, end, b.class, b.passenger, b.roundTrip)
+			if err == nil {
+				err = priceChanged(fares[i], current)
+			}
+			if err != nil {
+				er <- err
+				return
+			}
+			if seats[i], _, err = s.assignSeat(j, start, end, b.class, b.preferences); err != nil {
+				er <- fmt.Errorf("%s journey %s: %w", l.name, j.info.Id, err)
+				return
+			}
+			sales[i] = sale{j: j, start: start, end: end, user: b.user, purchaser: b.purchaser, class: b.class, passenger: b.passenger, fare: fares[i], payment: payment}
+		}
+		if err := b.check(sales); err != nil {
+			er <- err
+			return
+		}
+		trip := st.newReference()
+		var tickets []*train.Ticket
+		for i, sl := range sales {
+			ticket, err := s.issue(st, sl, seats[i])
+			if err != nil {
+				for _, t := range tickets {
+					st.drop(t)
+				}
+				er <- err
+				return
+			}
+			b.link(ticket, trip, i)
+			tickets = append(tickets, ticket)
+		}
+		st.trips[trip] = tickets
+		result <- snapshots(tickets)
+	}
+	var tickets []*train.Ticket
+	select {
+	case e := <-er:
+		s.void(ctx, payment)
+		return nil, e
+	case tickets = <-result:
+	}
+	tickets, err = s.settleAll(ctx, payment, tickets)
+	if err != nil {
+		return nil, err
+	}
+	for _, t := range tickets {
+		s.emit(EventTicketPurchased, t)
+	}
+	return tickets, nil
+}
+
+// arrivalAt is when the journey gets to station, the departure from the stop
+// before when the stop has no arrival time, zero when neither is known.
+func (j *journey) arrivalAt(station string) time.Time {
+	if a := j.arrival(station); a != nil {
+		return a.AsTime()
+	}
+	if i := j.stopIndex(station); i > 0 {
+		return j.departure(j.info.Stops[i-1].Station)
+	}
+	return time.Time{}
+}
+
+// returnAfterOutbound checks the return journey leaves after the outbound
+// journey arrives, journeys without a timetable are not checked.
+func returnAfterOutbound(outbound, back *journey, station string) error {
+	arrives := outbound.arrivalAt(station)
+	leaves := back.departure(station)
+	if !arrives.IsZero() && !leaves.IsZero() && !leaves.After(arrives) {
+		return fmt.Errorf("return journey %s leaves %s before outbound journey %s gets there", back.info.Id, station, outbound.info.Id)
+	}
+	return nil
+}
+
+// PurchaseReturn books a round trip in one operation, either both legs are
+// booked or neither is. Both legs are priced at return fares and paid with
+// one payment.
+func (s *TrainService) PurchaseReturn(ctx context.Context, req *train.PurchaseReturnRequest) (*train.PurchaseReturnResponse, error) {
+	if err := validBooking(req.Purchaser, req.User); err != nil {
+		return nil, err
+	}
+	from, to, err := s.route(req.From, req.To)
+	if err != nil {
+		return nil, err
+	}
+	if from == "" || to == "" {
+		return nil, fmt.Errorf("a round trip requires a from and a to station")
+	}
+	if req.OutboundJourneyId == "" || req.ReturnJourneyId == "" || req.OutboundJourneyId == req.ReturnJourneyId {
+		return nil, fmt.Errorf("a round trip requires two different journeys")
+	}
+	directions := []train.TripLeg{train.TripLeg_TRIP_LEG_OUTBOUND, train.TripLeg_TRIP_LEG_RETURN}
+	tickets, err := s.bookTrip(ctx, &tripBooking{
+		legs: []tripLeg{
+			{journeyID: req.OutboundJourneyId, from: from, to: to, name: "outbound"},
+			{journeyID: req.ReturnJourneyId, from: to, to: from, name: "return"},
+		},
+		user:        req.User,
+		purchaser:   req.Purchaser,
+		class:       req.SeatClass,
+		passenger:   req.PassengerType,
+		preferences: req.Preferences,
+		roundTrip:   true,
+		check: func(sales []sale) error {
+			return returnAfterOutbound(sales[0].j, sales[1].j, to)
+		},
+		link: func(ticket *train.Ticket, reference string, leg int) {
+			ticket.TripReference = reference
+			ticket.TripLeg = directions[leg]
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &train.PurchaseReturnResponse{TripReference: tickets[0].TripReference, Tickets: tickets}, nil
+}
+
+// tripCancellation sums the cancellations of the legs cancelled together.
+func tripCancellation(tickets []*train.Ticket) *train.Cancellation {
+	c := &train.Cancellation{
+		CancelledAt: tickets[0].Cancellation.CancelledAt,
+		Refund:      &train.Money{Currency: tickets[0].Cancellation.Refund.Currency},
+		Fee:         &train.Money{Currency: tickets[0].Cancellation.Fee.Currency},
+	}
+	var rules []string
+	for _, t := range tickets {
+		c.Refund.MinorUnits += t.Cancellation.Refund.MinorUnits
+		c.Fee.MinorUnits += t.Cancellation.Fee.MinorUnits
+		if len(rules) == 0 || rules[len(rules)-1] != t.Cancellation.Rule {
+			rules = append(rules, t.Cancellation.Rule)
+		}
+	}
+	c.Rule = strings.Join(rules, ", ")
+	return c
+}
@@ -0,0 +1,225 @@
+package reservation
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	train "github.com/bijoyv/train/pkg/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// createTimetabledJourney adds a journey running from one station to another
+// that takes two hours and twenty minutes.
+func createTimetabledJourney(t *testing.T, trainService *TrainService, number, from, to, depart string, layout *train.SeatLayout) string {
+	t.Helper()
+	departure, err := time.Parse(time.RFC3339, depart)
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := trainService.CreateJourney(context.Background(), &train.CreateJourneyRequest{
+		TrainNumber: number,
+		Departure:   timestamppb.New(departure),
+		Layout:      layout,
+		Stops: []*train.Stop{
+			{Station: from, Departure: timestamppb.New(departure)},
+			{Station: to, Arrival: timestamppb.New(departure.Add(2*time.Hour + 20*time.Minute))},
+		},
+	})
+	if err != nil {
+		t.Fatalf("CreateJourney failed: %v", err)
+	}
+	return res.Journey.Id
+}
+
+func TestPurchaseReturn(t *testing.T) {
+	payments := NewFakePaymentProvider()
+	trainService := NewTrainReservationService(WithClock(newFakeClock()), WithPaymentProvider(payments))
+	ctx := context.Background()
+	outbound := createTimetabledJourney(t, trainService, "9O12", "London", "Paris", "2026-10-19T09:00:00Z", nil)
+	back := createTimetabledJourney(t, trainService, "9O51", "Paris", "London", "2026-10-21T18:00:00Z", nil)
+
+	res, err := trainService.PurchaseReturn(ctx, &train.PurchaseReturnRequest{
+		From:              "London",
+		To:                "Paris",
+		OutboundJourneyId: outbound,
+		ReturnJourneyId:   back,
+		User:              &train.User{FirstName: "Max", Email: "max.hale@example.com"},
+	})
+	if err != nil {
+		t.Fatalf("PurchaseReturn failed: %v", err)
+	}
+	if len(res.Tickets) != 2 {
+		t.Fatalf("Expected 2 tickets, got %d", len(res.Tickets))
+	}
+	out, ret := res.Tickets[0], res.Tickets[1]
+	if out.TripLeg != train.TripLeg_TRIP_LEG_OUTBOUND || ret.TripLeg != train.TripLeg_TRIP_LEG_RETURN || ret.From != "Paris" || ret.To != "London" {
+		t.Errorf("Expected London to Paris and back, got %v and %v", out, ret)
+	}
+	for _, ticket := range res.Tickets {
+		if ticket.TripReference != res.TripReference || ticket.Price.MinorUnits != 1800 || ticket.PaymentStatus != train.PaymentStatus_PAYMENT_STATUS_CAPTURED {
+			t.Errorf("Expected a captured 1800 leg of trip %s, got %v", res.TripReference, ticket)
+		}
+	}
+	if payment, _ := payments.Payment(out.PaymentId); payment.Amount.MinorUnits != 3600 || ret.PaymentId != out.PaymentId {
+		t.Errorf("Expected one payment of 3600, got %d", payment.Amount.MinorUnits)
+	}
+
+	t.Run("GetTicket", func(t *testing.T) {
+		for _, ref := range []string{res.TripReference, ret.Reference} {
+			got, err := trainService.GetTicket(ctx, &train.GetTicketRequest{Reference: ref})
+			if err != nil {
+				t.Fatalf("GetTicket failed: %v", err)
+			}
+			if len(got.Trip) != 2 || got.Trip[0].Reference != out.Reference || got.Trip[1].Reference != ret.Reference {
+				t.Errorf("Expected both legs for %s, got %v", ref, got.Trip)
+			}
+		}
+	})
+
+	var trip string
+	t.Run("Transfer", func(t *testing.T) {
+		moved, err := trainService.TransferTicket(ctx, &train.TransferTicketRequest{Reference: ret.Reference, Email: ret.Purchaser.Email, NewUser: &train.User{Email: "nia.hale@example.com"}})
+		if err != nil {
+			t.Fatalf("TransferTicket failed: %v", err)
+		}
+		if len(moved.Trip) != 2 {
+			t.Fatalf("Expected both legs transferred, got %v", moved.Trip)
+		}
+		for _, leg := range moved.Trip {
+			if leg.User.Email != "nia.hale@example.com" || leg.TripReference == res.TripReference {
+				t.Errorf("Expected a leg for the new passenger on a new trip reference, got %v", leg)
+			}
+		}
+		if _, err := trainService.GetTicket(ctx, &train.GetTicketRequest{Reference: res.TripReference}); err == nil {
+			t.Error("Expected the old trip reference to stop working")
+		}
+		trip = moved.Trip[0].TripReference
+	})
+
+	t.Run("Cancel", func(t *testing.T) {
+		removed, err := trainService.RemoveUser(ctx, &train.RemoveUserRequest{Reference: trip, Email: "max.hale@example.com"})
+		if err != nil {
+			t.Fatalf("RemoveUser failed: %v", err)
+		}
+		// 50% of the outbound leg a day before departure, all of the return
+		// leg, less a fee on each
+		if removed.Cancellation.Refund.MinorUnits != 2500 || removed.Cancellation.Fee.MinorUnits != 200 {
+			t.Errorf("Expected 2500 refunded less 200 in fees, got %d less %d", removed.Cancellation.Refund.MinorUnits, removed.Cancellation.Fee.MinorUnits)
+		}
+		if payment, _ := payments.Payment(out.PaymentId); payment.Refunded != 2500 {
+			t.Errorf("Expected 2500 back on the payment, got %d", payment.Refunded)
+		}
+		got, err := trainService.GetTicket(ctx, &train.GetTicketRequest{Reference: trip})
+		if err != nil {
+			t.Fatalf("GetTicket failed: %v", err)
+		}
+		for _, leg := range got.Trip {
+			if leg.Cancellation == nil {
+				t.Errorf("Expected leg %s to be cancelled", leg.Reference)
+			}
+		}
+	})
+}
+
+// flakyRefund fails the first refund it is asked for.
+type flakyRefund struct {
+	*FakePaymentProvider
+	failed atomic.Bool
+}
+
+func (p *flakyRefund) Refund(ctx context.Context, paymentID string, amount *train.Money) error {
+	if p.failed.CompareAndSwap(false, true) {
+		return fmt.Errorf("refund of %s timed out", paymentID)
+	}
+	return p.FakePaymentProvider.Refund(ctx, paymentID, amount)
+}
+
+func TestRemoveUserRefundsEveryLeg(t *testing.T) {
+	payments := &flakyRefund{FakePaymentProvider: NewFakePaymentProvider()}
+	notifier := &recordingNotifier{sent: make(chan Message, 10)}
+	trainService := NewTrainReservationService(WithClock(newFakeClock()), WithPaymentProvider(payments), WithNotifier(notifier))
+	ctx := context.Background()
+	outbound := createTimetabledJourney(t, trainService, "9O12", "London", "Paris", "2026-10-19T09:00:00Z", nil)
+	back := createTimetabledJourney(t, trainService, "9O51", "Paris", "London", "2026-10-21T18:00:00Z", nil)
+
+	res, err := trainService.PurchaseReturn(ctx, &train.PurchaseReturnRequest{
+		From:              "London",
+		To:                "Paris",
+		OutboundJourneyId: outbound,
+		ReturnJourneyId:   back,
+		User:              &train.User{FirstName: "Max", Email: "max.hale@example.com"},
+	})
+	if err != nil {
+		t.Fatalf("PurchaseReturn failed: %v", err)
+	}
+	for range res.Tickets {
+		receive(t, notifier.sent)
+	}
+
+	// the outbound refund fails, the return leg is still refunded
+	if _, err := trainService.RemoveUser(ctx, &train.RemoveUserRequest{Reference: res.TripReference, Email: "max.hale@example.com"}); err == nil || !strings.Contains(err.Error(), res.Tickets[0].Reference) {
+		t.Fatalf("Expected the outbound refund to fail, got %v", err)
+	}
+	if payment, _ := payments.Payment(res.Tickets[0].PaymentId); payment.Refunded != 1700 {
+		t.Errorf("Expected the 1700 return leg refunded, got %d", payment.Refunded)
+	}
+	cancelled := map[string]bool{}
+	for range res.Tickets {
+		if msg := receive(t, notifier.sent); msg.Event == EventTicketCancelled {
+			cancelled[msg.Reference] = true
+		}
+	}
+	for _, leg := range res.Tickets {
+		if !cancelled[leg.Reference] {
+			t.Errorf("Expected a cancellation for %s", leg.Reference)
+		}
+	}
+}
+
+func TestPurchaseReturnAllOrNothing(t *testing.T) {
+	payments := NewFakePaymentProvider()
+	trainService := NewTrainReservationService(WithClock(newFakeClock()), WithPaymentProvider(payments))
+	ctx := context.Background()
+	single := &train.SeatLayout{Coaches: []*train.Coach{{Code: "A", Seats: []*train.SeatDefinition{{Id: "A1"}}}}}
+	outbound := createTimetabledJourney(t, trainService, "9O12", "London", "Paris", "2026-10-19T09:00:00Z", nil)
+	early := createTimetabledJourney(t, trainService, "9O17", "Paris", "London", "2026-10-19T10:00:00Z", nil)
+	full := createTimetabledJourney(t, trainService, "9O51", "Paris", "London", "2026-10-21T18:00:00Z", single)
+	if _, err := trainService.PurchaseTicket(ctx, &train.PurchaseTicketRequest{JourneyId: full, From: "Paris", To: "London", User: &train.User{Email: "ola.hale@example.com"}}); err != nil {
+		t.Fatalf("PurchaseTicket failed: %v", err)
+	}
+
+	tests := []struct {
+		name string
+		back string
+	}{
+		{"a full return journey", full},
+		{"a return before the outbound arrives", early},
+		{"the same journey twice", outbound},
+	}
+	for _, tt := range tests {
+		_, err := trainService.PurchaseReturn(ctx, &train.PurchaseReturnRequest{
+			From:              "London",
+			To:                "Paris",
+			OutboundJourneyId: outbound,
+			ReturnJourneyId:   tt.back,
+			User:              &train.User{Email: "pam.hale@example.com"},
+		})
+		if err == nil {
+			t.Errorf("Expected a round trip with %s to fail", tt.name)
+		}
+	}
+	list, err := trainService.ListTicketsByUser(ctx, &train.ListTicketsByUserRequest{Email: "pam.hale@example.com"})
+	if err != nil {
+		t.Fatalf("ListTicketsByUser failed: %v", err)
+	}
+	if len(list.Tickets) != 0 {
+		t.Errorf("Expected no ticket left from failed round trips, got %v", list.Tickets)
+	}
+	if payment, _ := payments.Payment("PAY000002"); payment.Status != train.PaymentStatus_PAYMENT_STATUS_VOIDED {
+		t.Errorf("Expected the round trip payment to be voided, got %s", payment.Status)
+	}
+}
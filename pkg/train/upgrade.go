@@ -0,0 +1,223 @@
+package reservation
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	train "github.com/bijoyv/train/pkg/proto"
+	"google.golang.org/protobuf/proto"
+)
+
+// upgrade is a ticket moving up a class, seat is where it goes and fare what
+// it costs in the new class. previous keeps the ticket as it was so a failed
+// payment can put it back, its seat stays taken until the charge is captured.
+type upgrade struct {
+	j          *journey
+	start, end int
+	ticket     *train.Ticket
+	seat       *seat
+	fare       *Fare
+	previous   *train.Ticket
+}
+
+// charge is the fare difference the purchaser pays, never negative.
+func (u *upgrade) charge() *train.Money {
+	return u.fare.money(max(u.fare.Total()-u.ticket.Price.GetMinorUnits(), 0))
+}
+
+// planUpgrade checks a ticket can move to class and picks its new seat.
+func (s *TrainService) planUpgrade(st *state, req *train.UpgradeSeatRequest) (*upgrade, error) {
+	ticket, err := st.findPurchase(req.Reference, req.Email)
+	if err != nil {
+		return nil, err
+	}
+	class := seatClass(req.SeatClass)
+	if class <= seatClass(ticket.SeatClass) {
+		return nil, fmt.Errorf("ticket %s is already in %s class", ticket.Reference, enumName(ticket.SeatClass.String(), "SEAT_CLASS_"))
+	}
+	j, err := st.journey(ticket.JourneyId)
+	if err != nil {
+		return nil, err
+	}
+	start, end, err := j.segment(ticket.From, ticket.To)
+	if err != nil {
+		return nil, err
+	}
+	u := &upgrade{j: j, start: start, end: end, ticket: ticket}
+	if req.NewSeat != "" {
+		target, exists := j.seats[req.NewSeat]
+		switch {
+		case !exists:
+			return nil, fmt.Errorf("seat %s does not exist on journey %s", req.NewSeat, j.info.Id)
+		case target.blocked:
+			return nil, fmt.Errorf("seat %s is out of service", req.NewSeat)
+		case target.class != class:
+			return nil, fmt.Errorf("seat %s is not in %s class", req.NewSeat, enumName(class.String(), "SEAT_CLASS_"))
+		case !target.free(start, end):
+			return nil, fmt.Errorf("seat %s already in use", req.NewSeat)
+		}
+		u.seat = target
+	} else if u.seat, _, err = s.assignSeat(j, start, end, class, nil); err != nil {
+		return nil, err
+	}
+	if u.fare, err = s.priceLeg(j, start, end, class, ticket.PassengerType, ticket.TripReference != ""); err != nil {
+		return nil, err
+	}
+	if p, ok := st.passes[ticket.PassId]; ok {
+		if err := s.passDiscount(u.fare, p, j, start, end); err != nil {
+			return nil, err
+		}
+	}
+	return u, nil
+}
+
+// apply moves the ticket into its new seat and class. The fare breakdown is
+// the sale as invoiced, the charge is added to the price and recorded as an
+// upgrade payment that is invoiced on its own. A paid upgrade keeps the old
+// seat taken until the charge is captured, so it can always be undone.
+func (u *upgrade) apply(payment string) {
+	u.previous = proto.Clone(u.ticket).(*train.Ticket)
+	if payment == "" {
+		u.j.seats[u.ticket.Seat].release(u.ticket.Reference)
+	}
+	u.seat.occupy(u.start, u.end, u.ticket.Reference)
+	charged := u.charge()
+	u.ticket.Seat = u.seat.id
+	u.ticket.SeatClass = u.seat.class
+	u.ticket.Price = u.fare.money(u.ticket.Price.GetMinorUnits() + charged.MinorUnits)
+	u.ticket.NonRefundable = u.fare.NonRefundable
+	if payment != "" {
+		u.ticket.UpgradePayments = append(u.ticket.UpgradePayments, &train.Payment{
+			Id:          payment,
+			Amount:      charged,
+			Status:      train.PaymentStatus_PAYMENT_STATUS_AUTHORIZED,
+			Description: fmt.Sprintf("Upgrade to %s class", enumName(u.seat.class.String(), "SEAT_CLASS_")),
+		})
+	}
+}
+
+// settleUpgrade gives up the old seat once the charge is captured and
+// invoices the charge.
+func (s *TrainService) settleUpgrade(st *state, u *upgrade, payment string) {
+	u.j.seats[u.previous.Seat].release(u.previous.Reference)
+	if p := upgradePayment(u.ticket, payment); p != nil {
+		p.Status = train.PaymentStatus_PAYMENT_STATUS_CAPTURED
+		p.InvoiceNumber, p.InvoicedAt = s.nextInvoice(st)
+	}
+	s.seatsFreed(st, u.j)
+}
+
+// revert puts the ticket back in the seat kept for it when the charge could
+// not be captured. The passenger may have changed seat since the upgrade, so
+// the seat given up is the one the ticket holds now. A ticket cancelled or
+// moved to another journey meanwhile only has its charge marked voided.
+func (s *TrainService) revert(st *state, u *upgrade, payment string) {
+	old := u.j.seats[u.previous.Seat]
+	old.release(u.previous.Reference)
+	if !st.active(u.ticket) || u.ticket.JourneyId != u.previous.JourneyId {
+		if p := upgradePayment(u.ticket, payment); p != nil {
+			p.Status = train.PaymentStatus_PAYMENT_STATUS_VOIDED
+		}
+		log.Printf("upgrade of %s not reverted, the ticket has changed", u.ticket.Reference)
+		s.seatsFreed(st, u.j)
+		return
+	}
+	u.j.seats[u.ticket.Seat].release(u.ticket.Reference)
+	old.occupy(u.start, u.end, u.ticket.Reference)
+	u.ticket.Seat = u.previous.Seat
+	u.ticket.SeatClass = u.previous.SeatClass
+	u.ticket.Price = u.previous.Price
+	u.ticket.NonRefundable = u.previous.NonRefundable
+	u.ticket.UpgradePayments = u.previous.UpgradePayments
+	s.seatsFreed(st, u.j)
+}
+
+// UpgradeSeat moves a ticket to a higher class and charges the purchaser the
+// difference between its fare and the fare in the new class. Like a
+// purchase the charge is authorized before the move and captured after.
+func (s *TrainService) UpgradeSeat(ctx context.Context, req *train.UpgradeSeatRequest) (*train.UpgradeSeatResponse, error) {
+	type quote struct {
+		charge *train.Money
+		payer  *train.Purchaser
+	}
+	er := make(chan error, 1)
+	quoted := make(chan quote, 1)
+	s.ops <- func(st *state) {
+		u, err := s.planUpgrade(st, req)
+		if err != nil {
+			er <- err
+			return
+		}
+		quoted <- quote{charge: u.charge(), payer: proto.Clone(u.ticket.Purchaser).(*train.Purchaser)}
+	}
+	var q quote
+	select {
+	case e := <-er:
+		return nil, e
+	case q = <-quoted:
+	}
+
+	var payment string
+	if q.charge.MinorUnits > 0 {
+		var err error
+		if payment, err = s.payments.Authorize(ctx, q.payer, q.charge); err != nil {
+			return nil, err
+		}
+	}
+
+	// the upgrade is planned again since the seat or fare may have changed
+	// while the payment was authorized, the charge must still be the same
+	upgraded := make(chan *upgrade, 1)
+	s.ops <- func(st *state) {
+		u, err := s.planUpgrade(st, req)
+		if err != nil {
+			er <- err
+			return
+		}
+		if charge := u.charge(); charge.MinorUnits != q.charge.MinorUnits {
+			er <- fmt.Errorf("the upgrade price changed to %d %s, please try again", charge.MinorUnits, charge.Currency)
+			return
+		}
+		u.apply(payment)
+		upgraded <- u
+	}
+	var u *upgrade
+	select {
+	case e := <-er:
+		if payment != "" {
+			s.void(ctx, payment)
+		}
+		return nil, e
+	case u = <-upgraded:
+	}
+
+	if payment == "" {
+		return &train.UpgradeSeatResponse{Ticket: s.ticketCopy(u.ticket), Charged: q.charge}, nil
+	}
+	if err := s.payments.Capture(ctx, payment); err != nil {
+		done := make(chan bool, 1)
+		s.ops <- func(st *state) {
+			s.revert(st, u, payment)
+			done <- true
+		}
+		<-done
+		s.void(ctx, payment)
+		return nil, fmt.Errorf("capture payment %s: %w", payment, err)
+	}
+	settled := make(chan *train.Ticket, 1)
+	s.ops <- func(st *state) {
+		s.settleUpgrade(st, u, payment)
+		settled <- snapshot(u.ticket)
+	}
+	return &train.UpgradeSeatResponse{Ticket: <-settled, Charged: q.charge}, nil
+}
+
+// ticketCopy takes a copy of a ticket on the actor.
+func (s *TrainService) ticketCopy(ticket *train.Ticket) *train.Ticket {
+	result := make(chan *train.Ticket, 1)
+	s.ops <- func(st *state) {
+		result <- snapshot(ticket)
+	}
+	return <-result
+}
@@ -0,0 +1,279 @@
+package reservation
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	train "github.com/bijoyv/train/pkg/proto"
+)
+
+func newClassService(t *testing.T) (*TrainService, *FakePaymentProvider) {
+	t.Helper()
+	payments := NewFakePaymentProvider()
+	trainService := NewTrainReservationService(
+		WithPaymentProvider(payments),
+		WithFarePolicy(&TableFarePolicy{Currency: "GBP", DefaultBase: 2000, ClassSupplements: map[string]int64{"first": 50}}),
+		WithSeatLayout(&train.SeatLayout{Coaches: []*train.Coach{
+			{Code: "F", Seats: []*train.SeatDefinition{{Id: "F1"}}, SeatClass: train.SeatClass_SEAT_CLASS_FIRST},
+			{Code: "S", Rows: 1, Columns: "AB"},
+		}}),
+	)
+	return trainService, payments
+}
+
+func TestSeatClasses(t *testing.T) {
+	trainService, _ := newClassService(t)
+	ctx := context.Background()
+	purchase := func(email string, class train.SeatClass) (*train.PurchaseTicketResponse, error) {
+		return trainService.PurchaseTicket(ctx, &train.PurchaseTicketRequest{
+			From:      "London",
+			To:        "Paris",
+			User:      &train.User{Email: email},
+			SeatClass: class,
+		})
+	}
+
+	standard, err := purchase("ada.vale@example.com", train.SeatClass_SEAT_CLASS_UNSPECIFIED)
+	if err != nil {
+		t.Fatalf("PurchaseTicket failed: %v", err)
+	}
+	if standard.Ticket.Seat != "S1" || standard.Ticket.SeatClass != train.SeatClass_SEAT_CLASS_STANDARD {
+		t.Errorf("Expected standard seat S1, got %s %s", standard.Ticket.Seat, standard.Ticket.SeatClass)
+	}
+	first, err := purchase("bea.vale@example.com", train.SeatClass_SEAT_CLASS_FIRST)
+	if err != nil {
+		t.Fatalf("PurchaseTicket failed: %v", err)
+	}
+	if first.Ticket.Seat != "F1" || first.Ticket.Price.MinorUnits != 3000 {
+		t.Errorf("Expected F1 at 3000, got %s at %d", first.Ticket.Seat, first.Ticket.Price.MinorUnits)
+	}
+
+	t.Run("ClassFull", func(t *testing.T) {
+		if _, err := purchase("cal.vale@example.com", train.SeatClass_SEAT_CLASS_FIRST); err == nil {
+			t.Error("Expected first class to be full while standard seats are free")
+		}
+	})
+
+	t.Run("ModifySeatAcrossClasses", func(t *testing.T) {
+		_, err := trainService.ModifySeat(ctx, &train.ModifySeatRequest{Reference: first.Ticket.Reference, NewSeat: "S2"})
+		if err == nil {
+			t.Error("Expected moving a first class ticket into standard to fail")
+		}
+	})
+}
+
+func TestUpgradeSeat(t *testing.T) {
+	trainService, payments := newClassService(t)
+	ctx := context.Background()
+	res, err := trainService.PurchaseTicket(ctx, &train.PurchaseTicketRequest{
+		From: "London",
+		To:   "Paris",
+		User: &train.User{Email: "dee.vale@example.com"},
+	})
+	if err != nil {
+		t.Fatalf("PurchaseTicket failed: %v", err)
+	}
+	ticket := res.Ticket
+
+	upgraded, err := trainService.UpgradeSeat(ctx, &train.UpgradeSeatRequest{Reference: ticket.Reference, Email: ticket.Purchaser.Email, SeatClass: train.SeatClass_SEAT_CLASS_FIRST})
+	if err != nil {
+		t.Fatalf("UpgradeSeat failed: %v", err)
+	}
+	if upgraded.Charged.MinorUnits != 1000 {
+		t.Errorf("Expected to be charged 1000, got %d", upgraded.Charged.MinorUnits)
+	}
+	got := upgraded.Ticket
+	if got.Seat != "F1" || got.SeatClass != train.SeatClass_SEAT_CLASS_FIRST || got.Price.MinorUnits != 3000 {
+		t.Errorf("Expected first class seat F1 at 3000, got %s %s at %d", got.Seat, got.SeatClass, got.Price.MinorUnits)
+	}
+	if len(got.UpgradePayments) != 1 || got.UpgradePayments[0].Status != train.PaymentStatus_PAYMENT_STATUS_CAPTURED {
+		t.Fatalf("Expected a captured upgrade payment, got %v", got.UpgradePayments)
+	}
+
+	t.Run("SeatFreed", func(t *testing.T) {
+		seats, err := trainService.GetSeatsBySection(ctx, &train.GetSeatsBySectionRequest{Section: "S"})
+		if err != nil {
+			t.Fatalf("GetSeatsBySection failed: %v", err)
+		}
+		if seats.Seats[ticket.Seat] != "" {
+			t.Errorf("Expected %s to be free after the upgrade, got %s", ticket.Seat, seats.Seats[ticket.Seat])
+		}
+	})
+
+	t.Run("NotHigher", func(t *testing.T) {
+		_, err := trainService.UpgradeSeat(ctx, &train.UpgradeSeatRequest{Reference: ticket.Reference, Email: ticket.Purchaser.Email, SeatClass: train.SeatClass_SEAT_CLASS_FIRST})
+		if err == nil {
+			t.Error("Expected upgrading a first class ticket to first to fail")
+		}
+	})
+
+	t.Run("Receipt", func(t *testing.T) {
+		res, err := trainService.GetReceipt(ctx, &train.GetReceiptRequest{Reference: ticket.Reference, Email: ticket.Purchaser.Email})
+		if err != nil {
+			t.Fatalf("GetReceipt failed: %v", err)
+		}
+		// the sale keeps its invoice, the upgrade is invoiced on its own
+		for _, want := range []string{"INV-000001", "20.00 GBP", "Upgrade to first class, INV-000002", "10.00 GBP"} {
+			if !strings.Contains(string(res.Content), want) {
+				t.Errorf("Expected %q on the receipt, got\n%s", want, res.Content)
+			}
+		}
+		if res.InvoiceNumber != "INV-000001" || got.UpgradePayments[0].InvoiceNumber != "INV-000002" {
+			t.Errorf("Expected the sale on INV-000001 and the upgrade on INV-000002, got %s and %s", res.InvoiceNumber, got.UpgradePayments[0].InvoiceNumber)
+		}
+	})
+
+	t.Run("RefundBothPayments", func(t *testing.T) {
+		removed, err := trainService.RemoveUser(ctx, &train.RemoveUserRequest{Reference: ticket.Reference, Email: ticket.Purchaser.Email})
+		if err != nil {
+			t.Fatalf("RemoveUser failed: %v", err)
+		}
+		if removed.Cancellation.Refund.MinorUnits != 2900 {
+			t.Errorf("Expected 2900 refunded, got %d", removed.Cancellation.Refund.MinorUnits)
+		}
+		upgrade, _ := payments.Payment(got.UpgradePayments[0].Id)
+		original, _ := payments.Payment(got.PaymentId)
+		if upgrade.Refunded != 1000 || original.Refunded != 1900 {
+			t.Errorf("Expected 1000 back on the upgrade and 1900 on the fare, got %d and %d", upgrade.Refunded, original.Refunded)
+		}
+	})
+}
+
+// gatedCapture holds every capture until the test says how it ends.
+type gatedCapture struct {
+	*FakePaymentProvider
+	captures chan string
+	results  chan error
+}
+
+func (p gatedCapture) Capture(ctx context.Context, paymentID string) error {
+	p.captures <- paymentID
+	if err := <-p.results; err != nil {
+		return err
+	}
+	return p.FakePaymentProvider.Capture(ctx, paymentID)
+}
+
+func TestUpgradeRevertAfterSeatChange(t *testing.T) {
+	payments := gatedCapture{NewFakePaymentProvider(), make(chan string, 1), make(chan error, 1)}
+	trainService := NewTrainReservationService(
+		WithPaymentProvider(payments),
+		WithFarePolicy(&TableFarePolicy{Currency: "GBP", DefaultBase: 2000, ClassSupplements: map[string]int64{"first": 50}}),
+		WithSeatLayout(&train.SeatLayout{Coaches: []*train.Coach{
+			{Code: "F", Rows: 1, Columns: "AB", SeatClass: train.SeatClass_SEAT_CLASS_FIRST},
+			{Code: "S", Rows: 1, Columns: "AB"},
+		}}),
+	)
+	ctx := context.Background()
+	payments.results <- nil
+	res, err := trainService.PurchaseTicket(ctx, &train.PurchaseTicketRequest{From: "London", To: "Paris", User: &train.User{Email: "eli.vale@example.com"}})
+	if err != nil {
+		t.Fatalf("PurchaseTicket failed: %v", err)
+	}
+	<-payments.captures
+	ticket := res.Ticket
+
+	upgraded := make(chan error, 1)
+	go func() {
+		_, err := trainService.UpgradeSeat(ctx, &train.UpgradeSeatRequest{Reference: ticket.Reference, Email: ticket.Purchaser.Email, SeatClass: train.SeatClass_SEAT_CLASS_FIRST})
+		upgraded <- err
+	}()
+	// the passenger moves within first class while the charge is captured
+	<-payments.captures
+	if _, err := trainService.ModifySeat(ctx, &train.ModifySeatRequest{Reference: ticket.Reference, NewSeat: "F2"}); err != nil {
+		t.Fatalf("ModifySeat failed: %v", err)
+	}
+	payments.results <- fmt.Errorf("capture declined")
+	if err := <-upgraded; err == nil {
+		t.Fatal("Expected the upgrade to fail with its capture")
+	}
+
+	got, err := trainService.GetTicket(ctx, &train.GetTicketRequest{Reference: ticket.Reference})
+	if err != nil {
+		t.Fatalf("GetTicket failed: %v", err)
+	}
+	if got.Ticket.Seat != ticket.Seat || got.Ticket.SeatClass != train.SeatClass_SEAT_CLASS_STANDARD {
+		t.Errorf("Expected the ticket back in %s standard, got %s %s", ticket.Seat, got.Ticket.Seat, got.Ticket.SeatClass)
+	}
+	seats, err := trainService.GetSeatsBySection(ctx, &train.GetSeatsBySectionRequest{Section: "F"})
+	if err != nil {
+		t.Fatalf("GetSeatsBySection failed: %v", err)
+	}
+	for id, name := range seats.Seats {
+		if name != "" {
+			t.Errorf("Expected first class to be free, %s is taken by %s", id, name)
+		}
+	}
+}
+
+// heldCapture holds the capture of one payment until the test says how it
+// ends, other payments are captured straight away.
+type heldCapture struct {
+	*FakePaymentProvider
+	id       string
+	captured chan struct{}
+	result   chan error
+}
+
+func (p heldCapture) Capture(ctx context.Context, paymentID string) error {
+	if paymentID == p.id {
+		p.captured <- struct{}{}
+		if err := <-p.result; err != nil {
+			return err
+		}
+	}
+	return p.FakePaymentProvider.Capture(ctx, paymentID)
+}
+
+func TestUpgradeKeepsOldSeatUntilCaptured(t *testing.T) {
+	// the upgrade is the second payment
+	payments := heldCapture{NewFakePaymentProvider(), "PAY000002", make(chan struct{}), make(chan error)}
+	trainService := NewTrainReservationService(
+		WithPaymentProvider(payments),
+		WithFarePolicy(&TableFarePolicy{Currency: "GBP", DefaultBase: 2000, ClassSupplements: map[string]int64{"first": 50}}),
+		WithSeatLayout(&train.SeatLayout{Coaches: []*train.Coach{
+			{Code: "F", Seats: []*train.SeatDefinition{{Id: "F1"}}, SeatClass: train.SeatClass_SEAT_CLASS_FIRST},
+			{Code: "S", Rows: 1, Columns: "AB"},
+		}}),
+	)
+	ctx := context.Background()
+	purchase := func(email string) (*train.PurchaseTicketResponse, error) {
+		return trainService.PurchaseTicket(ctx, &train.PurchaseTicketRequest{From: "London", To: "Paris", User: &train.User{Email: email}})
+	}
+	res, err := purchase("fay.vale@example.com")
+	if err != nil {
+		t.Fatalf("PurchaseTicket failed: %v", err)
+	}
+	ticket := res.Ticket
+
+	upgraded := make(chan error, 1)
+	go func() {
+		_, err := trainService.UpgradeSeat(ctx, &train.UpgradeSeatRequest{Reference: ticket.Reference, Email: ticket.Purchaser.Email, SeatClass: train.SeatClass_SEAT_CLASS_FIRST})
+		upgraded <- err
+	}()
+	<-payments.captured
+	// while the charge is captured the old seat is not for sale
+	if _, err := purchase("gus.vale@example.com"); err != nil {
+		t.Fatalf("PurchaseTicket failed: %v", err)
+	}
+	if _, err := purchase("hal.vale@example.com"); err == nil {
+		t.Error("Expected the seat kept for the upgrade not to be sold")
+	}
+	payments.result <- fmt.Errorf("capture declined")
+	if err := <-upgraded; err == nil {
+		t.Fatal("Expected the upgrade to fail with its capture")
+	}
+
+	got, err := trainService.GetTicket(ctx, &train.GetTicketRequest{Reference: ticket.Reference})
+	if err != nil {
+		t.Fatalf("GetTicket failed: %v", err)
+	}
+	if got.Ticket.Seat != ticket.Seat || got.Ticket.SeatClass != train.SeatClass_SEAT_CLASS_STANDARD || got.Ticket.Price.MinorUnits != 2000 || len(got.Ticket.UpgradePayments) != 0 {
+		t.Errorf("Expected the ticket back in %s standard at 2000, got %v", ticket.Seat, got.Ticket)
+	}
+	if payment, _ := payments.Payment("PAY000002"); payment.Status != train.PaymentStatus_PAYMENT_STATUS_VOIDED {
+		t.Errorf("Expected the upgrade payment voided, got %s", payment.Status)
+	}
+}
@@ -0,0 +1,157 @@
+package reservation
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	train "github.com/bijoyv/train/pkg/proto"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// waitEntry is a passenger queued for a full journey segment.
+type waitEntry struct {
+	info        *train.WaitlistEntry
+	start, end  int
+	purchaser   *train.Purchaser
+	class       train.SeatClass
+	passenger   train.PassengerType
+	preferences *train.SeatPreferences
+	// the fare quoted and authorized when the passenger joined, captured
+	// once a seat comes up
+	fare    *Fare
+	payment string
+}
+
+// joinWaitlist queues a purchase that found the journey full, payment stays
+// authorized for fare until the passenger is ticketed or leaves the queue.
+func (s *TrainService) joinWaitlist(st *state, j *journey, start, end int, req *train.PurchaseTicketRequest, fare *Fare, payment string) *train.WaitlistEntry {
+	entry := &waitEntry{
+		info: &train.WaitlistEntry{
+			Id:        st.newReference(),
+			JourneyId: j.info.Id,
+			From:      j.info.Stops[start].Station,
+			To:        j.info.Stops[end].Station,
+			User:      req.User,
+			JoinedAt:  timestamppb.New(s.clock.Now()),
+		},
+		start:       start,
+		end:         end,
+		purchaser:   req.Purchaser,
+		class:       req.SeatClass,
+		passenger:   req.PassengerType,
+		preferences: req.Preferences,
+		fare:        fare,
+		payment:     payment,
+	}
+	j.waitlist = append(j.waitlist, entry)
+	st.waiting[entry.info.Id] = j
+	entry.info.Position = int32(len(j.waitlist))
+	return proto.Clone(entry.info).(*train.WaitlistEntry)
+}
+
+// seatsFreed is called whenever seats of a journey go back into inventory,
+// it tickets waitlisted passengers first come first served at the fare they
+// joined with. A passenger whose segment is still full keeps their place
+// and the next one is tried.
+func (s *TrainService) seatsFreed(st *state, j *journey) {
+	for i := 0; i < len(j.waitlist); {
+		entry := j.waitlist[i]
+		seat, _, err := s.assignSeat(j, entry.start, entry.end, entry.class, entry.preferences)
+		if err != nil {
+			i++
+			continue
+		}
+		ticket, err := s.issue(st, sale{j: j, start: entry.start, end: entry.end, user: entry.info.User, purchaser: entry.purchaser, class: entry.class, passenger: entry.passenger, fare: entry.fare, payment: entry.payment}, seat)
+		if err != nil {
+			i++
+			continue
+		}
+		st.leaveWaitlist(entry.info.Id)
+		go s.promoted(snapshot(ticket))
+	}
+}
+
+// promoted captures the payment of a ticket issued off the waitlist, off the
+// actor. When the capture fails the ticket is given up and its seat goes to
+// the next passenger waiting.
+func (s *TrainService) promoted(ticket *train.Ticket) {
+	if ticket.PaymentId != "" {
+		settled, err := s.settle(context.Background(), ticket)
+		if err != nil {
+			log.Printf("waitlisted ticket %s not paid, seat released: %v", ticket.Reference, err)
+			return
+		}
+		ticket = settled
+	}
+	s.emit(EventWaitlistPromoted, ticket)
+}
+
+// leaveWaitlist drops an entry from its journey's queue, it returns the
+// entry so a caller giving up the place can void its payment.
+func (st *state) leaveWaitlist(id string) (*waitEntry, bool) {
+	j, ok := st.waiting[id]
+	if !ok {
+		return nil, false
+	}
+	delete(st.waiting, id)
+	for i, entry := range j.waitlist {
+		if entry.info.Id == id {
+			j.waitlist = append(j.waitlist[:i], j.waitlist[i+1:]...)
+			return entry, true
+		}
+	}
+	return nil, false
+}
+
+// GetWaitlistPosition reports where an entry currently is in the queue.
+func (s *TrainService) GetWaitlistPosition(ctx context.Context, req *train.GetWaitlistPositionRequest) (*train.GetWaitlistPositionResponse, error) {
+	er := make(chan error, 1)
+	result := make(chan *train.WaitlistEntry, 1)
+
+	s.ops <- func(st *state) {
+		j, ok := st.waiting[req.WaitlistId]
+		if !ok {
+			er <- fmt.Errorf("waitlist entry %s not found", req.WaitlistId)
+			return
+		}
+		for i, entry := range j.waitlist {
+			if entry.info.Id == req.WaitlistId {
+				entry.info.Position = int32(i + 1)
+				result <- proto.Clone(entry.info).(*train.WaitlistEntry)
+				return
+			}
+		}
+		er <- fmt.Errorf("waitlist entry %s not found", req.WaitlistId)
+	}
+	select {
+	case e := <-er:
+		return nil, e
+	case entry := <-result:
+		return &train.GetWaitlistPositionResponse{Entry: entry}, nil
+	}
+}
+
+// LeaveWaitlist takes a passenger off the waitlist and voids the payment
+// authorized when they joined.
+func (s *TrainService) LeaveWaitlist(ctx context.Context, req *train.LeaveWaitlistRequest) (*train.LeaveWaitlistResponse, error) {
+	er := make(chan error, 1)
+	result := make(chan string, 1)
+
+	s.ops <- func(st *state) {
+		entry, ok := st.leaveWaitlist(req.WaitlistId)
+		if !ok {
+			er <- fmt.Errorf("waitlist entry %s not found", req.WaitlistId)
+			return
+		}
+		result <- entry.payment
+	}
+	select {
+	case e := <-er:
+		return nil, e
+	case payment := <-result:
+		s.void(ctx, payment)
+		return &train.LeaveWaitlistResponse{Success: true}, nil
+	}
+}
@@ -0,0 +1,93 @@
+package reservation
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	train "github.com/bijoyv/train/pkg/proto"
+)
+
+func TestWaitlist(t *testing.T) {
+	events := make(chan Event, 1)
+	payments := NewFakePaymentProvider()
+	trainService := NewTrainReservationService(
+		WithPaymentProvider(payments),
+		WithSeatLayout(&train.SeatLayout{Coaches: []*train.Coach{{Code: "A", Seats: []*train.SeatDefinition{{Id: "A1"}}}}}),
+		WithEventListener(func(e Event) {
+			if e.Type == EventWaitlistPromoted {
+				events <- e
+			}
+		}),
+	)
+	ctx := context.Background()
+	purchase := func(email string) *train.PurchaseTicketResponse {
+		t.Helper()
+		res, err := trainService.PurchaseTicket(ctx, &train.PurchaseTicketRequest{
+			From:         "London",
+			To:           "Paris",
+			User:         &train.User{Email: email},
+			JoinWaitlist: true,
+		})
+		if err != nil {
+			t.Fatalf("PurchaseTicket failed: %v", err)
+		}
+		return res
+	}
+
+	first := purchase("dan.reid@example.com")
+	if first.Ticket == nil {
+		t.Fatal("Expected the first passenger to get a ticket")
+	}
+	second := purchase("eve.reid@example.com")
+	third := purchase("fay.reid@example.com")
+	if second.Waitlist == nil || second.Waitlist.Position != 1 || third.Waitlist.Position != 2 {
+		t.Fatalf("Expected waitlist positions 1 and 2, got %v and %v", second.Waitlist, third.Waitlist)
+	}
+
+	if _, err := trainService.RemoveUser(ctx, &train.RemoveUserRequest{Reference: first.Ticket.Reference, Email: "dan.reid@example.com"}); err != nil {
+		t.Fatalf("RemoveUser failed: %v", err)
+	}
+	select {
+	case e := <-events:
+		if e.Type != EventWaitlistPromoted || e.Ticket.User.Email != "eve.reid@example.com" || e.Ticket.Seat != "A1" {
+			t.Errorf("Expected eve.reid to be promoted to A1, got %s for %v", e.Type, e.Ticket)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Expected a promotion event")
+	}
+	promoted, err := trainService.GetTicket(ctx, &train.GetTicketRequest{Email: "eve.reid@example.com"})
+	if err != nil {
+		t.Fatalf("Expected promoted passenger to hold a ticket: %v", err)
+	}
+	// the authorization taken when eve joined pays for the ticket
+	if payment, _ := payments.Payment(promoted.Ticket.PaymentId); promoted.Ticket.PaymentId != "PAY000002" || payment.Status != train.PaymentStatus_PAYMENT_STATUS_CAPTURED || payment.Amount.MinorUnits != 2000 {
+		t.Errorf("Expected 2000 captured on PAY000002 for the promoted ticket, got %s %v", promoted.Ticket.PaymentId, payment)
+	}
+
+	pos, err := trainService.GetWaitlistPosition(ctx, &train.GetWaitlistPositionRequest{WaitlistId: third.Waitlist.Id})
+	if err != nil {
+		t.Fatalf("GetWaitlistPosition failed: %v", err)
+	}
+	if pos.Entry.Position != 1 {
+		t.Errorf("Expected position 1 after promotion, got %d", pos.Entry.Position)
+	}
+	if _, err := trainService.LeaveWaitlist(ctx, &train.LeaveWaitlistRequest{WaitlistId: third.Waitlist.Id}); err != nil {
+		t.Fatalf("LeaveWaitlist failed: %v", err)
+	}
+	if _, err := trainService.GetWaitlistPosition(ctx, &train.GetWaitlistPositionRequest{WaitlistId: third.Waitlist.Id}); err == nil {
+		t.Error("Expected error for an entry that left the waitlist, got nil")
+	}
+	if payment, _ := payments.Payment("PAY000003"); payment.Status != train.PaymentStatus_PAYMENT_STATUS_VOIDED {
+		t.Errorf("Expected the payment of a passenger leaving the waitlist voided, got %s", payment.Status)
+	}
+
+	_, err = trainService.PurchaseTicket(ctx, &train.PurchaseTicketRequest{
+		From: "London",
+		To:   "Paris",
+		User: &train.User{Email: "gus.reid@example.com"},
+	})
+	if err == nil {
+		t.Error("Expected no seats available without joining the waitlist, got nil")
+	}
+}